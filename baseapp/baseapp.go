@@ -63,6 +63,7 @@ type BaseApp struct { // nolint: maligned
 	baseKey *sdk.KVStoreKey // Main KVStore in cms
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	msgFilter      sdk.MsgFilter    // filter messages before routing them
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -587,9 +588,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
+		if app.msgFilter != nil {
+			if err := app.msgFilter(ctx, msg); err != nil {
+				return nil, sdkerrors.Wrapf(err, "message rejected; message index: %d", i)
+			}
+		}
+
 		// skip actual execution for (Re)CheckTx mode
 		if mode == runTxModeCheck || mode == runTxModeReCheck {
-			break
+			continue
 		}
 
 		msgRoute := msg.Route()
@@ -440,6 +440,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetMsgFilter(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -870,6 +873,65 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	require.Equal(t, int64(2), msgCounter2)
 }
 
+func TestMsgFilter(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	deliverKey2 := []byte("deliver-key2")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(routeMsgCounter2, handlerMsgCounter(t, capKey1, deliverKey2))
+	}
+
+	// reject the messages of the second route
+	filterOpt := func(bapp *BaseApp) {
+		bapp.SetMsgFilter(func(_ sdk.Context, msg sdk.Msg) error {
+			if msg.Route() == routeMsgCounter2 {
+				return sdkerrors.ErrUnauthorized
+			}
+			return nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, filterOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	// the filter runs in CheckTx even though the messages are not executed
+	tx := newTxCounter(0, 0)
+	tx.Msgs = append(tx.Msgs, msgCounter2{0})
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), checkRes.Code)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// a transaction is rejected if any of its messages is
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+
+	store := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey2))
+
+	// the messages accepted by the filter are executed
+	tx = newTxCounter(1, 0)
+	txBytes, err = codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+}
+
 // Interleave calls to Check and Deliver and ensure
 // that there is no cross-talk. Check sees results of the previous Check calls
 // and Deliver sees that of the previous Deliver calls, but they don't see eachother.
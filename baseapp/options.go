@@ -101,6 +101,16 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetMsgFilter sets the filter run on every message before it is routed to its
+// handler, in CheckTx as well as DeliverTx. A transaction is rejected if the
+// filter rejects any of its messages.
+func (app *BaseApp) SetMsgFilter(mf sdk.MsgFilter) {
+	if app.sealed {
+		panic("SetMsgFilter() on sealed BaseApp")
+	}
+	app.msgFilter = mf
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
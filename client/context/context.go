@@ -1,6 +1,7 @@
 package context
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
@@ -10,7 +11,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/tendermint/tendermint/libs/cli"
-	tmlite "github.com/tendermint/tendermint/lite"
+	lite2 "github.com/tendermint/tendermint/lite2"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -34,7 +35,7 @@ type CLIContext struct {
 	NodeURI       string
 	From          string
 	BroadcastMode string
-	Verifier      tmlite.Verifier
+	Verifier      Verifier
 	FromName      string
 	Codec         *codec.Codec
 	TrustNode     bool
@@ -43,6 +44,16 @@ type CLIContext struct {
 	GenerateOnly  bool
 	Indent        bool
 	SkipConfirm   bool
+
+	// AllowUnverified accepts the responses of the custom queries of untrusted
+	// nodes without a verified querier, which are rejected otherwise.
+	AllowUnverified bool
+
+	// ManageSequences allocates the sequences of transactions from the
+	// sequences persisted under the chain directory of the home directory.
+	ManageSequences bool
+
+	verifierErr error
 }
 
 // NewCLIContextWithInputAndFrom returns a new initialized CLIContext with parameters from the
@@ -92,16 +103,32 @@ func NewCLIContextWithInputAndFrom(input io.Reader, from string) CLIContext {
 		FromName:      fromName,
 		Indent:        viper.GetBool(flags.FlagIndentResponse),
 		SkipConfirm:   viper.GetBool(flags.FlagSkipConfirmation),
+
+		AllowUnverified: viper.GetBool(flags.FlagAllowUnverified),
+		ManageSequences: viper.GetBool(flags.FlagManageSequences),
+	}
+
+	trustHash, err := hex.DecodeString(viper.GetString(flags.FlagTrustHash))
+	if err != nil {
+		fmt.Printf("invalid trusted header hash: %s\n", err)
+		os.Exit(1)
 	}
 
 	// create a verifier for the specific chain ID and RPC client
-	verifier, err := CreateVerifier(ctx, DefaultVerifierCacheSize)
+	verifier, err := CreateVerifier(ctx, lite2.TrustOptions{
+		Period: viper.GetDuration(flags.FlagTrustingPeriod),
+		Height: viper.GetInt64(flags.FlagTrustHeight),
+		Hash:   trustHash,
+	}, viper.GetStringSlice(flags.FlagWitnesses))
 	if err != nil && viper.IsSet(flags.FlagTrustNode) {
 		fmt.Printf("failed to create verifier: %s\n", err)
 		os.Exit(1)
 	}
 
-	return ctx.WithVerifier(verifier)
+	ctx = ctx.WithVerifier(verifier)
+	// queries requiring verification report why there is no verifier
+	ctx.verifierErr = err
+	return ctx
 }
 
 // NewCLIContextWithFrom returns a new initialized CLIContext with parameters from the
@@ -154,6 +181,13 @@ func (ctx CLIContext) WithTrustNode(trustNode bool) CLIContext {
 	return ctx
 }
 
+// WithAllowUnverified returns a copy of the context with an updated
+// AllowUnverified flag.
+func (ctx CLIContext) WithAllowUnverified(allow bool) CLIContext {
+	ctx.AllowUnverified = allow
+	return ctx
+}
+
 // WithNodeURI returns a copy of the context with an updated node URI.
 func (ctx CLIContext) WithNodeURI(nodeURI string) CLIContext {
 	ctx.NodeURI = nodeURI
@@ -185,8 +219,9 @@ func (ctx CLIContext) WithUseLedger(useLedger bool) CLIContext {
 }
 
 // WithVerifier returns a copy of the context with an updated Verifier.
-func (ctx CLIContext) WithVerifier(verifier tmlite.Verifier) CLIContext {
+func (ctx CLIContext) WithVerifier(verifier Verifier) CLIContext {
 	ctx.Verifier = verifier
+	ctx.verifierErr = nil
 	return ctx
 }
 
@@ -208,6 +243,13 @@ func (ctx CLIContext) WithSimulation(simulate bool) CLIContext {
 	return ctx
 }
 
+// WithManageSequences returns a copy of the context with updated ManageSequences
+// value.
+func (ctx CLIContext) WithManageSequences(manage bool) CLIContext {
+	ctx.ManageSequences = manage
+	return ctx
+}
+
 // WithFromName returns a copy of the context with an updated from account name.
 func (ctx CLIContext) WithFromName(name string) CLIContext {
 	ctx.FromName = name
@@ -274,8 +316,7 @@ func GetFromFields(input io.Reader, from string, genOnly bool) (sdk.AccAddress,
 		return addr, "", nil
 	}
 
-	keybase, err := keys.NewKeyring(sdk.KeyringServiceName(),
-		viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), input)
+	keybase, err := flags.NewKeyringFromFlags(viper.GetString(flags.FlagHome), input)
 	if err != nil {
 		return nil, "", err
 	}
@@ -0,0 +1,32 @@
+package context
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// dbOpenTimeout is how long OpenDB waits for a database held by another
+// process.
+const dbOpenTimeout = 10 * time.Second
+
+// OpenDB opens the database of the given name in dir, e.g. the chain directory
+// of the home directory. A database is opened by one process at a time, so
+// OpenDB waits while another process holds it and the database should be
+// closed as soon as possible.
+func OpenDB(name, dir string) (dbm.DB, error) {
+	deadline := time.Now().Add(dbOpenTimeout)
+
+	for {
+		db, err := dbm.NewGoLevelDB(name, dir)
+		if err == nil {
+			return db, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, errors.Wrapf(err, "failed to open %s", name)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -13,6 +13,14 @@ func ErrInvalidAccount(addr sdk.AccAddress) error {
 Are you sure there has been a transaction involving it?`, addr)
 }
 
+// ErrUnverifiableQuery returns an error reflecting that the response of the
+// custom query of the given path can't be verified, as no verified querier is
+// registered for it.
+func ErrUnverifiableQuery(path string) error {
+	return fmt.Errorf(`the response of the custom query %s can't be verified.
+Please set --trust-node or --allow-unverified to true and try again`, path)
+}
+
 // ErrVerifyCommit returns a common error reflecting that the blockchain commit at a given
 // height can't be verified. The reason is that the base checkpoint of the certifier is
 // newer than the given height
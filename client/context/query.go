@@ -3,14 +3,13 @@ package context
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
-	tmliteErr "github.com/tendermint/tendermint/lite/errors"
-	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -84,11 +83,31 @@ func (ctx CLIContext) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, erro
 		return abci.ResponseQuery{}, err
 	}
 
+	// custom queries without a verified querier are answered by the node as is
+	// only if unverified responses are allowed
+	if !ctx.TrustNode && isCustomQuery(req.Path) {
+		querier, ok := GetVerifiedQuerier(req.Path)
+		if ok {
+			return ctx.queryVerified(querier, req)
+		}
+		if !ctx.AllowUnverified {
+			return abci.ResponseQuery{}, ErrUnverifiableQuery(req.Path)
+		}
+	}
+
 	opts := rpcclient.ABCIQueryOptions{
 		Height: ctx.Height,
 		Prove:  req.Prove || !ctx.TrustNode,
 	}
 
+	// the latest state can't be verified until the next header commits to it
+	if !ctx.TrustNode && opts.Height == 0 && isQueryStoreWithProof(req.Path) {
+		opts.Height, err = ctx.latestVerifiableHeight()
+		if err != nil {
+			return abci.ResponseQuery{}, err
+		}
+	}
+
 	result, err := node.ABCIQueryWithOptions(req.Path, req.Data, opts)
 	if err != nil {
 		return abci.ResponseQuery{}, err
@@ -111,6 +130,46 @@ func (ctx CLIContext) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, erro
 	return result.Response, nil
 }
 
+// queryVerified answers a custom query with the verified querier registered
+// for its path. All the store reads of the querier are made at the same height.
+func (ctx CLIContext) queryVerified(querier VerifiedQuerier, req abci.RequestQuery) (abci.ResponseQuery, error) {
+	height := ctx.Height
+	if height == 0 {
+		var err error
+		if height, err = ctx.latestVerifiableHeight(); err != nil {
+			return abci.ResponseQuery{}, err
+		}
+	}
+
+	value, err := querier(ctx.WithHeight(height), req.Data)
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
+	return abci.ResponseQuery{Value: value, Height: height}, nil
+}
+
+// latestVerifiableHeight returns the latest height of the state committed to
+// by a header, which is the height preceding the latest block.
+func (ctx CLIContext) latestVerifiableHeight() (int64, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight - 1
+	if height < 1 {
+		return 0, fmt.Errorf("no verifiable state at block height %d", status.SyncInfo.LatestBlockHeight)
+	}
+
+	return height, nil
+}
+
 // query performs a query to a Tendermint node with the provided store name
 // and path. It returns the result and height of the query upon success
 // or an error if the query fails. In addition, it will verify the returned
@@ -128,26 +187,35 @@ func (ctx CLIContext) query(path string, key tmbytes.HexBytes) ([]byte, int64, e
 	return resp.Value, resp.Height, nil
 }
 
-// Verify verifies the consensus proof at given height.
+// Verify verifies the header at given height with the light client.
 func (ctx CLIContext) Verify(height int64) (tmtypes.SignedHeader, error) {
-	if ctx.Verifier == nil {
-		return tmtypes.SignedHeader{}, fmt.Errorf("missing valid certifier to verify data from distrusted node")
-	}
-	check, err := tmliteProxy.GetCertifiedCommit(height, ctx.Client, ctx.Verifier)
-	switch {
-	case tmliteErr.IsErrCommitNotFound(err):
-		return tmtypes.SignedHeader{}, ErrVerifyCommit(height)
-	case err != nil:
+	if err := ctx.checkVerifier(); err != nil {
 		return tmtypes.SignedHeader{}, err
 	}
 
-	return check, nil
+	header, err := ctx.Verifier.VerifyHeaderAtHeight(height, time.Now())
+	if err != nil {
+		return tmtypes.SignedHeader{}, errors.Wrapf(err, "failed to verify the header at height %d", height)
+	}
+
+	return *header, nil
+}
+
+func (ctx CLIContext) checkVerifier() error {
+	switch {
+	case ctx.Verifier != nil:
+		return nil
+	case ctx.verifierErr != nil:
+		return errors.Wrap(ctx.verifierErr, "missing valid certifier to verify data from distrusted node")
+	default:
+		return errors.New("missing valid certifier to verify data from distrusted node")
+	}
 }
 
 // verifyProof perform response proof verification.
 func (ctx CLIContext) verifyProof(queryPath string, resp abci.ResponseQuery) error {
-	if ctx.Verifier == nil {
-		return fmt.Errorf("missing valid certifier to verify data from distrusted node")
+	if err := ctx.checkVerifier(); err != nil {
+		return err
 	}
 
 	// the AppHash for height H is in header H+1
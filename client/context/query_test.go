@@ -0,0 +1,70 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+type queryClient struct {
+	mock.Client
+	height int64
+}
+
+func (c queryClient) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: c.height}}, nil
+}
+
+func (c queryClient) ABCIQueryWithOptions(
+	path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: []byte("unverified"), Height: c.height}}, nil
+}
+
+func TestQueryVerified(t *testing.T) {
+	RegisterVerifiedQuerier("/custom/test/height", func(ctx CLIContext, data []byte) ([]byte, error) {
+		require.True(t, ctx.Height > 0)
+		return append([]byte("verified "), data...), nil
+	})
+
+	ctx := CLIContext{Client: queryClient{height: 10}}
+
+	// custom queries of untrusted nodes are answered by their verified querier
+	// at the latest height committed to by a header
+	bz, height, err := ctx.QueryWithData("custom/test/height", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t, "verified data", string(bz))
+	require.Equal(t, int64(9), height)
+
+	_, height, err = ctx.WithHeight(5).QueryWithData("custom/test/height", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), height)
+
+	// custom queries without a verified querier are rejected, unless unverified
+	// responses are allowed
+	_, _, err = ctx.QueryWithData("custom/test/other", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "custom/test/other")
+
+	bz, _, err = ctx.WithAllowUnverified(true).QueryWithData("custom/test/other", nil)
+	require.NoError(t, err)
+	require.Equal(t, "unverified", string(bz))
+
+	bz, _, err = ctx.WithAllowUnverified(true).QueryWithData("custom/test/height", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t, "verified data", string(bz))
+
+	_, _, err = CLIContext{Client: queryClient{height: 1}}.QueryWithData("custom/test/height", nil)
+	require.Error(t, err)
+
+	// trusted nodes answer custom queries
+	bz, _, err = ctx.WithTrustNode(true).QueryWithData("custom/test/other", nil)
+	require.NoError(t, err)
+	require.Equal(t, "unverified", string(bz))
+}
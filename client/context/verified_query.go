@@ -0,0 +1,47 @@
+package context
+
+import (
+	"strings"
+	"sync"
+)
+
+// VerifiedQuerier answers a custom query with store reads, e.g. through
+// QueryStore, whose proofs are verified unless the node is trusted. It returns
+// the response of the custom query for the given request data at the height
+// of the CLIContext.
+type VerifiedQuerier func(ctx CLIContext, data []byte) ([]byte, error)
+
+var (
+	verifiedQueriersMtx sync.RWMutex
+	verifiedQueriers    = make(map[string]VerifiedQuerier)
+)
+
+// RegisterVerifiedQuerier registers the verified querier answering the custom
+// query of the given path, e.g. custom/bank/balance, when the node is not
+// trusted. Registering a querier again for the same path replaces it.
+func RegisterVerifiedQuerier(path string, querier VerifiedQuerier) {
+	verifiedQueriersMtx.Lock()
+	defer verifiedQueriersMtx.Unlock()
+
+	verifiedQueriers[normalizeQueryPath(path)] = querier
+}
+
+// GetVerifiedQuerier returns the verified querier registered for the custom
+// query of the given path.
+func GetVerifiedQuerier(path string) (VerifiedQuerier, bool) {
+	verifiedQueriersMtx.RLock()
+	defer verifiedQueriersMtx.RUnlock()
+
+	querier, ok := verifiedQueriers[normalizeQueryPath(path)]
+	return querier, ok
+}
+
+// isCustomQuery returns true if the path is the path of a custom query, i.e.
+// custom/<route>/<query>.
+func isCustomQuery(path string) bool {
+	return strings.HasPrefix(normalizeQueryPath(path), "custom/")
+}
+
+func normalizeQueryPath(path string) string {
+	return strings.TrimPrefix(path, "/")
+}
@@ -2,27 +2,95 @@ package context
 
 import (
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/log"
-	tmlite "github.com/tendermint/tendermint/lite"
-	tmliteproxy "github.com/tendermint/tendermint/lite/proxy"
+	lite2 "github.com/tendermint/tendermint/lite2"
+	"github.com/tendermint/tendermint/lite2/provider"
+	litehttp "github.com/tendermint/tendermint/lite2/provider/http"
+	litestore "github.com/tendermint/tendermint/lite2/store"
+	litedb "github.com/tendermint/tendermint/lite2/store/db"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 const (
-	verifierDir = ".lite_verifier"
-
-	// DefaultVerifierCacheSize defines the default Tendermint cache size.
-	DefaultVerifierCacheSize = 10
+	// verifierDB is the name of the database of the headers trusted by the
+	// light client, stored in the chain directory of the home directory.
+	verifierDB = "light-client"
 )
 
-// CreateVerifier returns a Tendermint verifier from a CLIContext object and
-// cache size. An error is returned if the CLIContext is missing required values
-// or if the verifier could not be created. A CLIContext must at the very least
-// have the chain ID and home directory set. If the CLIContext has TrustNode
-// enabled, no verifier will be created.
-func CreateVerifier(ctx CLIContext, cacheSize int) (tmlite.Verifier, error) {
+// Verifier verifies the headers of a chain.
+type Verifier interface {
+	// VerifyHeaderAtHeight returns the header of the given height once it has
+	// been verified against the headers trusted at the given time.
+	VerifyHeaderAtHeight(height int64, now time.Time) (*tmtypes.SignedHeader, error)
+}
+
+// lightClientVerifier verifies headers with a light client whose trusted
+// headers are opened for each verification, so that several processes verify
+// headers of the same chain. Verifications are serialized.
+type lightClientVerifier struct {
+	mtx         sync.Mutex
+	chainID     string
+	dir         string
+	trustOpts   lite2.TrustOptions
+	primary     provider.Provider
+	witnesses   []provider.Provider
+	initialized bool
+}
+
+func (v *lightClientVerifier) VerifyHeaderAtHeight(height int64, now time.Time) (*tmtypes.SignedHeader, error) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	db, err := OpenDB(verifierDB, v.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the trusted headers")
+	}
+	defer db.Close()
+
+	// the trust options initialize the trusted headers once
+	trustOpts := v.trustOpts
+	if v.initialized {
+		trustOpts = lite2.TrustOptions{Period: trustOpts.Period}
+	}
+
+	lc, err := newLightClient(v.chainID, trustOpts, v.primary, v.witnesses, litedb.New(db, v.chainID))
+	if err != nil {
+		return nil, err
+	}
+	v.initialized = true
+
+	return lc.VerifyHeaderAtHeight(height, now)
+}
+
+// signStatusClient is the RPC client the light client fetches headers with.
+type signStatusClient struct {
+	rpcclient.Client
+	remote string
+}
+
+func (c signStatusClient) Remote() string { return c.remote }
+
+// CreateVerifier returns a light client verifier from a CLIContext object, trust
+// options and the RPC addresses of witness nodes. An error is returned if the
+// CLIContext is missing required values or if the verifier could not be
+// created. A CLIContext must at the very least have the chain ID and home
+// directory set. If the CLIContext has TrustNode enabled, no verifier will be
+// created.
+//
+// The light client verifies headers by bisection from the headers it trusts,
+// which are persisted under the chain directory of the home directory, and
+// cross-checks them with the witnesses, which must be other nodes than the one
+// of the CLIContext. The trust options give the period headers are trusted for
+// and, until the light client trusts a header of the chain, the height and
+// hash of the first header to trust, obtained from a trusted source. A trusted
+// header replaces the headers trusted so far. The trusted headers are only
+// opened while a header is verified.
+func CreateVerifier(ctx CLIContext, trustOpts lite2.TrustOptions, witnessURIs []string) (Verifier, error) {
 	if ctx.TrustNode {
 		return nil, nil
 	}
@@ -36,6 +104,9 @@ func CreateVerifier(ctx CLIContext, cacheSize int) (tmlite.Verifier, error) {
 
 	case ctx.Client == nil && ctx.NodeURI == "":
 		return nil, errors.New("must provide a valid RPC client or RPC URI to create verifier")
+
+	case len(witnessURIs) == 0:
+		return nil, errors.New("must provide the RPC URIs of witness nodes to create verifier")
 	}
 
 	var err error
@@ -49,8 +120,52 @@ func CreateVerifier(ctx CLIContext, cacheSize int) (tmlite.Verifier, error) {
 		}
 	}
 
-	return tmliteproxy.NewVerifier(
-		ctx.ChainID, filepath.Join(ctx.HomeDir, ctx.ChainID, verifierDir),
-		client, log.NewNopLogger(), cacheSize,
-	)
+	witnesses := make([]provider.Provider, len(witnessURIs))
+	for i, uri := range witnessURIs {
+		if uri == ctx.NodeURI {
+			return nil, errors.Errorf("the witness %s is the node of the verified queries", uri)
+		}
+
+		witness, err := rpcclient.NewHTTP(uri, "/websocket")
+		if err != nil {
+			return nil, err
+		}
+		witnesses[i] = litehttp.NewWithClient(ctx.ChainID, signStatusClient{witness, uri})
+	}
+
+	return &lightClientVerifier{
+		chainID:   ctx.ChainID,
+		dir:       filepath.Join(ctx.HomeDir, ctx.ChainID),
+		trustOpts: trustOpts,
+		primary:   litehttp.NewWithClient(ctx.ChainID, signStatusClient{client, ctx.NodeURI}),
+		witnesses: witnesses,
+	}, nil
+}
+
+func newLightClient(
+	chainID string, trustOpts lite2.TrustOptions, primary provider.Provider, witnesses []provider.Provider,
+	trustedStore litestore.Store,
+) (*lite2.Client, error) {
+
+	options := []lite2.Option{
+		lite2.SkippingVerification(lite2.DefaultTrustLevel),
+		lite2.Logger(log.NewNopLogger()),
+	}
+
+	if trustOpts.Height != 0 || len(trustOpts.Hash) != 0 {
+		return lite2.NewClient(chainID, trustOpts, primary, witnesses, trustedStore, options...)
+	}
+
+	lastHeight, err := trustedStore.LastSignedHeaderHeight()
+	if err != nil {
+		return nil, err
+	}
+	if lastHeight <= 0 {
+		return nil, errors.Errorf(
+			"the light client trusts no header of chain %s yet: set the height and hash of a header obtained from a trusted source with --trust-height and --trust-hash",
+			chainID,
+		)
+	}
+
+	return lite2.NewClientFromTrustedStore(chainID, trustOpts.Period, primary, witnesses, trustedStore, options...)
 }
@@ -2,9 +2,12 @@ package context_test
 
 import (
 	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	lite2 "github.com/tendermint/tendermint/lite2"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 )
@@ -12,26 +15,46 @@ import (
 func TestCreateVerifier(t *testing.T) {
 	tmpDir, err := ioutil.TempDir("", "example")
 	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	node := "tcp://localhost:26657"
+	witnesses := []string{"tcp://localhost:36657"}
 
 	testCases := []struct {
 		name      string
 		ctx       context.CLIContext
+		witnesses []string
 		expectErr bool
 	}{
-		{"no chain ID", context.CLIContext{}, true},
-		{"no home directory", context.CLIContext{}.WithChainID("test"), true},
-		{"no client or RPC URI", context.CLIContext{HomeDir: tmpDir}.WithChainID("test"), true},
+		{"no chain ID", context.CLIContext{}, witnesses, true},
+		{"no home directory", context.CLIContext{}.WithChainID("test"), witnesses, true},
+		{"no client or RPC URI", context.CLIContext{HomeDir: tmpDir}.WithChainID("test"), witnesses, true},
+		{"no witnesses", context.CLIContext{HomeDir: tmpDir, NodeURI: node}.WithChainID("test"), nil, true},
+		{"node is a witness", context.CLIContext{HomeDir: tmpDir, NodeURI: node}.WithChainID("test"), []string{node}, true},
+		{"trusted node", context.CLIContext{}.WithTrustNode(true), nil, false},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			verifier, err := context.CreateVerifier(tc.ctx, context.DefaultVerifierCacheSize)
+			verifier, err := context.CreateVerifier(tc.ctx, lite2.TrustOptions{}, tc.witnesses)
 			require.Equal(t, tc.expectErr, err != nil, err)
-
-			if !tc.expectErr {
-				require.NotNil(t, verifier)
-			}
+			require.Nil(t, verifier)
 		})
 	}
+
+	// the trusted headers are opened by the verifications
+	ctx := context.CLIContext{HomeDir: tmpDir, NodeURI: node}.WithChainID("test")
+	verifier, err := context.CreateVerifier(ctx, lite2.TrustOptions{}, witnesses)
+	require.NoError(t, err)
+	require.NotNil(t, verifier)
+
+	_, err = verifier.VerifyHeaderAtHeight(1, time.Now())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "trusts no header")
+
+	// and closed afterwards, so that other processes open them
+	db, err := context.OpenDB("light-client", tmpDir+"/test")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
 }
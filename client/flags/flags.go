@@ -2,8 +2,10 @@ package flags
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +13,7 @@ import (
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // nolint
@@ -24,6 +27,10 @@ const (
 
 	// DefaultKeyringBackend
 	DefaultKeyringBackend = keys.BackendOS
+
+	// DefaultTrustingPeriod is the period headers verified by the light client
+	// are trusted for. It must be shorter than the unbonding period of the chain.
+	DefaultTrustingPeriod = 14 * 24 * time.Hour
 )
 
 const (
@@ -47,6 +54,11 @@ const (
 	FlagHeight             = "height"
 	FlagGasAdjustment      = "gas-adjustment"
 	FlagTrustNode          = "trust-node"
+	FlagAllowUnverified    = "allow-unverified"
+	FlagTrustHeight        = "trust-height"
+	FlagTrustHash          = "trust-hash"
+	FlagTrustingPeriod     = "trusting-period"
+	FlagWitnesses          = "witnesses"
 	FlagFrom               = "from"
 	FlagName               = "name"
 	FlagAccountNumber      = "account-number"
@@ -57,6 +69,7 @@ const (
 	FlagBroadcastMode      = "broadcast-mode"
 	FlagDryRun             = "dry-run"
 	FlagGenerateOnly       = "generate-only"
+	FlagManageSequences    = "manage-sequences"
 	FlagIndentResponse     = "indent"
 	FlagListenAddr         = "laddr"
 	FlagMaxOpenConnections = "max-open"
@@ -66,6 +79,8 @@ const (
 	FlagSkipConfirmation   = "yes"
 	FlagProve              = "prove"
 	FlagKeyringBackend     = "keyring-backend"
+	FlagKeyringRemoteAddr  = "keyring-remote-addr"
+	FlagKeyringRemoteAuth  = "keyring-remote-auth-key"
 	FlagPage               = "page"
 	FlagLimit              = "limit"
 	FlagUnsafeCORS         = "unsafe-cors"
@@ -83,11 +98,21 @@ func GetCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
 		c.Flags().Bool(FlagIndentResponse, false, "Add indent to JSON response")
 		c.Flags().Bool(FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+		c.Flags().Bool(FlagAllowUnverified, false, "Accept the responses of the custom queries which can't be verified, while still verifying the other responses")
+		c.Flags().Int64(FlagTrustHeight, 0, "Height of a header trusted by the light client, required until the light client trusts a header of the chain")
+		c.Flags().String(FlagTrustHash, "", "Hex encoded hash of the header at --trust-height")
+		c.Flags().Duration(FlagTrustingPeriod, DefaultTrustingPeriod, "Period headers verified by the light client are trusted for, shorter than the unbonding period")
+		c.Flags().StringSlice(FlagWitnesses, nil, "Comma separated <host>:<port> of the Tendermint RPC interfaces of other nodes the light client cross-checks headers with")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
 		c.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 
 		viper.BindPFlag(FlagTrustNode, c.Flags().Lookup(FlagTrustNode))
+		viper.BindPFlag(FlagAllowUnverified, c.Flags().Lookup(FlagAllowUnverified))
+		viper.BindPFlag(FlagTrustHeight, c.Flags().Lookup(FlagTrustHeight))
+		viper.BindPFlag(FlagTrustHash, c.Flags().Lookup(FlagTrustHash))
+		viper.BindPFlag(FlagTrustingPeriod, c.Flags().Lookup(FlagTrustingPeriod))
+		viper.BindPFlag(FlagWitnesses, c.Flags().Lookup(FlagWitnesses))
 		viper.BindPFlag(FlagUseLedger, c.Flags().Lookup(FlagUseLedger))
 		viper.BindPFlag(FlagNode, c.Flags().Lookup(FlagNode))
 
@@ -116,7 +141,10 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 		c.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible and the node operates offline)")
 		c.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-		c.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+		c.Flags().Bool(FlagManageSequences, false, "Allocate the sequence from the sequences persisted in the home directory, to broadcast transactions while the previous ones are in the mempool")
+		c.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
+		c.Flags().String(FlagKeyringRemoteAddr, "", "Address of the remote signer of the remote keyring backend (default unix://<home>/remote-signer.sock)")
+		c.Flags().String(FlagKeyringRemoteAuth, "", "File holding the key authenticating the remote keyring backend to the remote signer (default <home>/remote-signer.key)")
 
 		// --gas can accept integers and "simulate"
 		c.Flags().Var(&GasFlagVar, "gas", fmt.Sprintf(
@@ -127,6 +155,8 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		viper.BindPFlag(FlagUseLedger, c.Flags().Lookup(FlagUseLedger))
 		viper.BindPFlag(FlagNode, c.Flags().Lookup(FlagNode))
 		viper.BindPFlag(FlagKeyringBackend, c.Flags().Lookup(FlagKeyringBackend))
+		viper.BindPFlag(FlagKeyringRemoteAddr, c.Flags().Lookup(FlagKeyringRemoteAddr))
+		viper.BindPFlag(FlagKeyringRemoteAuth, c.Flags().Lookup(FlagKeyringRemoteAuth))
 
 		c.MarkFlagRequired(FlagChainID)
 
@@ -219,3 +249,11 @@ To configure your bash shell to load completions for each session add to your ba
 
 	return cmd
 }
+
+// NewKeyringFromFlags returns the keyring of the backend selected with the
+// keyring flags, storing its keys under rootDir.
+func NewKeyringFromFlags(rootDir string, input io.Reader) (keys.Keybase, error) {
+	return keys.NewKeyring(sdk.KeyringServiceName(), viper.GetString(FlagKeyringBackend), rootDir, input,
+		keys.WithRemoteSignerAddr(viper.GetString(FlagKeyringRemoteAddr)),
+		keys.WithRemoteSignerAuthKeyFile(viper.GetString(FlagKeyringRemoteAuth)))
+}
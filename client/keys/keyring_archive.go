@@ -0,0 +1,127 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+const flagOnConflict = "on-conflict"
+
+// ExportKeyringCommand exports all keys of the key store into an encrypted archive.
+func ExportKeyringCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-keyring <file>",
+		Short: "Export all keys into an encrypted archive",
+		Long: `Export all keys of the keybase into an ASCII-armored archive encrypted with a
+new passphrase, e.g. to back up the keybase or to migrate it to another backend.
+
+Local keys are exported with their private keys. Ledger keys, together with their
+HD paths, offline and multisig keys are exported as references. Keys held by a
+remote signer are not exported.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportKeyringCmd,
+	}
+}
+
+func runExportKeyringCmd(cmd *cobra.Command, args []string) error {
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := flags.NewKeyringFromFlags(viper.GetString(flags.FlagHome), buf)
+	if err != nil {
+		return err
+	}
+
+	encryptPassword, err := input.GetCheckPassword(
+		"Enter passphrase to encrypt the exported keyring:", "Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+
+	armored, infos, err := keys.ExportKeyring(kb, "", encryptPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(args[0], []byte(armored), 0600); err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		cmd.PrintErrf("exported %s key %s (%s)\n", info.GetType(), info.GetName(), info.GetAddress())
+	}
+	cmd.PrintErrf("%d keys written to %s\n", len(infos), args[0])
+	return nil
+}
+
+// ImportKeyringCommand imports the keys of an archive created by export-keyring.
+func ImportKeyringCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-keyring <file>",
+		Short: "Import all keys of an encrypted archive",
+		Long: `Import the keys of an archive created by export-keyring into the keybase.
+
+Every archived key is checked against its address before any key is imported.
+Keys whose address is already in the keybase are skipped. Keys whose name is used
+by a different key are skipped, renamed to <name>-<n> or overwrite the existing
+key according to --on-conflict. Use --dry-run to list the actions of an import
+without modifying the keybase.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImportKeyringCmd,
+	}
+	cmd.Flags().String(flagOnConflict, string(keys.ConflictSkip), "Resolution of name conflicts (skip|rename|overwrite)")
+	cmd.Flags().Bool(flags.FlagDryRun, false, "List the actions of the import without modifying the keybase")
+	return cmd
+}
+
+func runImportKeyringCmd(cmd *cobra.Command, args []string) error {
+	conflict, err := keys.ParseKeyringImportConflict(viper.GetString(flagOnConflict))
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := flags.NewKeyringFromFlags(viper.GetString(flags.FlagHome), buf)
+	if err != nil {
+		return err
+	}
+
+	bz, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to decrypt the keyring:", buf)
+	if err != nil {
+		return err
+	}
+
+	dryRun := viper.GetBool(flags.FlagDryRun)
+	results, err := keys.ImportKeyring(kb, string(bz), passphrase, conflict, dryRun)
+	for _, res := range results {
+		line := fmt.Sprintf("%s %s key %s (%s)", res.Action, res.Type, res.Name, res.Address)
+		switch {
+		case res.Reason != "":
+			line += ": " + res.Reason
+		case res.ImportedName != res.Name:
+			line += " as " + res.ImportedName
+		}
+		cmd.Println(line)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		cmd.PrintErrln("dry-run: no keys were imported")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runExportImportKeyringCmd(t *testing.T) {
+	exportCmd := ExportKeyringCommand()
+	mockIn, _, _ := tests.ApplyMockIO(exportCmd)
+
+	srcHome, cleanUp := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanUp)
+	viper.Set(flags.FlagHome, srcHome)
+	viper.Set(flags.FlagKeyringBackend, keys.BackendTest)
+
+	kb, err := keys.NewKeyring(sdk.KeyringServiceName(), keys.BackendTest, srcHome, mockIn)
+	require.NoError(t, err)
+	info, err := kb.CreateAccount("keyname1", tests.TestMnemonic, "", "", "", keys.Secp256k1)
+	require.NoError(t, err)
+
+	archive := filepath.Join(srcHome, "keyring.asc")
+	mockIn.Reset("12345678\n12345678\n")
+	require.NoError(t, runExportKeyringCmd(exportCmd, []string{archive}))
+
+	importCmd := ImportKeyringCommand()
+	mockIn, _, _ = tests.ApplyMockIO(importCmd)
+
+	dstHome, cleanUp := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanUp)
+	viper.Set(flags.FlagHome, dstHome)
+
+	// a dry-run leaves the keybase empty
+	viper.Set(flags.FlagDryRun, true)
+	t.Cleanup(func() { viper.Set(flags.FlagDryRun, false) })
+	mockIn.Reset("12345678\n")
+	require.NoError(t, runImportKeyringCmd(importCmd, []string{archive}))
+
+	dst, err := keys.NewKeyring(sdk.KeyringServiceName(), keys.BackendTest, dstHome, mockIn)
+	require.NoError(t, err)
+	infos, err := dst.List()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+
+	viper.Set(flags.FlagDryRun, false)
+	mockIn.Reset("wrongpass\n")
+	require.Error(t, runImportKeyringCmd(importCmd, []string{archive}))
+
+	mockIn.Reset("12345678\n")
+	require.NoError(t, runImportKeyringCmd(importCmd, []string{archive}))
+
+	imported, err := dst.Get("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, info.GetAddress(), imported.GetAddress())
+
+	viper.Set(flagOnConflict, "merge")
+	t.Cleanup(func() { viper.Set(flagOnConflict, string(keys.ConflictSkip)) })
+	require.Error(t, runImportKeyringCmd(importCmd, []string{archive}))
+}
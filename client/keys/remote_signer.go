@@ -0,0 +1,141 @@
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+	tmos "github.com/tendermint/tendermint/libs/os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagListen      = "listen"
+	flagAuthKeyFile = "auth-key"
+)
+
+// RemoteSignerCmd serves the keys of a local keyring to clients using the
+// remote keyring backend.
+func RemoteSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer",
+		Short: "Serve the local keys to clients of the remote keyring backend",
+		Long: `Run a reference remote signer which signs with the local keys of the file keyring,
+or of the keyring selected with --keyring-backend, on behalf of clients using
+--keyring-backend remote.
+
+The signer listens on a Unix domain socket or a TCP address. Clients authenticate with
+the key of the --auth-key file, which is generated if it does not exist and must be
+shared with the clients through their --keyring-remote-auth-key flag. Connections are
+not encrypted: Unix sockets are only accessible by the owner of the signer process,
+TCP addresses must only be reachable from trusted hosts, e.g. through an SSH tunnel.
+`,
+		Args: cobra.NoArgs,
+		RunE: runRemoteSignerCmd,
+	}
+
+	cmd.Flags().String(flagListen, "", "Address to listen on, e.g. tcp://127.0.0.1:26659 (default unix://<home>/remote-signer.sock)")
+	cmd.Flags().String(flagAuthKeyFile, "", "File holding the key clients authenticate with (default <home>/remote-signer.key)")
+	return cmd
+}
+
+func runRemoteSignerCmd(cmd *cobra.Command, _ []string) error {
+	backend := keys.BackendFile
+	if cmd.Flags().Changed(flags.FlagKeyringBackend) {
+		backend = viper.GetString(flags.FlagKeyringBackend)
+	}
+	if backend == keys.BackendRemote {
+		return errors.New("the remote signer cannot use the remote keyring backend")
+	}
+
+	rootDir := viper.GetString(flags.FlagHome)
+	kb, err := keys.NewKeyring(sdk.KeyringServiceName(), backend, rootDir, bufio.NewReader(cmd.InOrStdin()))
+	if err != nil {
+		return err
+	}
+
+	// unlock the keyring before serving requests
+	infos, err := kb.List()
+	if err != nil {
+		return err
+	}
+
+	authKeyFile, _ := cmd.Flags().GetString(flagAuthKeyFile)
+	if authKeyFile == "" {
+		authKeyFile = filepath.Join(rootDir, keys.DefaultRemoteSignerAuthKeyFile)
+	}
+
+	authKey, err := keys.GenRemoteSignerAuthKey(authKeyFile)
+	if err != nil {
+		return err
+	}
+
+	laddr, _ := cmd.Flags().GetString(flagListen)
+	if laddr == "" {
+		laddr = "unix://" + filepath.Join(rootDir, keys.DefaultRemoteSignerSocket)
+	}
+
+	proto, addr := tmnet.ProtocolAndAddress(laddr)
+	ln, err := listenRemoteSigner(proto, addr)
+	if err != nil {
+		return err
+	}
+
+	logger := log.NewTMLogger(log.NewSyncWriter(cmd.ErrOrStderr()))
+	logger.Info("serving keys", "addr", laddr, "backend", backend, "keys", len(infos), "auth-key", authKeyFile)
+
+	tmos.TrapSignal(logger, func() {
+		ln.Close()
+		if proto == "unix" {
+			os.Remove(addr)
+		}
+	})
+
+	return keys.NewRemoteSigner(kb, authKey, logger).Serve(ln)
+}
+
+// listenRemoteSigner listens on the given address. Unix sockets are created in
+// a temporary directory only accessible by the owner of the process, restricted
+// to the owner and then moved to addr, so that no other user can connect to the
+// socket before its permissions are set.
+func listenRemoteSigner(proto, addr string) (net.Listener, error) {
+	if proto != "unix" {
+		return net.Listen(proto, addr)
+	}
+
+	dir, err := ioutil.TempDir(filepath.Dir(addr), ".remote-signer")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpAddr := filepath.Join(dir, filepath.Base(addr))
+	ln, err := net.Listen(proto, tmpAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the socket is removed on shutdown as it no longer is at its original path
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	if err := os.Chmod(tmpAddr, 0600); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmpAddr, addr); err != nil {
+		ln.Close()
+		return nil, err
+	}
+
+	return ln, nil
+}
@@ -0,0 +1,45 @@
+package keys
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func Test_listenRemoteSigner(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	addr := filepath.Join(dir, "signer.sock")
+	ln, err := listenRemoteSigner("unix", addr)
+	require.NoError(t, err)
+
+	// the socket is moved to its address with owner only permissions and the
+	// temporary directory is removed
+	fi, err := os.Stat(addr)
+	require.NoError(t, err)
+	require.Equal(t, os.ModeSocket, fi.Mode()&os.ModeSocket)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}()
+
+	conn, err := net.Dial("unix", addr)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.NoError(t, ln.Close())
+}
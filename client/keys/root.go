@@ -24,6 +24,8 @@ func Commands() *cobra.Command {
 		AddKeyCommand(),
 		ExportKeyCommand(),
 		ImportKeyCommand(),
+		ExportKeyringCommand(),
+		ImportKeyringCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
 		flags.LineBreak,
@@ -31,8 +33,13 @@ func Commands() *cobra.Command {
 		UpdateKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		RemoteSignerCmd(),
 	)
-	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
+	cmd.PersistentFlags().String(flags.FlagKeyringRemoteAddr, "", "Address of the remote signer of the remote keyring backend (default unix://<home>/remote-signer.sock)")
+	cmd.PersistentFlags().String(flags.FlagKeyringRemoteAuth, "", "File holding the key authenticating the remote keyring backend to the remote signer (default <home>/remote-signer.key)")
 	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
+	viper.BindPFlag(flags.FlagKeyringRemoteAddr, cmd.PersistentFlags().Lookup(flags.FlagKeyringRemoteAddr))
+	viper.BindPFlag(flags.FlagKeyringRemoteAuth, cmd.PersistentFlags().Lookup(flags.FlagKeyringRemoteAuth))
 	return cmd
 }
@@ -0,0 +1,357 @@
+package lcd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// OpenAPIPath is the path of the OpenAPI document of the REST server.
+const OpenAPIPath = "/openapi.json"
+
+var (
+	pathParamRegex  = regexp.MustCompile(`{([^}:]+)(:[^}]*)?}`)
+	schemaNameRegex = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// OpenAPI is an OpenAPI 3 document.
+type OpenAPI struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       OpenAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*OpenAPIOperation `json:"paths"`
+	Components OpenAPIComponents                       `json:"components"`
+}
+
+// OpenAPIInfo describes the API of an OpenAPI document.
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIComponents holds the schemas referenced by an OpenAPI document.
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas"`
+}
+
+// OpenAPIOperation describes an operation of a path of an OpenAPI document.
+type OpenAPIOperation struct {
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Parameters  []OpenAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *OpenAPIBody                `json:"requestBody,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter describes a path or query parameter of an operation.
+type OpenAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required"`
+	Schema      *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIBody describes the JSON body of a request.
+type OpenAPIBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIResponse describes a response of an operation.
+type OpenAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]OpenAPIMediaType `json:"content,omitempty"`
+}
+
+// OpenAPIMediaType holds the schema of a body.
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPISchema is the JSON schema of a value.
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// GenerateOpenAPI generates the OpenAPI document of the routes of the router
+// from their documentation. The schemas of the bodies follow the Amino JSON
+// encoding of their types. The document of the documented routes is returned
+// along with an error listing the routes without documentation, if any.
+func GenerateOpenAPI(router *mux.Router) (*OpenAPI, error) {
+	title := version.Name
+	if title == "" {
+		title = "cosmos-sdk"
+	}
+
+	doc := &OpenAPI{
+		OpenAPI: "3.0.0",
+		Info:    OpenAPIInfo{Title: fmt.Sprintf("%s REST API", title), Version: version.Version},
+		Paths:   make(map[string]map[string]*OpenAPIOperation),
+	}
+	schemas := newSchemaGenerator()
+	errResponse := &OpenAPIResponse{
+		Description: "error",
+		Content:     jsonContent(schemas.schemaOf(reflect.TypeOf(rest.ErrorResponse{}))),
+	}
+
+	var undocumented []string
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		// routes without handlers only group the routes of subrouters
+		if route.GetHandler() == nil {
+			return nil
+		}
+
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			methods = []string{http.MethodGet}
+		}
+
+		routeDoc, ok := rest.GetRouteDoc(route)
+		if !ok {
+			undocumented = append(undocumented, fmt.Sprintf("%s %s", strings.Join(methods, ","), path))
+			return nil
+		}
+
+		op := schemas.operation(path, routeDoc)
+		op.Responses["default"] = errResponse
+
+		openAPIPath := pathParamRegex.ReplaceAllString(path, "{$1}")
+		if doc.Paths[openAPIPath] == nil {
+			doc.Paths[openAPIPath] = make(map[string]*OpenAPIOperation)
+		}
+		for _, method := range methods {
+			doc.Paths[openAPIPath][strings.ToLower(method)] = op
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Components.Schemas = schemas.components
+	if len(undocumented) > 0 {
+		sort.Strings(undocumented)
+		return doc, fmt.Errorf("REST routes without documentation: %s", strings.Join(undocumented, ", "))
+	}
+
+	return doc, nil
+}
+
+// OpenAPIHandler returns the handler serving the given OpenAPI document.
+func OpenAPIHandler(doc *OpenAPI) http.HandlerFunc {
+	bz, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
+
+type schemaGenerator struct {
+	components map[string]*OpenAPISchema
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{components: make(map[string]*OpenAPISchema)}
+}
+
+func (g *schemaGenerator) operation(path string, routeDoc rest.RouteDoc) *OpenAPIOperation {
+	op := &OpenAPIOperation{
+		Summary:     routeDoc.Summary,
+		Description: routeDoc.Description,
+		Responses:   make(map[string]*OpenAPIResponse),
+	}
+
+	// routes are tagged with the first segment of their path, e.g. their module
+	if segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2); segments[0] != "" {
+		op.Tags = []string{segments[0]}
+	}
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		op.Parameters = append(op.Parameters, OpenAPIParameter{
+			Name: match[1], In: "path", Required: true, Schema: &OpenAPISchema{Type: "string"},
+		})
+	}
+	for _, param := range routeDoc.QueryParams {
+		paramType := param.Type
+		if paramType == "" {
+			paramType = "string"
+		}
+
+		op.Parameters = append(op.Parameters, OpenAPIParameter{
+			Name:        param.Name,
+			In:          "query",
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      &OpenAPISchema{Type: paramType},
+		})
+	}
+
+	if routeDoc.Request != nil {
+		op.RequestBody = &OpenAPIBody{Required: true, Content: jsonContent(g.schemaOf(reflect.TypeOf(routeDoc.Request)))}
+	}
+
+	ok := &OpenAPIResponse{Description: "success"}
+	switch {
+	case routeDoc.Result != nil:
+		ok.Content = jsonContent(&OpenAPISchema{
+			Type: "object",
+			Properties: map[string]*OpenAPISchema{
+				"height": {Type: "string", Format: "int64"},
+				"result": g.schemaOf(reflect.TypeOf(routeDoc.Result)),
+			},
+		})
+
+	case routeDoc.Response != nil:
+		ok.Content = jsonContent(g.schemaOf(reflect.TypeOf(routeDoc.Response)))
+	}
+	op.Responses["200"] = ok
+
+	return op
+}
+
+// schemaOf returns the schema of the Amino JSON encoding of the given type.
+// Named struct types are referenced from the components of the document.
+func (g *schemaGenerator) schemaOf(t reflect.Type) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &OpenAPISchema{Type: "string", Format: "date-time"}
+	}
+
+	// types encoding themselves are described by their kind, e.g. addresses as
+	// strings and coins as arrays
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		switch {
+		case t.Kind() == reflect.Struct && hasExportedFields(t):
+		case t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		default:
+			return &OpenAPISchema{Type: "string"}
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer"}
+
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &OpenAPISchema{Type: "string", Format: "int64"}
+
+	case reflect.Float32, reflect.Float64:
+		return &OpenAPISchema{Type: "number"}
+
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: g.schemaOf(t.Elem())}
+
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+
+	case reflect.Interface:
+		// concrete types are registered with Amino and encoded with their name
+		return &OpenAPISchema{
+			Type: "object",
+			Properties: map[string]*OpenAPISchema{
+				"type":  {Type: "string"},
+				"value": {},
+			},
+		}
+
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+
+		name := schemaName(t)
+		if _, ok := g.components[name]; !ok {
+			// the component is reserved before describing recursive types
+			g.components[name] = nil
+			g.components[name] = g.structSchema(t)
+		}
+		return &OpenAPISchema{Ref: "#/components/schemas/" + name}
+
+	default:
+		return &OpenAPISchema{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) *OpenAPISchema {
+	schema := &OpenAPISchema{Type: "object", Properties: make(map[string]*OpenAPISchema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		// Amino names the fields after their JSON tag, or their name
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		schema.Properties[name] = g.schemaOf(field.Type)
+	}
+
+	return schema
+}
+
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// schemaName returns the name of the schema of a named type, after its package
+// path relative to the SDK, e.g. x.bank.types.Balance.
+func schemaName(t reflect.Type) string {
+	pkg := strings.TrimPrefix(t.PkgPath(), "github.com/cosmos/cosmos-sdk/")
+	return schemaNameRegex.ReplaceAllString(strings.Replace(pkg, "/", ".", -1)+"."+t.Name(), "_")
+}
+
+func jsonContent(schema *OpenAPISchema) map[string]OpenAPIMediaType {
+	return map[string]OpenAPIMediaType{"application/json": {Schema: schema}}
+}
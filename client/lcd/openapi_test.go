@@ -0,0 +1,87 @@
+package lcd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenerateOpenAPIModuleRoutes(t *testing.T) {
+	cliCtx := context.CLIContext{}.WithCodec(codec.New())
+
+	r := mux.NewRouter()
+	client.RegisterRoutes(cliCtx, r)
+	authrest.RegisterTxRoutes(cliCtx, r)
+	simapp.ModuleBasics.RegisterRESTRoutes(cliCtx, r)
+
+	// every route registered by the modules must be documented
+	doc, err := GenerateOpenAPI(r)
+	require.NoError(t, err)
+
+	balances := doc.Paths["/bank/balances/{address}"]["get"]
+	require.NotNil(t, balances)
+	require.Equal(t, []string{"bank"}, balances.Tags)
+	require.Equal(t, "address", balances.Parameters[0].Name)
+	require.Equal(t, "path", balances.Parameters[0].In)
+
+	result := balances.Responses["200"].Content["application/json"].Schema
+	require.Equal(t, &OpenAPISchema{Type: "string", Format: "int64"}, result.Properties["height"])
+	require.Equal(t, "array", result.Properties["result"].Type)
+	require.Equal(t, "#/components/schemas/types.Coin", result.Properties["result"].Items.Ref)
+
+	coin := doc.Components.Schemas["types.Coin"]
+	require.Equal(t, &OpenAPISchema{Type: "string"}, coin.Properties["denom"])
+	require.Equal(t, &OpenAPISchema{Type: "string"}, coin.Properties["amount"])
+
+	send := doc.Paths["/bank/accounts/{address}/transfers"]["post"]
+	require.NotNil(t, send)
+	require.NotNil(t, send.RequestBody)
+
+	// proposal routes are documented by the modules handling the proposals
+	require.NotNil(t, doc.Paths["/gov/proposals/param_change"]["post"])
+	require.NotNil(t, doc.Paths["/gov/proposals/upgrade"]["post"])
+
+	_, err = json.Marshal(doc)
+	require.NoError(t, err)
+}
+
+func TestGenerateOpenAPIUndocumentedRoute(t *testing.T) {
+	r := mux.NewRouter()
+	rest.Document(r.HandleFunc("/documented", func(http.ResponseWriter, *http.Request) {}).Methods("GET"), rest.RouteDoc{
+		Summary:  "documented",
+		Response: banktypes.Balance{},
+	})
+	r.HandleFunc("/undocumented/{id}", func(http.ResponseWriter, *http.Request) {}).Methods("POST")
+
+	doc, err := GenerateOpenAPI(r)
+	require.EqualError(t, err, "REST routes without documentation: POST /undocumented/{id}")
+	require.NotNil(t, doc.Paths["/documented"]["get"])
+	require.Nil(t, doc.Paths["/undocumented/{id}"])
+
+	schema := doc.Components.Schemas["x.bank.types.Balance"]
+	require.NotNil(t, schema)
+	require.Equal(t, &OpenAPISchema{Type: "string"}, schema.Properties["address"])
+	require.Equal(t, "array", schema.Properties["coins"].Type)
+
+	// the document is served as JSON
+	w := httptest.NewRecorder()
+	OpenAPIHandler(doc)(w, httptest.NewRequest("GET", OpenAPIPath, nil))
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var served OpenAPI
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &served))
+	require.Equal(t, "3.0.0", served.OpenAPI)
+	require.Contains(t, served.Paths, "/documented")
+}
@@ -88,6 +88,7 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 			rs := NewRestServer(cdc)
 
 			registerRoutesFn(rs)
+			rs.registerOpenAPI()
 			rs.registerSwaggerUI()
 
 			// Start the rest server and return error if one exists
@@ -106,6 +107,20 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 	return flags.RegisterRestServerFlags(cmd)
 }
 
+// registerOpenAPI serves the OpenAPI document generated from the documentation
+// of the routes of the server.
+func (rs *RestServer) registerOpenAPI() {
+	doc, err := GenerateOpenAPI(rs.Mux)
+	if doc == nil {
+		panic(err)
+	}
+	if err != nil {
+		rs.log.Error("the OpenAPI document is incomplete", "err", err)
+	}
+
+	rs.Mux.HandleFunc(OpenAPIPath, OpenAPIHandler(doc)).Methods("GET")
+}
+
 func (rs *RestServer) registerSwaggerUI() {
 	statikFS, err := fs.New()
 	if err != nil {
@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	// eventsCapacity is the number of events of a subscription buffered until
+	// they are streamed.
+	eventsCapacity = 100
+
+	// MaxCatchUpBlocks is the maximum number of blocks a block stream sends
+	// before the new blocks, e.g. from the from_height query parameter.
+	MaxCatchUpBlocks = 100
+
+	wsWriteWait  = 10 * time.Second
+	wsPongWait   = 60 * time.Second
+	wsPingPeriod = wsPongWait * 9 / 10
+)
+
+var upgrader = websocket.Upgrader{CheckOrigin: checkOrigin}
+
+// ErrSubscriptionClosed is returned when an event subscription closed by the
+// node cannot be renewed.
+var ErrSubscriptionClosed = errors.New("the event subscription was closed by the node")
+
+// EventStream streams messages with the given send function until the context
+// is done, in which case it returns nil, or until it fails.
+type EventStream func(ctx gocontext.Context, send func(msg []byte) error) error
+
+// ServeEvents upgrades the request to a websocket connection and writes the
+// messages of the stream to it until the client closes the connection. An
+// error of the stream is written as an ErrorResponse before the connection is
+// closed.
+func ServeEvents(w http.ResponseWriter, r *http.Request, stream EventStream) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader replied with an HTTP error
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := gocontext.WithCancel(r.Context())
+	defer cancel()
+
+	var mtx sync.Mutex
+	write := func(messageType int, data []byte) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+		return conn.WriteMessage(messageType, data)
+	}
+
+	// the messages of the client are discarded, reading them handles the pongs
+	// and the closing of the connection
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(wsPingPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := write(websocket.PingMessage, nil); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = stream(ctx, func(msg []byte) error { return write(websocket.TextMessage, msg) })
+	if ctx.Err() != nil {
+		return
+	}
+
+	closeCode := websocket.CloseNormalClosure
+	if err != nil {
+		closeCode = websocket.CloseInternalServerErr
+		write(websocket.TextMessage, codec.Cdc.MustMarshalJSON(rest.NewErrorResponse(0, err.Error())))
+	}
+	write(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, ""))
+}
+
+// checkOrigin accepts the websocket connections of the origin of the server,
+// and of any origin when CORS is enabled.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || viper.GetBool(flags.FlagUnsafeCORS) {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// StreamBlocks sends the blocks of the chain in the JSON format of the block
+// query until the context is done. The blocks from the given height are sent
+// first when it is positive, and the blocks following the latest block
+// otherwise. The blocks missed while the subscription to the node is renewed
+// are sent before the new blocks. Blocks are verified unless the node is
+// trusted. It fails when more than MaxCatchUpBlocks blocks are to be sent
+// before the new blocks.
+func StreamBlocks(ctx gocontext.Context, cliCtx context.CLIContext, fromHeight int64, send func(msg []byte) error) error {
+	sub, err := SubscribeEvents(ctx, cliCtx, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return err
+	}
+
+	height, err := GetChainHeight(cliCtx)
+	if err != nil {
+		return err
+	}
+
+	next := fromHeight
+	if next <= 0 {
+		next = height + 1
+	}
+
+	sendUntil := func(height int64) error {
+		if height-next >= MaxCatchUpBlocks {
+			return fmt.Errorf("%d blocks from height %d to send, more than the limit of %d",
+				height-next+1, next, MaxCatchUpBlocks)
+		}
+
+		for ; next <= height; next++ {
+			h := next
+			bz, err := getBlock(cliCtx, &h)
+			if err != nil {
+				return err
+			}
+			if err := send(bz); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// catch up with the chain before sending the new blocks
+	if err := sendUntil(height); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-sub.Missed:
+			height, err := GetChainHeight(cliCtx)
+			if err != nil {
+				return err
+			}
+			if err := sendUntil(height); err != nil {
+				return err
+			}
+
+		case event, ok := <-sub.Events:
+			if !ok {
+				return ErrSubscriptionClosed
+			}
+
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+
+			// blocks missed in the meantime are sent first
+			if err := sendUntil(data.Block.Height); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeBlocksRequestHandlerFn returns the REST handler streaming the blocks
+// of the chain through a websocket, in the JSON format of /blocks/{height}. The
+// from_height query parameter resumes the stream from a given height.
+func SubscribeBlocksRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fromHeight, ok := ParseFromHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		ServeEvents(w, r, func(ctx gocontext.Context, send func(msg []byte) error) error {
+			return StreamBlocks(ctx, cliCtx, fromHeight, send)
+		})
+	}
+}
+
+// ParseFromHeightOrReturnBadRequest parses the optional from_height query
+// parameter of an event subscription, which is zero when not set.
+func ParseFromHeightOrReturnBadRequest(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	fromHeight := r.FormValue("from_height")
+	if fromHeight == "" {
+		return 0, true
+	}
+
+	height, ok := rest.ParseInt64OrReturnBadRequest(w, fromHeight)
+	if !ok {
+		return 0, false
+	}
+	if height < 0 {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "from_height must not be negative")
+		return 0, false
+	}
+
+	return height, true
+}
@@ -2,16 +2,47 @@ package rpc
 
 import (
 	"github.com/gorilla/mux"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
 // Register REST endpoints
 func RegisterRPCRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/node_info", NodeInfoRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/syncing", NodeSyncingRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/blocks/latest", LatestBlockRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/blocks/{height}", BlockRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/validatorsets/latest", LatestValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/validatorsets/{height}", ValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
+	rest.Document(r.HandleFunc("/node_info", NodeInfoRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:  "Get the information of the node and of the application version",
+		Response: NodeInfoResponse{},
+	})
+	rest.Document(r.HandleFunc("/syncing", NodeSyncingRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:  "Get whether the node is syncing",
+		Response: SyncingResponse{},
+	})
+	rest.Document(r.HandleFunc("/blocks/latest", LatestBlockRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:  "Get the latest block",
+		Response: ctypes.ResultBlock{},
+	})
+	rest.Document(r.HandleFunc("/blocks/subscribe", SubscribeBlocksRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary: "Stream the blocks of the chain through a websocket",
+		Description: "Each message of the websocket is a block. The blocks from from_height, at most 100 blocks behind " +
+			"the latest block, are sent before the new blocks.",
+		QueryParams: []rest.QueryParam{
+			{Name: "from_height", Description: "height of the first block to send", Type: "integer"},
+		},
+		Response: ctypes.ResultBlock{},
+	})
+	rest.Document(r.HandleFunc("/blocks/{height}", BlockRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:  "Get the block at a height",
+		Response: ctypes.ResultBlock{},
+	})
+	rest.Document(r.HandleFunc("/validatorsets/latest", LatestValidatorSetRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the latest validator set",
+		QueryParams: []rest.QueryParam{rest.PageParam, rest.LimitParam},
+		Result:      ResultValidatorsOutput{},
+	})
+	rest.Document(r.HandleFunc("/validatorsets/{height}", ValidatorSetRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the validator set at a height",
+		QueryParams: []rest.QueryParam{rest.PageParam, rest.LimitParam},
+		Result:      ResultValidatorsOutput{},
+	})
 }
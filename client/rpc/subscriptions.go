@@ -0,0 +1,506 @@
+package rpc
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpclib "github.com/tendermint/tendermint/rpc/lib/client"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+const (
+	// maxResubscribeAttempts is the number of times a subscription closed by the
+	// node is renewed before its subscribers are closed.
+	maxResubscribeAttempts = 10
+
+	resubscribeDelay = time.Second
+)
+
+// MaxSubscriptionsPerConnection is the number of queries subscribed to through
+// a websocket connection to a node, which is the max_subscriptions_per_client
+// of the default Tendermint RPC config. The hub of a node opens another
+// connection for the queries beyond it, within the max_subscription_clients
+// connections accepted by the node.
+var MaxSubscriptionsPerConnection = 5
+
+var (
+	hubsMtx sync.Mutex
+	// hubs multiplexes the subscriptions to the nodes reached through their RPC
+	// URI, by URI
+	hubs = make(map[string]*eventHub)
+
+	// hubNames numbers the hubs, which subscribe under distinct names
+	hubNames uint64
+)
+
+// Subscription is a subscription to the events of a node matching a query.
+type Subscription struct {
+	// Events receives the events matching the query. It is closed when the
+	// subscription cannot be renewed after the node closed it.
+	Events <-chan ctypes.ResultEvent
+
+	// Missed receives a value when events may have been missed, because the
+	// connection to the node was lost or the events were not received in time.
+	// The subscription is renewed by then, so that the missed events can be
+	// queried.
+	Missed <-chan struct{}
+}
+
+// SubscribeEvents subscribes to the events of the node of the CLIContext
+// matching the given Tendermint query until the context is done. The
+// subscriptions to a node reached through its RPC URI share websocket
+// connections, which subscribe to at most MaxSubscriptionsPerConnection queries
+// each, and the node is subscribed to once per query whatever the number of
+// subscribers. Without an RPC URI, the client of the CLIContext subscribes to
+// the query.
+func SubscribeEvents(ctx gocontext.Context, cliCtx context.CLIContext, query string) (Subscription, error) {
+	if cliCtx.NodeURI == "" {
+		node, err := cliCtx.GetNode()
+		if err != nil {
+			return Subscription{}, err
+		}
+
+		return newEventHub(dialClient(node)).subscribe(ctx, query)
+	}
+
+	return getNodeHub(cliCtx.NodeURI).subscribe(ctx, query)
+}
+
+// getNodeHub returns the hub of the subscriptions to the node of the given RPC
+// URI, which connects to the node on demand.
+func getNodeHub(nodeURI string) *eventHub {
+	hubsMtx.Lock()
+	defer hubsMtx.Unlock()
+
+	hub, ok := hubs[nodeURI]
+	if !ok {
+		hub = newEventHub(func() (rpcclient.EventsClient, error) {
+			return newWSEvents(nodeURI)
+		})
+		hubs[nodeURI] = hub
+	}
+
+	return hub
+}
+
+// dialClient returns the dial function of a hub connecting to the node through
+// the given client only, so that the hub subscribes to at most
+// MaxSubscriptionsPerConnection queries.
+func dialClient(node rpcclient.EventsClient) func() (rpcclient.EventsClient, error) {
+	dialed := false
+	return func() (rpcclient.EventsClient, error) {
+		if dialed {
+			return nil, fmt.Errorf(
+				"cannot subscribe to more than %d queries through the client of the node", MaxSubscriptionsPerConnection,
+			)
+		}
+
+		dialed = true
+		return node, nil
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// subscriber is a subscriber to the events of a hub query.
+type subscriber struct {
+	events chan ctypes.ResultEvent
+	missed chan struct{}
+}
+
+// send sends the event to the subscriber, or notifies the subscriber that it
+// missed the event if its buffer is full.
+func (s *subscriber) send(event ctypes.ResultEvent) {
+	select {
+	case s.events <- event:
+	default:
+		s.miss()
+	}
+}
+
+// miss notifies the subscriber that it missed events.
+func (s *subscriber) miss() {
+	select {
+	case s.missed <- struct{}{}:
+	default:
+	}
+}
+
+// hubQuery is a subscription of a hub to the node.
+type hubQuery struct {
+	conn        *hubConn
+	subscribers map[*subscriber]struct{}
+	// done is closed when the last subscriber unsubscribes
+	done chan struct{}
+}
+
+// hubConn is a connection of a hub to the node.
+type hubConn struct {
+	node rpcclient.EventsClient
+	// queries is the number of queries subscribed to through the connection
+	queries int
+}
+
+// running returns false if the connection failed for good.
+func (c *hubConn) running() bool {
+	if ws, ok := c.node.(*wsEvents); ok {
+		return ws.IsRunning()
+	}
+
+	return true
+}
+
+// eventHub multiplexes the subscriptions to the events of a node, so that the
+// node is subscribed to once per query, over connections subscribing to at
+// most MaxSubscriptionsPerConnection queries each.
+type eventHub struct {
+	// dial opens a new connection to the node
+	dial func() (rpcclient.EventsClient, error)
+	name string
+
+	mtx     sync.Mutex
+	conns   []*hubConn
+	queries map[string]*hubQuery
+}
+
+func newEventHub(dial func() (rpcclient.EventsClient, error)) *eventHub {
+	return &eventHub{
+		dial:    dial,
+		name:    fmt.Sprintf("rest-server-%d", atomic.AddUint64(&hubNames, 1)),
+		queries: make(map[string]*hubQuery),
+	}
+}
+
+// conn returns a running connection subscribing to less than
+// MaxSubscriptionsPerConnection queries, connecting to the node again if there
+// is none. The failed connections without queries are dropped.
+func (h *eventHub) conn() (*hubConn, error) {
+	conns := h.conns[:0]
+	for _, c := range h.conns {
+		if c.running() || c.queries > 0 {
+			conns = append(conns, c)
+		}
+	}
+	h.conns = conns
+
+	for _, c := range h.conns {
+		if c.running() && c.queries < MaxSubscriptionsPerConnection {
+			return c, nil
+		}
+	}
+
+	node, err := h.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &hubConn{node: node}
+	h.conns = append(h.conns, c)
+	return c, nil
+}
+
+// subscribe subscribes to the events matching the query until the context is
+// done. The node is subscribed to on the first subscription to the query.
+func (h *eventHub) subscribe(ctx gocontext.Context, query string) (Subscription, error) {
+	sub := &subscriber{
+		events: make(chan ctypes.ResultEvent, eventsCapacity),
+		missed: make(chan struct{}, 1),
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	q, ok := h.queries[query]
+	if !ok {
+		conn, err := h.conn()
+		if err != nil {
+			return Subscription{}, err
+		}
+
+		subscribeCtx, cancel := gocontext.WithTimeout(gocontext.Background(), wsWriteWait)
+		defer cancel()
+
+		events, err := conn.node.Subscribe(subscribeCtx, h.name, query, eventsCapacity)
+		if err != nil {
+			return Subscription{}, err
+		}
+		conn.queries++
+
+		q = &hubQuery{conn: conn, subscribers: make(map[*subscriber]struct{}), done: make(chan struct{})}
+		h.queries[query] = q
+		go h.forward(query, q, events)
+	}
+	q.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		h.unsubscribe(query, sub)
+	}()
+
+	return Subscription{Events: sub.events, Missed: sub.missed}, nil
+}
+
+// unsubscribe removes the subscriber of the query, and unsubscribes from the
+// node when it was the last one.
+func (h *eventHub) unsubscribe(query string, sub *subscriber) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	q, ok := h.queries[query]
+	if !ok {
+		return
+	}
+	if _, ok := q.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(q.subscribers, sub)
+	if len(q.subscribers) > 0 {
+		return
+	}
+
+	delete(h.queries, query)
+	close(q.done)
+	q.conn.queries--
+
+	unsubscribeCtx, cancel := gocontext.WithTimeout(gocontext.Background(), wsWriteWait)
+	defer cancel()
+	q.conn.node.Unsubscribe(unsubscribeCtx, h.name, query)
+}
+
+// forward sends the events of the node to the subscribers of the query until
+// the last one unsubscribes, renewing the subscription when the node closes it.
+func (h *eventHub) forward(query string, q *hubQuery, events <-chan ctypes.ResultEvent) {
+	for {
+		select {
+		case <-q.done:
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				if events = h.resubscribe(query, q); events == nil {
+					return
+				}
+				continue
+			}
+
+			h.mtx.Lock()
+			for sub := range q.subscribers {
+				sub.send(event)
+			}
+			h.mtx.Unlock()
+		}
+	}
+}
+
+// resubscribe renews the subscription of the query after the node closed it and
+// notifies the subscribers that they may have missed events. It closes the
+// subscribers and returns nil if the subscription cannot be renewed.
+func (h *eventHub) resubscribe(query string, q *hubQuery) <-chan ctypes.ResultEvent {
+	for attempt := 0; attempt < maxResubscribeAttempts; attempt++ {
+		select {
+		case <-q.done:
+			return nil
+		case <-time.After(resubscribeDelay):
+		}
+
+		events, ok, err := h.renew(query, q)
+		if err != nil {
+			continue
+		}
+		if !ok {
+			return nil
+		}
+
+		return events
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.queries[query] == q {
+		delete(h.queries, query)
+		close(q.done)
+		q.conn.queries--
+		for sub := range q.subscribers {
+			close(sub.events)
+		}
+	}
+
+	return nil
+}
+
+// renew subscribes again to the query, through another connection if its
+// connection failed for good, and notifies its subscribers that they may have
+// missed events. It returns false if the last subscriber unsubscribed in the
+// meantime.
+func (h *eventHub) renew(query string, q *hubQuery) (<-chan ctypes.ResultEvent, bool, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.queries[query] != q {
+		return nil, false, nil
+	}
+
+	if !q.conn.running() {
+		conn, err := h.conn()
+		if err != nil {
+			return nil, false, err
+		}
+
+		q.conn.queries--
+		conn.queries++
+		q.conn = conn
+	}
+
+	subscribeCtx, cancel := gocontext.WithTimeout(gocontext.Background(), wsWriteWait)
+	defer cancel()
+
+	events, err := q.conn.node.Subscribe(subscribeCtx, h.name, query, eventsCapacity)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for sub := range q.subscribers {
+		sub.miss()
+	}
+
+	return events, true, nil
+}
+
+//-----------------------------------------------------------------------------
+
+// wsEvents subscribes to the events of a node through a websocket connection.
+// Unlike the websocket client of Tendermint, which subscribes again silently
+// when it reconnects, it closes the event channels of its subscriptions when
+// the connection is lost or a channel is full, so that the hub renews the
+// subscriptions and notifies their subscribers of the missed events.
+type wsEvents struct {
+	*rpclib.WSClient
+	cdc *amino.Codec
+
+	mtx  sync.Mutex
+	subs map[string]chan ctypes.ResultEvent
+}
+
+var _ rpcclient.EventsClient = (*wsEvents)(nil)
+
+func newWSEvents(nodeURI string) (*wsEvents, error) {
+	w := &wsEvents{
+		cdc:  amino.NewCodec(),
+		subs: make(map[string]chan ctypes.ResultEvent),
+	}
+	ctypes.RegisterAmino(w.cdc)
+
+	ws, err := rpclib.NewWSClient(nodeURI, "/websocket", rpclib.OnReconnect(w.closeAll))
+	if err != nil {
+		return nil, err
+	}
+	ws.SetCodec(w.cdc)
+	if err := ws.Start(); err != nil {
+		return nil, err
+	}
+
+	w.WSClient = ws
+	go w.listen()
+	return w, nil
+}
+
+// Subscribe implements EventsClient. The subscriber is ignored, as the node
+// identifies the subscriptions of a websocket connection by its address.
+func (w *wsEvents) Subscribe(
+	ctx gocontext.Context, _, query string, outCapacity ...int,
+) (<-chan ctypes.ResultEvent, error) {
+
+	if !w.IsRunning() {
+		return nil, errors.New("the websocket connection to the node failed")
+	}
+
+	outCap := 1
+	if len(outCapacity) > 0 {
+		outCap = outCapacity[0]
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if err := w.WSClient.Subscribe(ctx, query); err != nil {
+		return nil, err
+	}
+
+	out := make(chan ctypes.ResultEvent, outCap)
+	w.subs[query] = out
+	return out, nil
+}
+
+// Unsubscribe implements EventsClient.
+func (w *wsEvents) Unsubscribe(ctx gocontext.Context, _, query string) error {
+	w.mtx.Lock()
+	delete(w.subs, query)
+	w.mtx.Unlock()
+
+	return w.WSClient.Unsubscribe(ctx, query)
+}
+
+// UnsubscribeAll implements EventsClient.
+func (w *wsEvents) UnsubscribeAll(ctx gocontext.Context, _ string) error {
+	w.mtx.Lock()
+	w.subs = make(map[string]chan ctypes.ResultEvent)
+	w.mtx.Unlock()
+
+	return w.WSClient.UnsubscribeAll(ctx)
+}
+
+// closeAll closes the event channels of all the subscriptions.
+func (w *wsEvents) closeAll() {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	for query, out := range w.subs {
+		delete(w.subs, query)
+		close(out)
+	}
+}
+
+// listen sends the events received from the node to the channels of their
+// subscriptions until the client stops.
+func (w *wsEvents) listen() {
+	defer w.closeAll()
+
+	for resp := range w.ResponsesCh {
+		if resp.Error != nil {
+			// the node failed a subscription, which is renewed unless it exists
+			if !strings.Contains(resp.Error.Error(), tmpubsub.ErrAlreadySubscribed.Error()) {
+				w.closeAll()
+			}
+			continue
+		}
+
+		var event ctypes.ResultEvent
+		if err := w.cdc.UnmarshalJSON(resp.Result, &event); err != nil || event.Query == "" {
+			// not an event, e.g. the response to a subscription
+			continue
+		}
+
+		w.mtx.Lock()
+		if out, ok := w.subs[event.Query]; ok {
+			select {
+			case out <- event:
+			default:
+				delete(w.subs, event.Query)
+				close(out)
+			}
+		}
+		w.mtx.Unlock()
+	}
+}
@@ -0,0 +1,236 @@
+package rpc
+
+import (
+	gocontext "context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// eventsNode is an in-process node recording its subscriptions.
+type eventsNode struct {
+	mock.Client
+
+	height        int64
+	subscriptions chan chan ctypes.ResultEvent
+
+	mtx          sync.Mutex
+	subscribed   int
+	unsubscribed int
+}
+
+func newEventsNode() *eventsNode {
+	return &eventsNode{subscriptions: make(chan chan ctypes.ResultEvent, 2*MaxSubscriptionsPerConnection)}
+}
+
+func (n *eventsNode) Subscribe(
+	ctx gocontext.Context, subscriber, query string, outCapacity ...int,
+) (<-chan ctypes.ResultEvent, error) {
+
+	n.mtx.Lock()
+	n.subscribed++
+	n.mtx.Unlock()
+
+	events := make(chan ctypes.ResultEvent, 1)
+	n.subscriptions <- events
+	return events, nil
+}
+
+func (n *eventsNode) Unsubscribe(ctx gocontext.Context, subscriber, query string) error {
+	n.mtx.Lock()
+	n.unsubscribed++
+	n.mtx.Unlock()
+	return nil
+}
+
+func (n *eventsNode) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: n.height}}, nil
+}
+
+func (n *eventsNode) counts() (int, int) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.subscribed, n.unsubscribed
+}
+
+func receiveEvent(t *testing.T, events <-chan ctypes.ResultEvent) ctypes.ResultEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+	return ctypes.ResultEvent{}
+}
+
+func TestEventHubMultiplexing(t *testing.T) {
+	node := newEventsNode()
+	hub := newEventHub(dialClient(node))
+	query := tmtypes.EventQueryNewBlock.String()
+
+	ctx1, cancel1 := gocontext.WithCancel(gocontext.Background())
+	defer cancel1()
+	sub1, err := hub.subscribe(ctx1, query)
+	require.NoError(t, err)
+	events := <-node.subscriptions
+
+	// the second subscription to the query shares the subscription to the node
+	ctx2, cancel2 := gocontext.WithCancel(gocontext.Background())
+	defer cancel2()
+	sub2, err := hub.subscribe(ctx2, query)
+	require.NoError(t, err)
+
+	subscribed, _ := node.counts()
+	require.Equal(t, 1, subscribed)
+
+	events <- ctypes.ResultEvent{Query: query}
+	require.Equal(t, query, receiveEvent(t, sub1.Events).Query)
+	require.Equal(t, query, receiveEvent(t, sub2.Events).Query)
+
+	// the node is unsubscribed from after the last subscriber
+	cancel1()
+	time.Sleep(100 * time.Millisecond)
+	_, unsubscribed := node.counts()
+	require.Zero(t, unsubscribed)
+
+	events <- ctypes.ResultEvent{Query: query}
+	require.Equal(t, query, receiveEvent(t, sub2.Events).Query)
+
+	cancel2()
+	require.Eventually(t, func() bool {
+		_, unsubscribed := node.counts()
+		return unsubscribed == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestEventHubRenewal(t *testing.T) {
+	node := newEventsNode()
+	hub := newEventHub(dialClient(node))
+	query := tmtypes.EventQueryNewBlock.String()
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	defer cancel()
+	sub, err := hub.subscribe(ctx, query)
+	require.NoError(t, err)
+
+	// the subscription closed by the node is renewed and the subscriber is told
+	// that it may have missed events
+	close(<-node.subscriptions)
+
+	var events chan ctypes.ResultEvent
+	select {
+	case events = <-node.subscriptions:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscription was not renewed")
+	}
+
+	select {
+	case <-sub.Missed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscriber was not told about the missed events")
+	}
+
+	events <- ctypes.ResultEvent{Query: query}
+	require.Equal(t, query, receiveEvent(t, sub.Events).Query)
+}
+
+func TestEventHubConnections(t *testing.T) {
+	var nodes []*eventsNode
+	hub := newEventHub(func() (rpcclient.EventsClient, error) {
+		node := newEventsNode()
+		nodes = append(nodes, node)
+		return node, nil
+	})
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	defer cancel()
+
+	// the queries beyond the limit of a connection are subscribed to through
+	// another connection
+	queries := make([]string, MaxSubscriptionsPerConnection+1)
+	subs := make([]Subscription, len(queries))
+	cancels := make([]gocontext.CancelFunc, len(queries))
+	for i := range queries {
+		queries[i] = fmt.Sprintf("tm.event='Tx' AND tx.height=%d", i+1)
+
+		var subCtx gocontext.Context
+		subCtx, cancels[i] = gocontext.WithCancel(ctx)
+		sub, err := hub.subscribe(subCtx, queries[i])
+		require.NoError(t, err)
+		subs[i] = sub
+	}
+
+	require.Len(t, nodes, 2)
+	subscribed, _ := nodes[0].counts()
+	require.Equal(t, MaxSubscriptionsPerConnection, subscribed)
+	subscribed, _ = nodes[1].counts()
+	require.Equal(t, 1, subscribed)
+
+	// the events of each connection are sent to the subscribers of its queries
+	for i, node := range nodes {
+		first := i * MaxSubscriptionsPerConnection
+		events := <-node.subscriptions
+		events <- ctypes.ResultEvent{Query: queries[first]}
+		require.Equal(t, queries[first], receiveEvent(t, subs[first].Events).Query)
+	}
+
+	// the queries unsubscribed from free their connection for other queries
+	cancels[0]()
+	require.Eventually(t, func() bool {
+		_, unsubscribed := nodes[0].counts()
+		return unsubscribed == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err := hub.subscribe(ctx, "tm.event='NewBlock'")
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	subscribed, _ = nodes[0].counts()
+	require.Equal(t, MaxSubscriptionsPerConnection+1, subscribed)
+}
+
+func TestEventHubClientLimit(t *testing.T) {
+	node := newEventsNode()
+	hub := newEventHub(dialClient(node))
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	defer cancel()
+
+	// a single client subscribes to the queries within the limit only
+	for i := 0; i < MaxSubscriptionsPerConnection; i++ {
+		_, err := hub.subscribe(ctx, fmt.Sprintf("tm.event='Tx' AND tx.height=%d", i+1))
+		require.NoError(t, err)
+	}
+
+	_, err := hub.subscribe(ctx, "tm.event='NewBlock'")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf("more than %d queries", MaxSubscriptionsPerConnection))
+
+	// the queries already subscribed to are shared
+	_, err = hub.subscribe(ctx, "tm.event='Tx' AND tx.height=1")
+	require.NoError(t, err)
+}
+
+func TestStreamBlocksCatchUpLimit(t *testing.T) {
+	node := newEventsNode()
+	node.height = 2 * MaxCatchUpBlocks
+	cliCtx := context.CLIContext{}.WithClient(node).WithTrustNode(true)
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	defer cancel()
+
+	err := StreamBlocks(ctx, cliCtx, MaxCatchUpBlocks, func([]byte) error {
+		t.Fatal("no block must be sent")
+		return nil
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "more than the limit")
+}
@@ -0,0 +1,29 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AuthClient is the client of the auth module.
+type AuthClient struct {
+	Client
+}
+
+// Account returns the account of the given address.
+func (c AuthClient) Account(addr sdk.AccAddress) (exported.Account, error) {
+	return c.accountRetriever().GetAccount(addr)
+}
+
+// AccountNumberSequence returns the account number and sequence of the given
+// address.
+func (c AuthClient) AccountNumberSequence(addr sdk.AccAddress) (uint64, uint64, error) {
+	return c.accountRetriever().GetAccountNumberSequence(addr)
+}
+
+// Params returns the parameters of the auth module.
+func (c AuthClient) Params() (params authtypes.Params, err error) {
+	err = c.Query(authtypes.QuerierRoute, authtypes.QueryParams, nil, &params)
+	return params, err
+}
@@ -0,0 +1,44 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankClient is the client of the bank module.
+type BankClient struct {
+	Client
+}
+
+// Balances returns all the balances of the given address.
+func (c BankClient) Balances(addr sdk.AccAddress) (balances sdk.Coins, err error) {
+	params := banktypes.NewQueryAllBalancesParams(addr)
+	err = c.Query(banktypes.QuerierRoute, banktypes.QueryAllBalances, params, &balances)
+	return balances, err
+}
+
+// Balance returns the balance of the given address in the given denomination.
+func (c BankClient) Balance(addr sdk.AccAddress, denom string) (balance sdk.Coin, err error) {
+	params := banktypes.NewQueryBalanceParams(addr, denom)
+	err = c.Query(banktypes.QuerierRoute, banktypes.QueryBalance, params, &balance)
+	return balance, err
+}
+
+// Send returns a message sending the given amount from the key of the client
+// to the given address.
+func (c BankClient) Send(to sdk.AccAddress, amount sdk.Coins) banktypes.MsgSend {
+	return banktypes.NewMsgSend(c.FromAddress(), to, amount)
+}
+
+// MultiSend returns a message sending the given amounts from the key of the
+// client to the given outputs.
+func (c BankClient) MultiSend(outputs []banktypes.Output) banktypes.MsgMultiSend {
+	var total sdk.Coins
+	for _, out := range outputs {
+		total = total.Add(out.Coins...)
+	}
+
+	return banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(c.FromAddress(), total)}, outputs,
+	)
+}
@@ -0,0 +1,61 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// CircuitClient is the client of the circuit module.
+type CircuitClient struct {
+	Client
+}
+
+// Accounts returns the permissions of all the accounts allowed to trip or
+// reset the circuit breaker.
+func (c CircuitClient) Accounts() (accounts []circuittypes.AccountPermissions, err error) {
+	err = c.Query(circuittypes.QuerierRoute, circuittypes.QueryAccounts, nil, &accounts)
+	return accounts, err
+}
+
+// Account returns the permissions of the given account.
+func (c CircuitClient) Account(addr sdk.AccAddress) (account circuittypes.AccountPermissions, err error) {
+	params := circuittypes.NewQueryAccountParams(addr)
+	err = c.Query(circuittypes.QuerierRoute, circuittypes.QueryAccount, params, &account)
+	return account, err
+}
+
+// DisabledMsgTypes returns the message types disabled by the circuit breaker.
+func (c CircuitClient) DisabledMsgTypes() (msgTypes []string, err error) {
+	err = c.Query(circuittypes.QuerierRoute, circuittypes.QueryDisabledMsgTypes, nil, &msgTypes)
+	return msgTypes, err
+}
+
+// TripCircuitBreaker returns a message disabling the given message types with
+// the key of the client.
+func (c CircuitClient) TripCircuitBreaker(msgTypes []string) circuittypes.MsgTripCircuitBreaker {
+	return circuittypes.NewMsgTripCircuitBreaker(c.FromAddress(), msgTypes)
+}
+
+// ResetCircuitBreaker returns a message enabling the given message types again
+// with the key of the client.
+func (c CircuitClient) ResetCircuitBreaker(msgTypes []string) circuittypes.MsgResetCircuitBreaker {
+	return circuittypes.NewMsgResetCircuitBreaker(c.FromAddress(), msgTypes)
+}
+
+// TripCircuitBreakerProposal returns the content of a proposal disabling the
+// given message types, to be submitted with the gov client.
+func (c CircuitClient) TripCircuitBreakerProposal(
+	title, description string, msgTypes []string,
+) circuittypes.TripCircuitBreakerProposal {
+
+	return circuittypes.NewTripCircuitBreakerProposal(title, description, msgTypes)
+}
+
+// ResetCircuitBreakerProposal returns the content of a proposal enabling the
+// given message types again, to be submitted with the gov client.
+func (c CircuitClient) ResetCircuitBreakerProposal(
+	title, description string, msgTypes []string,
+) circuittypes.ResetCircuitBreakerProposal {
+
+	return circuittypes.NewResetCircuitBreakerProposal(title, description, msgTypes)
+}
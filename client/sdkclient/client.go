@@ -0,0 +1,372 @@
+/*
+Package sdkclient implements a typed Go client of the modules of a chain.
+
+The client queries the module queriers through the custom ABCI query routes and
+decodes their responses into the module types, e.g.
+
+	balances, err := c.Bank().Balances(addr)
+	delegations, err := c.Staking().Delegations(addr)
+
+There is a module client for each module of the SimApp ModuleBasics with queries
+or messages. Each module client also builds the messages of its module for the
+key the client signs with, and the content of its governance proposals, which
+are submitted with the gov client:
+
+	content := c.Upgrade().SoftwareUpgradeProposal(title, description, plan)
+	res, err := c.SignAndBroadcast(c.Gov().SubmitProposal(content, deposit))
+
+Messages are broadcast with SignAndBroadcast, which looks up the account number
+and sequence of the key, estimates the gas of the transaction, signs it through
+the keybase of the TxBuilder and broadcasts it with the broadcast mode of the
+CLIContext:
+
+	c, err := sdkclient.NewClient(cliCtx, txBldr).WithFrom("alice")
+	res, err := c.SignAndBroadcast(c.Bank().Send(to, amount))
+
+The CLIContext and TxBuilder are used as is, so that the client honours their
+node, chain ID, height, trust, fees and gas settings.
+
+Clients broadcasting transactions faster than they are committed allocate the
+sequences of their transactions with a SequenceManager, which is shared by the
+clients signing with the same keys:
+
+	c = c.WithSequenceManager(authclient.NewSequenceManager(db, querier))
+*/
+package sdkclient
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Client is a typed client of the modules of a chain. It is safe to copy, and
+// its With methods return updated copies.
+type Client struct {
+	cliCtx     context.CLIContext
+	txBldr     authtypes.TxBuilder
+	passphrase string
+	seqs       *authclient.SequenceManager
+}
+
+// NewClient returns a client which queries and broadcasts through the given
+// CLIContext and builds transactions with the given TxBuilder. The CLIContext
+// must hold the application codec. Transactions are encoded with the default
+// encoder of the codec unless the TxBuilder sets one.
+func NewClient(cliCtx context.CLIContext, txBldr authtypes.TxBuilder) Client {
+	if txBldr.TxEncoder() == nil {
+		txBldr = txBldr.WithTxEncoder(authclient.GetTxEncoder(cliCtx.Codec))
+	}
+
+	return Client{cliCtx: cliCtx, txBldr: txBldr, passphrase: keys.DefaultKeyPass}
+}
+
+// CLIContext returns the CLIContext of the client.
+func (c Client) CLIContext() context.CLIContext { return c.cliCtx }
+
+// TxBuilder returns the TxBuilder of the client.
+func (c Client) TxBuilder() authtypes.TxBuilder { return c.txBldr }
+
+// Codec returns the codec of the client.
+func (c Client) Codec() *codec.Codec { return c.cliCtx.Codec }
+
+// WithCLIContext returns a copy of the client with the given CLIContext.
+func (c Client) WithCLIContext(cliCtx context.CLIContext) Client {
+	c.cliCtx = cliCtx
+	return c
+}
+
+// WithTxBuilder returns a copy of the client with the given TxBuilder.
+func (c Client) WithTxBuilder(txBldr authtypes.TxBuilder) Client {
+	c.txBldr = txBldr
+	return c
+}
+
+// WithHeight returns a copy of the client querying the state at the given
+// height. A zero height queries the latest state.
+func (c Client) WithHeight(height int64) Client {
+	c.cliCtx = c.cliCtx.WithHeight(height)
+	return c
+}
+
+// WithBroadcastMode returns a copy of the client broadcasting transactions
+// with the given mode, i.e. sync, async or block.
+func (c Client) WithBroadcastMode(mode string) Client {
+	c.cliCtx = c.cliCtx.WithBroadcastMode(mode)
+	return c
+}
+
+// WithPassphrase returns a copy of the client unlocking its key with the
+// given passphrase. Keyring backends ignore the passphrase.
+func (c Client) WithPassphrase(passphrase string) Client {
+	c.passphrase = passphrase
+	return c
+}
+
+// WithSequenceManager returns a copy of the client allocating the sequences of
+// its transactions with the given SequenceManager. A sequence set on the
+// TxBuilder is kept.
+func (c Client) WithSequenceManager(m *authclient.SequenceManager) Client {
+	c.seqs = m
+	return c
+}
+
+// WithFrom returns a copy of the client signing with the key of the given name
+// of the keybase of the TxBuilder.
+func (c Client) WithFrom(name string) (Client, error) {
+	kb := c.txBldr.Keybase()
+	if kb == nil {
+		return c, fmt.Errorf("the TxBuilder has no keybase")
+	}
+
+	info, err := kb.Get(name)
+	if err != nil {
+		return c, err
+	}
+
+	c.cliCtx = c.cliCtx.WithFromName(info.GetName()).WithFromAddress(info.GetAddress())
+	return c, nil
+}
+
+// FromAddress returns the address of the key the client signs with.
+func (c Client) FromAddress() sdk.AccAddress { return c.cliCtx.GetFromAddress() }
+
+// Auth returns the client of the auth module.
+func (c Client) Auth() AuthClient { return AuthClient{c} }
+
+// Bank returns the client of the bank module.
+func (c Client) Bank() BankClient { return BankClient{c} }
+
+// Supply returns the client of the supply module.
+func (c Client) Supply() SupplyClient { return SupplyClient{c} }
+
+// Staking returns the client of the staking module.
+func (c Client) Staking() StakingClient { return StakingClient{c} }
+
+// Distribution returns the client of the distribution module.
+func (c Client) Distribution() DistributionClient { return DistributionClient{c} }
+
+// Slashing returns the client of the slashing module.
+func (c Client) Slashing() SlashingClient { return SlashingClient{c} }
+
+// Gov returns the client of the gov module.
+func (c Client) Gov() GovClient { return GovClient{c} }
+
+// Mint returns the client of the mint module.
+func (c Client) Mint() MintClient { return MintClient{c} }
+
+// Evidence returns the client of the evidence module.
+func (c Client) Evidence() EvidenceClient { return EvidenceClient{c} }
+
+// Crisis returns the client of the crisis module.
+func (c Client) Crisis() CrisisClient { return CrisisClient{c} }
+
+// Circuit returns the client of the circuit module.
+func (c Client) Circuit() CircuitClient { return CircuitClient{c} }
+
+// Params returns the client of the params module.
+func (c Client) Params() ParamsClient { return ParamsClient{c} }
+
+// Upgrade returns the client of the upgrade module.
+func (c Client) Upgrade() UpgradeClient { return UpgradeClient{c} }
+
+// Query queries the given query of the querier of a module with the given
+// params and decodes the JSON response into res. Nil params send no data.
+func (c Client) Query(route, query string, params, res interface{}) error {
+	bz, err := c.queryRaw(route, query, params)
+	if err != nil {
+		return err
+	}
+
+	return c.cliCtx.Codec.UnmarshalJSON(bz, res)
+}
+
+// queryRaw queries the given query of the querier of a module with the given
+// params and returns the response as is.
+func (c Client) queryRaw(route, query string, params interface{}) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if params != nil {
+		data, err = c.cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, _, err := c.cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", route, query), data)
+	return bz, err
+}
+
+// accountRetriever returns the account retriever of the client.
+func (c Client) accountRetriever() authtypes.AccountRetriever {
+	return authtypes.NewAccountRetriever(codecstd.NewAppCodec(c.cliCtx.Codec), c.cliCtx)
+}
+
+// PrepareTxBuilder returns the TxBuilder of the client with the account number
+// and sequence of the key the client signs with. An account number or sequence
+// set on the TxBuilder is kept.
+func (c Client) PrepareTxBuilder() (authtypes.TxBuilder, error) {
+	from := c.FromAddress()
+	if from.Empty() {
+		return c.txBldr, fmt.Errorf("the client has no key to sign with")
+	}
+
+	txBldr := c.txBldr
+	if txBldr.AccountNumber() == 0 || txBldr.Sequence() == 0 {
+		num, seq, err := c.accountRetriever().GetAccountNumberSequence(from)
+		if err != nil {
+			return txBldr, err
+		}
+
+		if txBldr.AccountNumber() == 0 {
+			txBldr = txBldr.WithAccountNumber(num)
+		}
+		if txBldr.Sequence() == 0 {
+			txBldr = txBldr.WithSequence(seq)
+		}
+	}
+
+	return txBldr, nil
+}
+
+// EstimateGas simulates a transaction of the given messages and returns its
+// gas adjusted by the gas adjustment of the TxBuilder.
+func (c Client) EstimateGas(msgs ...sdk.Msg) (uint64, error) {
+	txBldr, err := c.PrepareTxBuilder()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.estimateGas(txBldr, msgs)
+}
+
+func (c Client) estimateGas(txBldr authtypes.TxBuilder, msgs []sdk.Msg) (uint64, error) {
+	txBytes, err := txBldr.BuildTxForSim(msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	_, adjusted, err := authclient.CalculateGas(c.cliCtx.QueryWithData, c.cliCtx.Codec, txBytes, txBldr.GasAdjustment())
+	return adjusted, err
+}
+
+// Sign returns a signed transaction of the given messages. The gas of the
+// transaction is estimated if the TxBuilder simulates transactions. The
+// response to a transaction signed with a sequence of the SequenceManager of
+// the client must be tracked by the SequenceManager.
+func (c Client) Sign(msgs ...sdk.Msg) ([]byte, error) {
+	txBytes, _, err := c.sign(msgs)
+	return txBytes, err
+}
+
+// sign returns a signed transaction of the given messages and its TxBuilder.
+func (c Client) sign(msgs []sdk.Msg) ([]byte, authtypes.TxBuilder, error) {
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, c.txBldr, err
+		}
+	}
+
+	txBldr, err := c.PrepareTxBuilder()
+	if err != nil {
+		return nil, txBldr, err
+	}
+
+	if c.managesSequence() {
+		num, seq, err := c.seqs.Next(c.FromAddress())
+		if err != nil {
+			return nil, txBldr, err
+		}
+		if c.txBldr.AccountNumber() == 0 {
+			txBldr = txBldr.WithAccountNumber(num)
+		}
+		txBldr = txBldr.WithSequence(seq)
+	}
+
+	if txBldr.SimulateAndExecute() {
+		gas, err := c.estimateGas(txBldr, msgs)
+		if err != nil {
+			return nil, txBldr, c.releaseSequence(txBldr, err)
+		}
+		txBldr = txBldr.WithGas(gas)
+	}
+
+	txBytes, err := txBldr.BuildAndSign(c.cliCtx.GetFromName(), c.passphrase, msgs)
+	if err != nil {
+		return nil, txBldr, c.releaseSequence(txBldr, err)
+	}
+
+	return txBytes, txBldr, nil
+}
+
+// managesSequence returns whether the sequences of the transactions of the
+// client are allocated by its SequenceManager.
+func (c Client) managesSequence() bool {
+	return c.seqs != nil && c.txBldr.Sequence() == 0
+}
+
+// releaseSequence releases the sequence of a transaction which failed with the
+// given error before being broadcast.
+func (c Client) releaseSequence(txBldr authtypes.TxBuilder, err error) error {
+	if c.managesSequence() {
+		if relErr := c.seqs.Release(c.FromAddress(), txBldr.Sequence()); relErr != nil {
+			return fmt.Errorf("%s; failed to release the sequence: %w", err, relErr)
+		}
+	}
+
+	return err
+}
+
+// SignAndBroadcast signs a transaction of the given messages and broadcasts it
+// with the broadcast mode of the CLIContext. Transactions rejected by the node
+// are returned with their response and an error. A transaction whose sequence
+// allocated by the SequenceManager of the client is not the one expected by
+// the node is signed again once with the next sequence.
+func (c Client) SignAndBroadcast(msgs ...sdk.Msg) (sdk.TxResponse, error) {
+	res, seq, err := c.signAndBroadcast(msgs)
+	if err == nil && c.managesSequence() {
+		wrongSeq, ok := authtypes.WrongSequenceFromResponse(res)
+		if ok && wrongSeq.Expected != seq {
+			res, _, err = c.signAndBroadcast(msgs)
+		}
+	}
+	if err != nil {
+		return res, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("transaction %s rejected with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return res, nil
+}
+
+// signAndBroadcast signs and broadcasts a transaction of the given messages,
+// and tracks the response with the SequenceManager of the client. It returns
+// the response and the sequence of the transaction.
+func (c Client) signAndBroadcast(msgs []sdk.Msg) (sdk.TxResponse, uint64, error) {
+	txBytes, txBldr, err := c.sign(msgs)
+	if err != nil {
+		return sdk.TxResponse{}, 0, err
+	}
+
+	res, err := c.cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return res, txBldr.Sequence(), c.releaseSequence(txBldr, err)
+	}
+
+	if c.managesSequence() {
+		if err := c.seqs.Track(c.FromAddress(), txBldr.Sequence(), res); err != nil {
+			return res, txBldr.Sequence(), fmt.Errorf("failed to track the sequence: %w", err)
+		}
+	}
+
+	return res, txBldr.Sequence(), nil
+}
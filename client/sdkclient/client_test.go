@@ -0,0 +1,284 @@
+package sdkclient
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const testChainID = "test-chain"
+
+// appNode is an in-process node of a SimApp which commits a block for each
+// broadcast transaction.
+type appNode struct {
+	mock.Client
+	app *simapp.SimApp
+}
+
+func newAppNode(app *simapp.SimApp) *appNode {
+	n := &appNode{app: app}
+
+	// end the block begun by the setup and commit a block with the chain ID
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	n.commitBlock(nil)
+
+	return n
+}
+
+func (n *appNode) commitBlock(tx []byte) (res abci.ResponseDeliverTx) {
+	header := abci.Header{ChainID: testChainID, Height: n.app.LastBlockHeight() + 1}
+	n.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	if tx != nil {
+		res = n.app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+	n.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	n.app.Commit()
+
+	return res
+}
+
+func (n *appNode) ABCIQueryWithOptions(
+	path string, data tmbytes.HexBytes, opts client.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+
+	res := n.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (n *appNode) BroadcastTxCommit(tx tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	res := &ctypes.ResultBroadcastTxCommit{Hash: tmhash.Sum(tx)}
+
+	res.CheckTx = n.app.CheckTx(abci.RequestCheckTx{Tx: tx})
+	if !res.CheckTx.IsOK() {
+		return res, nil
+	}
+
+	res.DeliverTx = n.commitBlock(tx)
+	res.Height = n.app.LastBlockHeight()
+	return res, nil
+}
+
+func TestClient(t *testing.T) {
+	kb := crkeys.NewInMemory()
+	alice, _, err := kb.CreateMnemonic("alice", crkeys.English, keys.DefaultKeyPass, crkeys.Secp256k1)
+	require.NoError(t, err)
+	bob, _, err := kb.CreateMnemonic("bob", crkeys.English, keys.DefaultKeyPass, crkeys.Secp256k1)
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+	app := simapp.SetupWithGenesisAccounts(
+		[]authexported.GenesisAccount{auth.NewBaseAccount(alice.GetAddress(), nil, 0, 0)},
+		bank.Balance{Address: alice.GetAddress(), Coins: coins},
+	)
+
+	cliCtx := context.CLIContext{}.
+		WithCodec(app.Codec()).
+		WithClient(newAppNode(app)).
+		WithTrustNode(true).
+		WithChainID(testChainID).
+		WithBroadcastMode(flags.BroadcastBlock)
+	txBldr := authtypes.NewTxBuilder(nil, 0, 0, 0, 1.5, true, testChainID, "", nil, nil).WithKeybase(kb)
+
+	c, err := NewClient(cliCtx, txBldr).WithFrom("alice")
+	require.NoError(t, err)
+	require.Equal(t, alice.GetAddress(), c.FromAddress())
+
+	_, err = c.WithFrom("unknown")
+	require.Error(t, err)
+	_, err = NewClient(cliCtx, txBldr).SignAndBroadcast(c.Bank().Send(bob.GetAddress(), coins))
+	require.Error(t, err)
+
+	// queries
+	balances, err := c.Bank().Balances(alice.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, coins, balances)
+
+	acc, err := c.Auth().Account(alice.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), acc.GetSequence())
+
+	params, err := c.Staking().Params()
+	require.NoError(t, err)
+	require.Equal(t, sdk.DefaultBondDenom, params.BondDenom)
+
+	// messages are signed with the looked up sequence and simulated gas
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	gas, err := c.EstimateGas(c.Bank().Send(bob.GetAddress(), amount))
+	require.NoError(t, err)
+	require.NotZero(t, gas)
+
+	res, err := c.SignAndBroadcast(c.Bank().Send(bob.GetAddress(), amount))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.TxHash)
+
+	balance, err := c.Bank().Balance(bob.GetAddress(), sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, amount[0], balance)
+
+	_, seq, err := c.Auth().AccountNumberSequence(alice.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seq)
+
+	valAddr := sdk.ValAddress(alice.GetAddress())
+	selfDelegation := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+	_, err = c.SignAndBroadcast(c.Staking().CreateValidator(
+		ed25519.GenPrivKey().PubKey(), selfDelegation, stakingtypes.NewDescription("alice", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	))
+	require.NoError(t, err)
+
+	validator, err := c.Staking().Validator(valAddr)
+	require.NoError(t, err)
+	require.Equal(t, "alice", validator.GetMoniker())
+
+	validators, err := c.Staking().Validators(sdk.BondStatusBonded, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+
+	delegations, err := c.Staking().Delegations(alice.GetAddress())
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Equal(t, selfDelegation, delegations[0].Balance)
+
+	// the client of another key signs with its own account
+	bobClient, err := c.WithFrom("bob")
+	require.NoError(t, err)
+	delegation := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	_, err = bobClient.SignAndBroadcast(bobClient.Staking().Delegate(valAddr, delegation))
+	require.NoError(t, err)
+
+	delegations, err = c.Staking().ValidatorDelegations(valAddr)
+	require.NoError(t, err)
+	require.Len(t, delegations, 2)
+
+	del, err := c.Staking().Delegation(bob.GetAddress(), valAddr)
+	require.NoError(t, err)
+	require.Equal(t, delegation, del.Balance)
+
+	// rejected transactions are returned with an error
+	res, err = bobClient.SignAndBroadcast(bobClient.Bank().Send(alice.GetAddress(), coins))
+	require.Error(t, err)
+	require.NotZero(t, res.Code)
+
+	// invalid messages are not broadcast
+	_, err = c.SignAndBroadcast(c.Bank().Send(bob.GetAddress(), sdk.Coins{}))
+	require.Error(t, err)
+
+	// a transaction whose allocated sequence was used by other transactions
+	// is signed again with the next sequence
+	seqs := authclient.NewSequenceManager(dbm.NewMemDB(), c.Auth().AccountNumberSequence)
+	_, _, err = seqs.Next(alice.GetAddress())
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = c.SignAndBroadcast(c.Bank().Send(bob.GetAddress(), amount))
+		require.NoError(t, err)
+	}
+
+	res, err = c.WithSequenceManager(seqs).SignAndBroadcast(c.Bank().Send(bob.GetAddress(), amount))
+	require.NoError(t, err)
+	require.NotEmpty(t, res.TxHash)
+
+	_, seq, err = c.Auth().AccountNumberSequence(alice.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), seq)
+
+	_, seq, err = seqs.Next(alice.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), seq)
+}
+
+func TestModuleClients(t *testing.T) {
+	app := simapp.Setup(false)
+	cliCtx := context.CLIContext{}.
+		WithCodec(app.Codec()).
+		WithClient(newAppNode(app)).
+		WithTrustNode(true).
+		WithChainID(testChainID)
+	c := NewClient(cliCtx, authtypes.NewTxBuilder(nil, 0, 0, 0, 1.5, false, testChainID, "", nil, nil))
+
+	mintParams, err := c.Mint().Params()
+	require.NoError(t, err)
+	require.Equal(t, minttypes.DefaultParams(), mintParams)
+	inflation, err := c.Mint().Inflation()
+	require.NoError(t, err)
+	require.True(t, inflation.IsPositive())
+
+	evidenceParams, err := c.Evidence().Params()
+	require.NoError(t, err)
+	require.Equal(t, evidencetypes.DefaultParams(), evidenceParams)
+	evidence, err := c.Evidence().AllEvidence(1, 10)
+	require.NoError(t, err)
+	require.Empty(t, evidence)
+
+	disabledModules, err := c.Crisis().DisabledModules()
+	require.NoError(t, err)
+	require.Empty(t, disabledModules)
+
+	disabledMsgTypes, err := c.Circuit().DisabledMsgTypes()
+	require.NoError(t, err)
+	require.Empty(t, disabledMsgTypes)
+
+	results, err := c.Params().DryRun([]paramproposal.ParamChange{
+		paramproposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "105"),
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "105", string(results[0].NewValue))
+
+	_, found, err := c.Upgrade().CurrentPlan()
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = c.Upgrade().Readiness()
+	require.NoError(t, err)
+	require.False(t, found)
+	height, err := c.Upgrade().AppliedHeight("test")
+	require.NoError(t, err)
+	require.Zero(t, height)
+	versions, err := c.Upgrade().ModuleVersions()
+	require.NoError(t, err)
+	require.Contains(t, versions, upgradetypes.NewModuleVersion(upgradetypes.ModuleName, 2))
+}
+
+func TestClientCoversModuleBasics(t *testing.T) {
+	clientType := reflect.TypeOf(Client{})
+	for name := range simapp.ModuleBasics {
+		// genutil has neither queries nor messages
+		if name == genutiltypes.ModuleName {
+			continue
+		}
+
+		_, ok := clientType.MethodByName(strings.Title(name))
+		require.True(t, ok, "no client for the %s module", name)
+	}
+}
@@ -0,0 +1,44 @@
+package sdkclient
+
+import (
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// CrisisClient is the client of the crisis module.
+type CrisisClient struct {
+	Client
+}
+
+// InvariantResults returns the results of the latest checks of all the
+// invariants.
+func (c CrisisClient) InvariantResults() (results []crisistypes.InvariantResult, err error) {
+	err = c.Query(crisistypes.QuerierRoute, crisistypes.QueryInvariantResults, nil, &results)
+	return results, err
+}
+
+// InvariantResult returns the result of the latest check of the invariant of
+// the given route.
+func (c CrisisClient) InvariantResult(route string) (result crisistypes.InvariantResult, err error) {
+	params := crisistypes.NewQueryInvariantResultParams(route)
+	err = c.Query(crisistypes.QuerierRoute, crisistypes.QueryInvariantResult, params, &result)
+	return result, err
+}
+
+// DisabledModules returns the modules whose messages are disabled because one
+// of their invariants is broken.
+func (c CrisisClient) DisabledModules() (modules []string, err error) {
+	err = c.Query(crisistypes.QuerierRoute, crisistypes.QueryDisabledModules, nil, &modules)
+	return modules, err
+}
+
+// VerifyInvariant returns a message verifying the invariant of the given module
+// and route with the key of the client.
+func (c CrisisClient) VerifyInvariant(moduleName, route string) crisistypes.MsgVerifyInvariant {
+	return crisistypes.NewMsgVerifyInvariant(c.FromAddress(), moduleName, route)
+}
+
+// UpdateParams returns a message updating the parameters of the crisis module
+// with the key of the client as the authority.
+func (c CrisisClient) UpdateParams(params crisistypes.Params) crisistypes.MsgUpdateParams {
+	return crisistypes.NewMsgUpdateParams(c.FromAddress(), params)
+}
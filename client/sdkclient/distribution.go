@@ -0,0 +1,138 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// DistributionClient is the client of the distribution module.
+type DistributionClient struct {
+	Client
+}
+
+// Params returns the parameters of the distribution module.
+func (c DistributionClient) Params() (params distrtypes.Params, err error) {
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryParams, nil, &params)
+	return params, err
+}
+
+// ValidatorOutstandingRewards returns the rewards of the given validator which
+// have not been withdrawn yet.
+func (c DistributionClient) ValidatorOutstandingRewards(
+	valAddr sdk.ValAddress,
+) (rewards distrtypes.ValidatorOutstandingRewards, err error) {
+
+	params := distrtypes.NewQueryValidatorOutstandingRewardsParams(valAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryValidatorOutstandingRewards, params, &rewards)
+	return rewards, err
+}
+
+// ValidatorCommission returns the accumulated commission of the given
+// validator.
+func (c DistributionClient) ValidatorCommission(
+	valAddr sdk.ValAddress,
+) (commission distrtypes.ValidatorAccumulatedCommission, err error) {
+
+	params := distrtypes.NewQueryValidatorCommissionParams(valAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryValidatorCommission, params, &commission)
+	return commission, err
+}
+
+// ValidatorSlashes returns the slash events of the given validator between
+// the given heights.
+func (c DistributionClient) ValidatorSlashes(
+	valAddr sdk.ValAddress, startingHeight, endingHeight uint64,
+) (events []distrtypes.ValidatorSlashEvent, err error) {
+
+	params := distrtypes.NewQueryValidatorSlashesParams(valAddr, startingHeight, endingHeight)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryValidatorSlashes, params, &events)
+	return events, err
+}
+
+// DelegationRewards returns the rewards of the delegation of the given
+// delegator to the given validator.
+func (c DistributionClient) DelegationRewards(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (rewards sdk.DecCoins, err error) {
+
+	params := distrtypes.NewQueryDelegationRewardsParams(delAddr, valAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryDelegationRewards, params, &rewards)
+	return rewards, err
+}
+
+// DelegatorTotalRewards returns the rewards of all the delegations of the given
+// delegator.
+func (c DistributionClient) DelegatorTotalRewards(
+	delAddr sdk.AccAddress,
+) (rewards distrtypes.QueryDelegatorTotalRewardsResponse, err error) {
+
+	params := distrtypes.NewQueryDelegatorParams(delAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryDelegatorTotalRewards, params, &rewards)
+	return rewards, err
+}
+
+// DelegatorValidators returns the validators the given delegator delegates to.
+func (c DistributionClient) DelegatorValidators(delAddr sdk.AccAddress) (validators []sdk.ValAddress, err error) {
+	params := distrtypes.NewQueryDelegatorParams(delAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryDelegatorValidators, params, &validators)
+	return validators, err
+}
+
+// AutoCompoundValidators returns the validators the rewards of the given
+// delegator are automatically compounded for.
+func (c DistributionClient) AutoCompoundValidators(delAddr sdk.AccAddress) (validators []sdk.ValAddress, err error) {
+	params := distrtypes.NewQueryDelegatorParams(delAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryAutoCompoundValidators, params, &validators)
+	return validators, err
+}
+
+// WithdrawAddress returns the address the rewards of the given delegator are
+// withdrawn to.
+func (c DistributionClient) WithdrawAddress(delAddr sdk.AccAddress) (addr sdk.AccAddress, err error) {
+	params := distrtypes.NewQueryDelegatorWithdrawAddrParams(delAddr)
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryWithdrawAddr, params, &addr)
+	return addr, err
+}
+
+// CommunityPool returns the coins of the community pool.
+func (c DistributionClient) CommunityPool() (pool sdk.DecCoins, err error) {
+	err = c.Query(distrtypes.QuerierRoute, distrtypes.QueryCommunityPool, nil, &pool)
+	return pool, err
+}
+
+// SetWithdrawAddress returns a message setting the address the rewards of the
+// key of the client are withdrawn to.
+func (c DistributionClient) SetWithdrawAddress(withdrawAddr sdk.AccAddress) distrtypes.MsgSetWithdrawAddress {
+	return distrtypes.NewMsgSetWithdrawAddress(c.FromAddress(), withdrawAddr)
+}
+
+// WithdrawDelegatorReward returns a message withdrawing the rewards of the
+// delegation of the key of the client to the given validator.
+func (c DistributionClient) WithdrawDelegatorReward(valAddr sdk.ValAddress) distrtypes.MsgWithdrawDelegatorReward {
+	return distrtypes.NewMsgWithdrawDelegatorReward(c.FromAddress(), valAddr)
+}
+
+// WithdrawValidatorCommission returns a message withdrawing the commission of
+// the validator operated by the key of the client.
+func (c DistributionClient) WithdrawValidatorCommission() distrtypes.MsgWithdrawValidatorCommission {
+	return distrtypes.NewMsgWithdrawValidatorCommission(sdk.ValAddress(c.FromAddress()))
+}
+
+// FundCommunityPool returns a message funding the community pool with the
+// given amount of the key of the client.
+func (c DistributionClient) FundCommunityPool(amount sdk.Coins) distrtypes.MsgFundCommunityPool {
+	return distrtypes.NewMsgFundCommunityPool(amount, c.FromAddress())
+}
+
+// SetAutoCompound returns a message enabling or disabling the automatic
+// compounding of the rewards of the delegation of the key of the client to
+// the given validator.
+func (c DistributionClient) SetAutoCompound(valAddr sdk.ValAddress, enabled bool) distrtypes.MsgSetAutoCompound {
+	return distrtypes.NewMsgSetAutoCompound(c.FromAddress(), valAddr, enabled)
+}
+
+// UpdateParams returns a message updating the parameters of the distribution
+// module with the key of the client as the authority.
+func (c DistributionClient) UpdateParams(params distrtypes.Params) distrtypes.MsgUpdateParams {
+	return distrtypes.NewMsgUpdateParams(c.FromAddress(), params)
+}
@@ -0,0 +1,55 @@
+package sdkclient
+
+import (
+	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// EvidenceClient is the client of the evidence module.
+type EvidenceClient struct {
+	Client
+}
+
+// Params returns the parameters of the evidence module.
+func (c EvidenceClient) Params() (params evidencetypes.Params, err error) {
+	err = c.Query(evidencetypes.QuerierRoute, evidencetypes.QueryParameters, nil, &params)
+	return params, err
+}
+
+// Evidence returns the evidence of the given hex encoded hash.
+func (c EvidenceClient) Evidence(hash string) (evidence exported.Evidence, err error) {
+	params := evidencetypes.NewQueryEvidenceParams(hash)
+	err = c.Query(evidencetypes.QuerierRoute, evidencetypes.QueryEvidence, params, &evidence)
+	return evidence, err
+}
+
+// AllEvidence returns a page of all the submitted evidence.
+func (c EvidenceClient) AllEvidence(page, limit int) (evidence []exported.Evidence, err error) {
+	params := evidencetypes.NewQueryAllEvidenceParams(page, limit)
+	err = c.Query(evidencetypes.QuerierRoute, evidencetypes.QueryAllEvidence, params, &evidence)
+	return evidence, err
+}
+
+// ValidateEvidence returns whether the given evidence would be accepted if it
+// was submitted, without submitting it.
+func (c EvidenceClient) ValidateEvidence(
+	evidence exported.Evidence,
+) (result evidencetypes.ValidateEvidenceResult, err error) {
+
+	params := evidencetypes.NewQueryValidateEvidenceParams(evidence)
+	err = c.Query(evidencetypes.QuerierRoute, evidencetypes.QueryValidateEvidence, params, &result)
+	return result, err
+}
+
+// SubmitEvidence returns a message submitting the given evidence with the key
+// of the client.
+func (c EvidenceClient) SubmitEvidence(evidence exported.Evidence) (codecstd.MsgSubmitEvidence, error) {
+	return codecstd.NewMsgSubmitEvidence(evidence, c.FromAddress())
+}
+
+// UpdateParams returns a message updating the parameters of the evidence
+// module with the key of the client as the authority.
+func (c EvidenceClient) UpdateParams(params evidencetypes.Params) evidencetypes.MsgUpdateParams {
+	return evidencetypes.NewMsgUpdateParams(c.FromAddress(), params)
+}
@@ -0,0 +1,82 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GovClient is the client of the gov module.
+type GovClient struct {
+	Client
+}
+
+// Proposal returns the proposal of the given ID.
+func (c GovClient) Proposal(proposalID uint64) (proposal govtypes.Proposal, err error) {
+	params := govtypes.NewQueryProposalParams(proposalID)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryProposal, params, &proposal)
+	return proposal, err
+}
+
+// Proposals returns a page of the proposals of the given status, voter and
+// depositor. Zero values do not filter the proposals.
+func (c GovClient) Proposals(
+	status govtypes.ProposalStatus, voter, depositor sdk.AccAddress, page, limit int,
+) (proposals govtypes.Proposals, err error) {
+
+	params := govtypes.NewQueryProposalsParams(page, limit, status, voter, depositor)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryProposals, params, &proposals)
+	return proposals, err
+}
+
+// Deposits returns the deposits of the given proposal.
+func (c GovClient) Deposits(proposalID uint64) (deposits govtypes.Deposits, err error) {
+	params := govtypes.NewQueryProposalParams(proposalID)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryDeposits, params, &deposits)
+	return deposits, err
+}
+
+// Deposit returns the deposit of the given depositor to the given proposal.
+func (c GovClient) Deposit(proposalID uint64, depositor sdk.AccAddress) (deposit govtypes.Deposit, err error) {
+	params := govtypes.NewQueryDepositParams(proposalID, depositor)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryDeposit, params, &deposit)
+	return deposit, err
+}
+
+// Votes returns a page of the votes of the given proposal.
+func (c GovClient) Votes(proposalID uint64, page, limit int) (votes govtypes.Votes, err error) {
+	params := govtypes.NewQueryProposalVotesParams(proposalID, page, limit)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryVotes, params, &votes)
+	return votes, err
+}
+
+// Vote returns the vote of the given voter on the given proposal.
+func (c GovClient) Vote(proposalID uint64, voter sdk.AccAddress) (vote govtypes.Vote, err error) {
+	params := govtypes.NewQueryVoteParams(proposalID, voter)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryVote, params, &vote)
+	return vote, err
+}
+
+// Tally returns the tally of the votes on the given proposal.
+func (c GovClient) Tally(proposalID uint64) (tally govtypes.TallyResult, err error) {
+	params := govtypes.NewQueryProposalParams(proposalID)
+	err = c.Query(govtypes.QuerierRoute, govtypes.QueryTally, params, &tally)
+	return tally, err
+}
+
+// SubmitProposal returns a message submitting a proposal of the given content
+// with the given initial deposit of the key of the client.
+func (c GovClient) SubmitProposal(content govtypes.Content, initialDeposit sdk.Coins) govtypes.MsgSubmitProposal {
+	return govtypes.NewMsgSubmitProposal(content, initialDeposit, c.FromAddress())
+}
+
+// MakeDeposit returns a message depositing the given amount of the key of the
+// client to the given proposal.
+func (c GovClient) MakeDeposit(proposalID uint64, amount sdk.Coins) govtypes.MsgDeposit {
+	return govtypes.NewMsgDeposit(c.FromAddress(), proposalID, amount)
+}
+
+// CastVote returns a message voting with the key of the client on the given
+// proposal.
+func (c GovClient) CastVote(proposalID uint64, option govtypes.VoteOption) govtypes.MsgVote {
+	return govtypes.NewMsgVote(c.FromAddress(), proposalID, option)
+}
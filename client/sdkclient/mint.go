@@ -0,0 +1,35 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// MintClient is the client of the mint module.
+type MintClient struct {
+	Client
+}
+
+// Params returns the parameters of the mint module.
+func (c MintClient) Params() (params minttypes.Params, err error) {
+	err = c.Query(minttypes.QuerierRoute, minttypes.QueryParameters, nil, &params)
+	return params, err
+}
+
+// Inflation returns the current inflation rate.
+func (c MintClient) Inflation() (inflation sdk.Dec, err error) {
+	err = c.Query(minttypes.QuerierRoute, minttypes.QueryInflation, nil, &inflation)
+	return inflation, err
+}
+
+// AnnualProvisions returns the current annual provisions.
+func (c MintClient) AnnualProvisions() (provisions sdk.Dec, err error) {
+	err = c.Query(minttypes.QuerierRoute, minttypes.QueryAnnualProvisions, nil, &provisions)
+	return provisions, err
+}
+
+// UpdateParams returns a message updating the parameters of the mint module
+// with the key of the client as the authority.
+func (c MintClient) UpdateParams(params minttypes.Params) minttypes.MsgUpdateParams {
+	return minttypes.NewMsgUpdateParams(c.FromAddress(), params)
+}
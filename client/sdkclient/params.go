@@ -0,0 +1,27 @@
+package sdkclient
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// ParamsClient is the client of the params module.
+type ParamsClient struct {
+	Client
+}
+
+// DryRun returns the results of applying the given parameter changes, without
+// applying them.
+func (c ParamsClient) DryRun(changes []proposal.ParamChange) (results proposal.ParamChangeResults, err error) {
+	params := proposal.NewQueryDryRunParams(changes)
+	err = c.Query(proposal.QuerierRoute, proposal.QueryDryRun, params, &results)
+	return results, err
+}
+
+// ParameterChangeProposal returns the content of a proposal applying the given
+// parameter changes, to be submitted with the gov client.
+func (c ParamsClient) ParameterChangeProposal(
+	title, description string, changes []proposal.ParamChange,
+) proposal.ParameterChangeProposal {
+
+	return proposal.NewParameterChangeProposal(title, description, changes)
+}
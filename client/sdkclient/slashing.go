@@ -0,0 +1,44 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SlashingClient is the client of the slashing module.
+type SlashingClient struct {
+	Client
+}
+
+// Params returns the parameters of the slashing module.
+func (c SlashingClient) Params() (params slashingtypes.Params, err error) {
+	err = c.Query(slashingtypes.QuerierRoute, slashingtypes.QueryParameters, nil, &params)
+	return params, err
+}
+
+// SigningInfo returns the signing info of the validator of the given
+// consensus address.
+func (c SlashingClient) SigningInfo(consAddr sdk.ConsAddress) (info slashingtypes.ValidatorSigningInfo, err error) {
+	params := slashingtypes.NewQuerySigningInfoParams(consAddr)
+	err = c.Query(slashingtypes.QuerierRoute, slashingtypes.QuerySigningInfo, params, &info)
+	return info, err
+}
+
+// SigningInfos returns a page of the signing infos of all the validators.
+func (c SlashingClient) SigningInfos(page, limit int) (infos []slashingtypes.ValidatorSigningInfo, err error) {
+	params := slashingtypes.NewQuerySigningInfosParams(page, limit)
+	err = c.Query(slashingtypes.QuerierRoute, slashingtypes.QuerySigningInfos, params, &infos)
+	return infos, err
+}
+
+// Unjail returns a message unjailing the validator operated by the key of the
+// client.
+func (c SlashingClient) Unjail() slashingtypes.MsgUnjail {
+	return slashingtypes.NewMsgUnjail(sdk.ValAddress(c.FromAddress()))
+}
+
+// UpdateParams returns a message updating the parameters of the slashing
+// module with the key of the client as the authority.
+func (c SlashingClient) UpdateParams(params slashingtypes.Params) slashingtypes.MsgUpdateParams {
+	return slashingtypes.NewMsgUpdateParams(c.FromAddress(), params)
+}
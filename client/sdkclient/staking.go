@@ -0,0 +1,173 @@
+package sdkclient
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingClient is the client of the staking module.
+type StakingClient struct {
+	Client
+}
+
+// Validators returns a page of the validators of the given bonding status,
+// e.g. sdk.BondStatusBonded.
+func (c StakingClient) Validators(status string, page, limit int) (validators stakingtypes.Validators, err error) {
+	params := stakingtypes.NewQueryValidatorsParams(page, limit, status)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryValidators, params, &validators)
+	return validators, err
+}
+
+// Validator returns the validator of the given address.
+func (c StakingClient) Validator(valAddr sdk.ValAddress) (validator stakingtypes.Validator, err error) {
+	params := stakingtypes.NewQueryValidatorParams(valAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryValidator, params, &validator)
+	return validator, err
+}
+
+// Delegations returns the delegations of the given delegator.
+func (c StakingClient) Delegations(delAddr sdk.AccAddress) (delegations stakingtypes.DelegationResponses, err error) {
+	params := stakingtypes.NewQueryDelegatorParams(delAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryDelegatorDelegations, params, &delegations)
+	return delegations, err
+}
+
+// Delegation returns the delegation of the given delegator to the given
+// validator.
+func (c StakingClient) Delegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (delegation stakingtypes.DelegationResponse, err error) {
+
+	params := stakingtypes.NewQueryBondsParams(delAddr, valAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryDelegation, params, &delegation)
+	return delegation, err
+}
+
+// ValidatorDelegations returns the delegations to the given validator.
+func (c StakingClient) ValidatorDelegations(valAddr sdk.ValAddress) (delegations stakingtypes.DelegationResponses, err error) {
+	params := stakingtypes.NewQueryValidatorParams(valAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryValidatorDelegations, params, &delegations)
+	return delegations, err
+}
+
+// UnbondingDelegations returns the unbonding delegations of the given
+// delegator.
+func (c StakingClient) UnbondingDelegations(delAddr sdk.AccAddress) (ubds stakingtypes.UnbondingDelegations, err error) {
+	params := stakingtypes.NewQueryDelegatorParams(delAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryDelegatorUnbondingDelegations, params, &ubds)
+	return ubds, err
+}
+
+// UnbondingDelegation returns the unbonding delegation of the given delegator
+// from the given validator.
+func (c StakingClient) UnbondingDelegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (ubd stakingtypes.UnbondingDelegation, err error) {
+
+	params := stakingtypes.NewQueryBondsParams(delAddr, valAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryUnbondingDelegation, params, &ubd)
+	return ubd, err
+}
+
+// Redelegations returns the redelegations of the given delegator, optionally
+// filtered by their source and destination validators.
+func (c StakingClient) Redelegations(
+	delAddr sdk.AccAddress, srcValAddr, dstValAddr sdk.ValAddress,
+) (redelegations stakingtypes.RedelegationResponses, err error) {
+
+	params := stakingtypes.NewQueryRedelegationParams(delAddr, srcValAddr, dstValAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryRedelegations, params, &redelegations)
+	return redelegations, err
+}
+
+// DelegatorValidators returns the validators the given delegator delegates to.
+func (c StakingClient) DelegatorValidators(delAddr sdk.AccAddress) (validators stakingtypes.Validators, err error) {
+	params := stakingtypes.NewQueryDelegatorParams(delAddr)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryDelegatorValidators, params, &validators)
+	return validators, err
+}
+
+// HistoricalInfo returns the historical info of the given height.
+func (c StakingClient) HistoricalInfo(height int64) (info stakingtypes.HistoricalInfo, err error) {
+	params := stakingtypes.NewQueryHistoricalInfoParams(height)
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryHistoricalInfo, params, &info)
+	return info, err
+}
+
+// Pool returns the bonded and not bonded tokens of the staking pool.
+func (c StakingClient) Pool() (pool stakingtypes.Pool, err error) {
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryPool, nil, &pool)
+	return pool, err
+}
+
+// Params returns the parameters of the staking module.
+func (c StakingClient) Params() (params stakingtypes.Params, err error) {
+	err = c.Query(stakingtypes.QuerierRoute, stakingtypes.QueryParameters, nil, &params)
+	return params, err
+}
+
+// CreateValidator returns a message creating a validator operated by the key
+// of the client.
+func (c StakingClient) CreateValidator(
+	pubKey crypto.PubKey, selfDelegation sdk.Coin, description stakingtypes.Description,
+	commission stakingtypes.CommissionRates, minSelfDelegation sdk.Int,
+) stakingtypes.MsgCreateValidator {
+
+	return stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(c.FromAddress()), pubKey, selfDelegation, description, commission, minSelfDelegation,
+	)
+}
+
+// EditValidator returns a message editing the validator operated by the key of
+// the client. Nil rates and minimum self delegations are left unchanged.
+func (c StakingClient) EditValidator(
+	description stakingtypes.Description, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int,
+) stakingtypes.MsgEditValidator {
+
+	return stakingtypes.NewMsgEditValidator(sdk.ValAddress(c.FromAddress()), description, newRate, newMinSelfDelegation)
+}
+
+// RotateConsPubKey returns a message rotating the consensus key of the
+// validator operated by the key of the client.
+func (c StakingClient) RotateConsPubKey(newPubKey crypto.PubKey) stakingtypes.MsgRotateConsPubKey {
+	return stakingtypes.NewMsgRotateConsPubKey(sdk.ValAddress(c.FromAddress()), newPubKey)
+}
+
+// Delegate returns a message delegating the given amount of the key of the
+// client to the given validator.
+func (c StakingClient) Delegate(valAddr sdk.ValAddress, amount sdk.Coin) stakingtypes.MsgDelegate {
+	return stakingtypes.NewMsgDelegate(c.FromAddress(), valAddr, amount)
+}
+
+// Undelegate returns a message undelegating the given amount of the key of the
+// client from the given validator.
+func (c StakingClient) Undelegate(valAddr sdk.ValAddress, amount sdk.Coin) stakingtypes.MsgUndelegate {
+	return stakingtypes.NewMsgUndelegate(c.FromAddress(), valAddr, amount)
+}
+
+// BeginRedelegate returns a message redelegating the given amount of the key
+// of the client from a source to a destination validator.
+func (c StakingClient) BeginRedelegate(
+	valSrcAddr, valDstAddr sdk.ValAddress, amount sdk.Coin,
+) stakingtypes.MsgBeginRedelegate {
+
+	return stakingtypes.NewMsgBeginRedelegate(c.FromAddress(), valSrcAddr, valDstAddr, amount)
+}
+
+// CancelUnbondingDelegation returns a message cancelling the given amount of
+// the unbonding delegation created at the given height by the key of the
+// client.
+func (c StakingClient) CancelUnbondingDelegation(
+	valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin,
+) stakingtypes.MsgCancelUnbondingDelegation {
+
+	return stakingtypes.NewMsgCancelUnbondingDelegation(c.FromAddress(), valAddr, creationHeight, amount)
+}
+
+// UpdateParams returns a message updating the parameters of the staking module
+// with the key of the client as the authority.
+func (c StakingClient) UpdateParams(params stakingtypes.Params) stakingtypes.MsgUpdateParams {
+	return stakingtypes.NewMsgUpdateParams(c.FromAddress(), params)
+}
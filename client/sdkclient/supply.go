@@ -0,0 +1,25 @@
+package sdkclient
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	supplytypes "github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+// SupplyClient is the client of the supply module.
+type SupplyClient struct {
+	Client
+}
+
+// TotalSupply returns a page of the total supply of the chain.
+func (c SupplyClient) TotalSupply(page, limit int) (supply sdk.Coins, err error) {
+	params := supplytypes.NewQueryTotalSupplyParams(page, limit)
+	err = c.Query(supplytypes.QuerierRoute, supplytypes.QueryTotalSupply, params, &supply)
+	return supply, err
+}
+
+// SupplyOf returns the total supply of the given denomination.
+func (c SupplyClient) SupplyOf(denom string) (supply sdk.Int, err error) {
+	params := supplytypes.NewQuerySupplyOfParams(denom)
+	err = c.Query(supplytypes.QuerierRoute, supplytypes.QuerySupplyOf, params, &supply)
+	return supply, err
+}
@@ -0,0 +1,67 @@
+package sdkclient
+
+import (
+	"encoding/binary"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeClient is the client of the upgrade module.
+type UpgradeClient struct {
+	Client
+}
+
+// CurrentPlan returns the currently scheduled upgrade plan, and whether an
+// upgrade is scheduled.
+func (c UpgradeClient) CurrentPlan() (plan upgradetypes.Plan, found bool, err error) {
+	bz, err := c.queryRaw(upgradetypes.QuerierKey, upgradetypes.QueryCurrent, nil)
+	if err != nil || len(bz) == 0 {
+		return plan, false, err
+	}
+
+	err = c.Codec().UnmarshalJSON(bz, &plan)
+	return plan, err == nil, err
+}
+
+// AppliedHeight returns the height the upgrade of the given name was applied
+// at, or zero if it was not applied.
+func (c UpgradeClient) AppliedHeight(name string) (int64, error) {
+	params := upgradetypes.NewQueryAppliedParams(name)
+	bz, err := c.queryRaw(upgradetypes.QuerierKey, upgradetypes.QueryApplied, params)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// ModuleVersions returns the consensus versions of the modules of the chain.
+func (c UpgradeClient) ModuleVersions() (versions []upgradetypes.ModuleVersion, err error) {
+	err = c.Query(upgradetypes.QuerierKey, upgradetypes.QueryModuleVersions, nil, &versions)
+	return versions, err
+}
+
+// Readiness returns the readiness of the currently scheduled upgrade plan, and
+// whether an upgrade is scheduled.
+func (c UpgradeClient) Readiness() (readiness upgradetypes.UpgradeReadiness, found bool, err error) {
+	bz, err := c.queryRaw(upgradetypes.QuerierKey, upgradetypes.QueryReadiness, nil)
+	if err != nil || len(bz) == 0 {
+		return readiness, false, err
+	}
+
+	err = c.Codec().UnmarshalJSON(bz, &readiness)
+	return readiness, err == nil, err
+}
+
+// SoftwareUpgradeProposal returns the content of a proposal scheduling the
+// given upgrade plan, to be submitted with the gov client.
+func (c UpgradeClient) SoftwareUpgradeProposal(title, description string, plan upgradetypes.Plan) govtypes.Content {
+	return upgradetypes.NewSoftwareUpgradeProposal(title, description, plan)
+}
+
+// CancelSoftwareUpgradeProposal returns the content of a proposal cancelling
+// the currently scheduled upgrade plan, to be submitted with the gov client.
+func (c UpgradeClient) CancelSoftwareUpgradeProposal(title, description string) govtypes.Content {
+	return upgradetypes.NewCancelSoftwareUpgradeProposal(title, description)
+}
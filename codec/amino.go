@@ -8,6 +8,9 @@ import (
 	amino "github.com/tendermint/go-amino"
 	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 )
 
 // Cdc defines a global generic sealed Amino codec to be used throughout sdk. It
@@ -31,9 +34,11 @@ func New() *Codec {
 }
 
 // RegisterCrypto registers all crypto dependency types with the provided Amino
-// codec.
+// codec, including the SDK's own secp256r1 account keys.
 func RegisterCrypto(cdc *Codec) {
 	cryptoamino.RegisterAmino(cdc)
+	secp256r1.RegisterCodec(cdc)
+	multisig.RegisterCodec(cdc)
 }
 
 // RegisterEvidences registers Tendermint evidence types with the provided Amino
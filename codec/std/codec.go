@@ -2,6 +2,7 @@ package std
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -191,8 +192,10 @@ func MakeCodec(bm module.BasicManager) *codec.Codec {
 
 	bm.RegisterCodec(cdc)
 	vesting.RegisterCodec(cdc)
+	RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
+	keys.RegisterSignatureAlgoCodecs(cdc)
 
 	return cdc
 }
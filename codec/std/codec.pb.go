@@ -8,12 +8,18 @@ import (
 	github_com_cosmos_cosmos_sdk_x_auth_exported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	types8 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types7 "github.com/cosmos/cosmos-sdk/x/circuit/types"
+	types9 "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	types6 "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	github_com_cosmos_cosmos_sdk_x_evidence_exported "github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	types3 "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	github_com_cosmos_cosmos_sdk_x_gov_types "github.com/cosmos/cosmos-sdk/x/gov/types"
 	types4 "github.com/cosmos/cosmos-sdk/x/gov/types"
+	types10 "github.com/cosmos/cosmos-sdk/x/mint/types"
 	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	types11 "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	types12 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	github_com_cosmos_cosmos_sdk_x_supply_exported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	types2 "github.com/cosmos/cosmos-sdk/x/supply/types"
 	types5 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -246,6 +252,7 @@ type Evidence struct {
 	//
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_Equivocation
+	//	*Evidence_LightClientAttack
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -292,8 +299,12 @@ type isEvidence_Sum interface {
 type Evidence_Equivocation struct {
 	Equivocation *types3.Equivocation `protobuf:"bytes,1,opt,name=equivocation,proto3,oneof" json:"equivocation,omitempty"`
 }
+type Evidence_LightClientAttack struct {
+	LightClientAttack *types3.LightClientAttack `protobuf:"bytes,2,opt,name=light_client_attack,json=lightClientAttack,proto3,oneof" json:"light_client_attack,omitempty"`
+}
 
-func (*Evidence_Equivocation) isEvidence_Sum() {}
+func (*Evidence_Equivocation) isEvidence_Sum()      {}
+func (*Evidence_LightClientAttack) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -309,10 +320,18 @@ func (m *Evidence) GetEquivocation() *types3.Equivocation {
 	return nil
 }
 
+func (m *Evidence) GetLightClientAttack() *types3.LightClientAttack {
+	if x, ok := m.GetSum().(*Evidence_LightClientAttack); ok {
+		return x.LightClientAttack
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_Equivocation)(nil),
+		(*Evidence_LightClientAttack)(nil),
 	}
 }
 
@@ -454,6 +473,9 @@ type Content struct {
 	//	*Content_SoftwareUpgrade
 	//	*Content_CancelSoftwareUpgrade
 	//	*Content_CommunityPoolSpend
+	//	*Content_TripCircuitBreaker
+	//	*Content_ResetCircuitBreaker
+	//	*Content_Exec
 	Sum isContent_Sum `protobuf_oneof:"sum"`
 }
 
@@ -512,12 +534,24 @@ type Content_CancelSoftwareUpgrade struct {
 type Content_CommunityPoolSpend struct {
 	CommunityPoolSpend *types6.CommunityPoolSpendProposal `protobuf:"bytes,5,opt,name=community_pool_spend,json=communityPoolSpend,proto3,oneof" json:"community_pool_spend,omitempty"`
 }
+type Content_TripCircuitBreaker struct {
+	TripCircuitBreaker *types7.TripCircuitBreakerProposal `protobuf:"bytes,6,opt,name=trip_circuit_breaker,json=tripCircuitBreaker,proto3,oneof" json:"trip_circuit_breaker,omitempty"`
+}
+type Content_ResetCircuitBreaker struct {
+	ResetCircuitBreaker *types7.ResetCircuitBreakerProposal `protobuf:"bytes,7,opt,name=reset_circuit_breaker,json=resetCircuitBreaker,proto3,oneof" json:"reset_circuit_breaker,omitempty"`
+}
+type Content_Exec struct {
+	Exec *ExecProposal `protobuf:"bytes,8,opt,name=exec,proto3,oneof" json:"exec,omitempty"`
+}
 
 func (*Content_Text) isContent_Sum()                  {}
 func (*Content_ParameterChange) isContent_Sum()       {}
 func (*Content_SoftwareUpgrade) isContent_Sum()       {}
 func (*Content_CancelSoftwareUpgrade) isContent_Sum() {}
 func (*Content_CommunityPoolSpend) isContent_Sum()    {}
+func (*Content_TripCircuitBreaker) isContent_Sum()    {}
+func (*Content_ResetCircuitBreaker) isContent_Sum()   {}
+func (*Content_Exec) isContent_Sum()                  {}
 
 func (m *Content) GetSum() isContent_Sum {
 	if m != nil {
@@ -561,6 +595,27 @@ func (m *Content) GetCommunityPoolSpend() *types6.CommunityPoolSpendProposal {
 	return nil
 }
 
+func (m *Content) GetTripCircuitBreaker() *types7.TripCircuitBreakerProposal {
+	if x, ok := m.GetSum().(*Content_TripCircuitBreaker); ok {
+		return x.TripCircuitBreaker
+	}
+	return nil
+}
+
+func (m *Content) GetResetCircuitBreaker() *types7.ResetCircuitBreakerProposal {
+	if x, ok := m.GetSum().(*Content_ResetCircuitBreaker); ok {
+		return x.ResetCircuitBreaker
+	}
+	return nil
+}
+
+func (m *Content) GetExec() *ExecProposal {
+	if x, ok := m.GetSum().(*Content_Exec); ok {
+		return x.Exec
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Content) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -569,6 +624,229 @@ func (*Content) XXX_OneofWrappers() []interface{} {
 		(*Content_SoftwareUpgrade)(nil),
 		(*Content_CancelSoftwareUpgrade)(nil),
 		(*Content_CommunityPoolSpend)(nil),
+		(*Content_TripCircuitBreaker)(nil),
+		(*Content_ResetCircuitBreaker)(nil),
+		(*Content_Exec)(nil),
+	}
+}
+
+// ExecProposal defines a governance proposal executing messages on behalf of the
+// governance module account once the proposal has passed.
+type ExecProposal struct {
+	Title       string    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Msgs        []Message `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs"`
+}
+
+func (m *ExecProposal) Reset()      { *m = ExecProposal{} }
+func (*ExecProposal) ProtoMessage() {}
+func (*ExecProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf09dc2dfa19bb4, []int{7}
+}
+func (m *ExecProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecProposal.Merge(m, src)
+}
+func (m *ExecProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecProposal proto.InternalMessageInfo
+
+// Message defines the application-level messages allowed to be executed by an
+// ExecProposal.
+type Message struct {
+	// sum defines a set of all acceptable concrete messages.
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*Message_AuthUpdateParams
+	//	*Message_BankUpdateParams
+	//	*Message_CrisisUpdateParams
+	//	*Message_DistributionUpdateParams
+	//	*Message_EvidenceUpdateParams
+	//	*Message_GovUpdateParams
+	//	*Message_MintUpdateParams
+	//	*Message_SlashingUpdateParams
+	//	*Message_StakingUpdateParams
+	Sum isMessage_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *Message) Reset()         { *m = Message{} }
+func (m *Message) String() string { return proto.CompactTextString(m) }
+func (*Message) ProtoMessage()    {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daf09dc2dfa19bb4, []int{8}
+}
+func (m *Message) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Message.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(m, src)
+}
+func (m *Message) XXX_Size() int {
+	return m.Size()
+}
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Message proto.InternalMessageInfo
+
+type isMessage_Sum interface {
+	isMessage_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Message_AuthUpdateParams struct {
+	AuthUpdateParams *types.MsgUpdateParams `protobuf:"bytes,1,opt,name=auth_update_params,json=authUpdateParams,proto3,oneof" json:"auth_update_params,omitempty"`
+}
+type Message_BankUpdateParams struct {
+	BankUpdateParams *types8.MsgUpdateParams `protobuf:"bytes,2,opt,name=bank_update_params,json=bankUpdateParams,proto3,oneof" json:"bank_update_params,omitempty"`
+}
+type Message_CrisisUpdateParams struct {
+	CrisisUpdateParams *types9.MsgUpdateParams `protobuf:"bytes,3,opt,name=crisis_update_params,json=crisisUpdateParams,proto3,oneof" json:"crisis_update_params,omitempty"`
+}
+type Message_DistributionUpdateParams struct {
+	DistributionUpdateParams *types6.MsgUpdateParams `protobuf:"bytes,4,opt,name=distribution_update_params,json=distributionUpdateParams,proto3,oneof" json:"distribution_update_params,omitempty"`
+}
+type Message_EvidenceUpdateParams struct {
+	EvidenceUpdateParams *types3.MsgUpdateParams `protobuf:"bytes,5,opt,name=evidence_update_params,json=evidenceUpdateParams,proto3,oneof" json:"evidence_update_params,omitempty"`
+}
+type Message_GovUpdateParams struct {
+	GovUpdateParams *types4.MsgUpdateParams `protobuf:"bytes,6,opt,name=gov_update_params,json=govUpdateParams,proto3,oneof" json:"gov_update_params,omitempty"`
+}
+type Message_MintUpdateParams struct {
+	MintUpdateParams *types10.MsgUpdateParams `protobuf:"bytes,7,opt,name=mint_update_params,json=mintUpdateParams,proto3,oneof" json:"mint_update_params,omitempty"`
+}
+type Message_SlashingUpdateParams struct {
+	SlashingUpdateParams *types11.MsgUpdateParams `protobuf:"bytes,8,opt,name=slashing_update_params,json=slashingUpdateParams,proto3,oneof" json:"slashing_update_params,omitempty"`
+}
+type Message_StakingUpdateParams struct {
+	StakingUpdateParams *types12.MsgUpdateParams `protobuf:"bytes,9,opt,name=staking_update_params,json=stakingUpdateParams,proto3,oneof" json:"staking_update_params,omitempty"`
+}
+
+func (*Message_AuthUpdateParams) isMessage_Sum()         {}
+func (*Message_BankUpdateParams) isMessage_Sum()         {}
+func (*Message_CrisisUpdateParams) isMessage_Sum()       {}
+func (*Message_DistributionUpdateParams) isMessage_Sum() {}
+func (*Message_EvidenceUpdateParams) isMessage_Sum()     {}
+func (*Message_GovUpdateParams) isMessage_Sum()          {}
+func (*Message_MintUpdateParams) isMessage_Sum()         {}
+func (*Message_SlashingUpdateParams) isMessage_Sum()     {}
+func (*Message_StakingUpdateParams) isMessage_Sum()      {}
+
+func (m *Message) GetSum() isMessage_Sum {
+	if m != nil {
+		return m.Sum
+	}
+	return nil
+}
+
+func (m *Message) GetAuthUpdateParams() *types.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_AuthUpdateParams); ok {
+		return x.AuthUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetBankUpdateParams() *types8.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_BankUpdateParams); ok {
+		return x.BankUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetCrisisUpdateParams() *types9.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_CrisisUpdateParams); ok {
+		return x.CrisisUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetDistributionUpdateParams() *types6.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_DistributionUpdateParams); ok {
+		return x.DistributionUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetEvidenceUpdateParams() *types3.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_EvidenceUpdateParams); ok {
+		return x.EvidenceUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetGovUpdateParams() *types4.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_GovUpdateParams); ok {
+		return x.GovUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetMintUpdateParams() *types10.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_MintUpdateParams); ok {
+		return x.MintUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetSlashingUpdateParams() *types11.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_SlashingUpdateParams); ok {
+		return x.SlashingUpdateParams
+	}
+	return nil
+}
+
+func (m *Message) GetStakingUpdateParams() *types12.MsgUpdateParams {
+	if x, ok := m.GetSum().(*Message_StakingUpdateParams); ok {
+		return x.StakingUpdateParams
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Message) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Message_AuthUpdateParams)(nil),
+		(*Message_BankUpdateParams)(nil),
+		(*Message_CrisisUpdateParams)(nil),
+		(*Message_DistributionUpdateParams)(nil),
+		(*Message_EvidenceUpdateParams)(nil),
+		(*Message_GovUpdateParams)(nil),
+		(*Message_MintUpdateParams)(nil),
+		(*Message_SlashingUpdateParams)(nil),
+		(*Message_StakingUpdateParams)(nil),
 	}
 }
 
@@ -580,67 +858,95 @@ func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos_sdk.codec.std.v1.MsgSubmitProposal")
 	proto.RegisterType((*Proposal)(nil), "cosmos_sdk.codec.std.v1.Proposal")
 	proto.RegisterType((*Content)(nil), "cosmos_sdk.codec.std.v1.Content")
+	proto.RegisterType((*ExecProposal)(nil), "cosmos_sdk.codec.std.v1.ExecProposal")
+	proto.RegisterType((*Message)(nil), "cosmos_sdk.codec.std.v1.Message")
 }
 
 func init() { proto.RegisterFile("codec/std/codec.proto", fileDescriptor_daf09dc2dfa19bb4) }
 
 var fileDescriptor_daf09dc2dfa19bb4 = []byte{
-	// 875 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xb6, 0xa9, 0x9b, 0xac, 0xa6, 0x05, 0xca, 0x88, 0x90, 0x28, 0xa0, 0x4d, 0x1a, 0x44, 0x84,
-	0x8a, 0x62, 0xb7, 0x14, 0x68, 0xba, 0x12, 0x6a, 0xbb, 0x4b, 0xd1, 0x22, 0x11, 0x14, 0x6d, 0x80,
-	0x03, 0x02, 0x59, 0xde, 0x99, 0xc1, 0x6b, 0xd5, 0xf6, 0x0c, 0x9e, 0xb1, 0xf1, 0xfe, 0x03, 0xc4,
-	0x09, 0x89, 0x3f, 0x10, 0xc1, 0x91, 0x6b, 0x8f, 0xfc, 0x80, 0xaa, 0xa7, 0x1c, 0x39, 0x55, 0x28,
-	0xb9, 0xf0, 0x33, 0x90, 0x67, 0xc6, 0x5e, 0x5b, 0xf6, 0x6e, 0xd4, 0xcb, 0xca, 0x9e, 0xf7, 0x7d,
-	0xef, 0xfb, 0xc6, 0x33, 0xef, 0xbd, 0x05, 0x1b, 0x88, 0x62, 0x82, 0x1c, 0x2e, 0xb0, 0x23, 0x9f,
-	0x6c, 0x96, 0x50, 0x41, 0xe1, 0x26, 0xa2, 0x3c, 0xa2, 0xdc, 0xe5, 0xf8, 0x89, 0xad, 0xd6, 0xb9,
-	0xc0, 0x76, 0x76, 0x67, 0xfb, 0x03, 0x31, 0x0b, 0x12, 0xec, 0x32, 0x2f, 0x11, 0x73, 0x47, 0x62,
-	0x1d, 0x05, 0x3d, 0xa8, 0xbf, 0xa8, 0x2c, 0xdb, 0xfb, 0x6d, 0xb0, 0x4f, 0x7d, 0xba, 0x78, 0xd2,
-	0xb8, 0xad, 0xdc, 0xf1, 0x52, 0x31, 0x73, 0xc4, 0x9c, 0x11, 0xae, 0x7e, 0x75, 0x64, 0x57, 0x47,
-	0x32, 0xc2, 0x45, 0x10, 0xfb, 0x1d, 0x88, 0xed, 0xdc, 0xe1, 0x29, 0x63, 0xe1, 0xbc, 0x23, 0xf6,
-	0x4e, 0xee, 0x90, 0x2c, 0xc0, 0x24, 0x46, 0xa4, 0x23, 0xba, 0x99, 0x3b, 0x3e, 0xcd, 0x3a, 0x02,
-	0xef, 0xe6, 0x0e, 0xf3, 0x12, 0x2f, 0xd2, 0xab, 0x85, 0x73, 0x46, 0xb9, 0x17, 0x36, 0x40, 0x6f,
-	0xe7, 0x4e, 0xca, 0xfc, 0xc4, 0xc3, 0xa4, 0xdb, 0x36, 0x0e, 0xb8, 0x48, 0x82, 0x69, 0x2a, 0x02,
-	0x1a, 0xb7, 0x11, 0x7b, 0x7f, 0x5b, 0x60, 0xfd, 0x11, 0x42, 0x34, 0x8d, 0x05, 0xfc, 0x1c, 0x5c,
-	0x9f, 0x7a, 0x9c, 0xb8, 0x9e, 0x7a, 0xdf, 0x32, 0x77, 0xcd, 0xf7, 0xaf, 0x7d, 0x78, 0xd3, 0xae,
-	0x9d, 0x41, 0x6e, 0x17, 0x9f, 0xc1, 0xce, 0xee, 0xd8, 0x43, 0x8f, 0x13, 0x4d, 0x1c, 0x1b, 0x93,
-	0x6b, 0xd3, 0xc5, 0x2b, 0xcc, 0xc0, 0x36, 0xa2, 0xb1, 0x08, 0xe2, 0x94, 0xa6, 0xdc, 0xd5, 0x9f,
-	0xac, 0xca, 0xfa, 0x8a, 0xcc, 0xfa, 0x49, 0x57, 0x56, 0x85, 0x2c, 0xb2, 0x8f, 0x2a, 0xfe, 0xb7,
-	0x6a, 0x71, 0x21, 0xb5, 0x85, 0x96, 0xc4, 0x60, 0x04, 0x36, 0x31, 0x09, 0xbd, 0x39, 0xc1, 0x2d,
-	0xd1, 0x2b, 0x52, 0xf4, 0xee, 0x6a, 0xd1, 0xcf, 0x14, 0xb9, 0xa5, 0xb8, 0x81, 0xbb, 0x02, 0x90,
-	0x81, 0x2d, 0x46, 0x92, 0x80, 0xe2, 0x00, 0xb5, 0xf4, 0x2c, 0xa9, 0xf7, 0xd1, 0x6a, 0xbd, 0x63,
-	0xcd, 0x6e, 0x09, 0xbe, 0xc5, 0x3a, 0x23, 0xf0, 0x2b, 0xf0, 0x5a, 0x44, 0x71, 0x1a, 0x2e, 0x8e,
-	0xe8, 0xaa, 0xd4, 0x79, 0xaf, 0xa9, 0xa3, 0xee, 0x61, 0xa1, 0x70, 0x24, 0xd1, 0x8b, 0xc4, 0xaf,
-	0x46, 0xf5, 0x85, 0xc1, 0xfd, 0xe7, 0x4f, 0x0f, 0x3e, 0xbe, 0xe5, 0x07, 0x62, 0x96, 0x4e, 0x6d,
-	0x44, 0x23, 0x5d, 0x35, 0x65, 0x25, 0x71, 0xfc, 0xc4, 0xd1, 0xf7, 0x9e, 0xe4, 0x8c, 0x26, 0x82,
-	0x60, 0x5b, 0x53, 0x87, 0x57, 0xc1, 0x15, 0x9e, 0x46, 0x7b, 0xbf, 0x9a, 0x60, 0xed, 0x44, 0xca,
-	0xc1, 0x43, 0xb0, 0xa6, 0x84, 0xf5, 0xbd, 0xe9, 0x2f, 0x33, 0xa5, 0xf0, 0x63, 0x63, 0xa2, 0xf1,
-	0x83, 0x07, 0xff, 0x9d, 0xee, 0x98, 0xcf, 0x9f, 0x1e, 0xdc, 0xbb, 0xcc, 0x8a, 0x2e, 0xb0, 0xca,
-	0x8c, 0xca, 0xf4, 0x45, 0x69, 0xe6, 0x0f, 0x13, 0xf4, 0x1e, 0xeb, 0x3a, 0x83, 0x5f, 0x82, 0xeb,
-	0xe4, 0xa7, 0x34, 0xc8, 0x28, 0xf2, 0x8a, 0xab, 0xaf, 0x4d, 0xed, 0x37, 0x4d, 0x95, 0x55, 0x59,
-	0xd8, 0x7a, 0x5c, 0x43, 0x8f, 0x8d, 0x49, 0x83, 0x3d, 0x78, 0xa4, 0x2d, 0xde, 0xbf, 0xc4, 0x61,
-	0x55, 0xe6, 0x95, 0xc7, 0xd2, 0x50, 0x69, 0xf2, 0x2f, 0x13, 0xbc, 0x71, 0xc4, 0xfd, 0x93, 0x74,
-	0x1a, 0x05, 0xa2, 0x72, 0xfb, 0x29, 0xe8, 0x95, 0xd4, 0xae, 0xb2, 0xab, 0xb7, 0xbe, 0x2a, 0xe3,
-	0xa4, 0xa2, 0xc0, 0x23, 0x60, 0x15, 0x05, 0xa8, 0x6b, 0xcb, 0x59, 0xbe, 0xc9, 0x96, 0x72, 0x51,
-	0xc6, 0xc3, 0xde, 0xb3, 0x17, 0x3b, 0xc6, 0xd9, 0x8b, 0x1d, 0x73, 0x22, 0xd3, 0x0c, 0x7a, 0xbf,
-	0x9c, 0xee, 0x18, 0xc5, 0x8e, 0xf7, 0xfe, 0xac, 0xbb, 0x3d, 0xd6, 0xfd, 0x07, 0x8e, 0xb5, 0x9c,
-	0x72, 0x7a, 0xab, 0x29, 0xe7, 0xd3, 0xac, 0xa1, 0x54, 0xb2, 0xba, 0x94, 0xe0, 0x00, 0xac, 0x17,
-	0xe5, 0x4c, 0xaa, 0xbe, 0xb0, 0xbb, 0x74, 0xdb, 0x23, 0x85, 0x9b, 0x94, 0x84, 0x9a, 0xcb, 0xdf,
-	0x4d, 0xd0, 0xab, 0xcc, 0x3d, 0x68, 0x98, 0xbb, 0xd9, 0x69, 0x6e, 0xa5, 0xa7, 0x87, 0x2f, 0xed,
-	0x69, 0x68, 0x15, 0x29, 0x16, 0xce, 0x2c, 0xe9, 0xea, 0xd4, 0x02, 0xeb, 0x1a, 0x00, 0xef, 0x01,
-	0x4b, 0x90, 0x5c, 0xac, 0x34, 0xf5, 0x35, 0xc9, 0xab, 0x8f, 0x35, 0x36, 0x26, 0x92, 0x00, 0xbf,
-	0x07, 0x37, 0xe4, 0x0c, 0x20, 0x82, 0x24, 0x2e, 0x9a, 0x79, 0xb1, 0xbf, 0xe4, 0x94, 0xd5, 0xa4,
-	0x90, 0x9b, 0x2b, 0xf1, 0x23, 0x09, 0xaf, 0xa5, 0x7c, 0x9d, 0x35, 0x43, 0xf0, 0x07, 0x70, 0x83,
-	0xd3, 0x1f, 0xc5, 0xcf, 0x5e, 0x42, 0x5c, 0x3d, 0x45, 0x74, 0xab, 0xbc, 0xdd, 0xcc, 0xae, 0x83,
-	0xb2, 0x7c, 0x35, 0xe1, 0x1b, 0xb5, 0x54, 0x4f, 0xcf, 0x9b, 0x21, 0xc8, 0xc0, 0x26, 0xf2, 0x62,
-	0x44, 0x42, 0xb7, 0xa5, 0x62, 0x75, 0x4d, 0x81, 0x9a, 0xca, 0x48, 0xf2, 0x96, 0x6b, 0x6d, 0xa0,
-	0x2e, 0x00, 0x0c, 0xc1, 0x9b, 0x88, 0x46, 0x51, 0x1a, 0x07, 0x62, 0xee, 0x32, 0x4a, 0x43, 0x97,
-	0x33, 0x12, 0x63, 0xdd, 0x27, 0x0f, 0x9b, 0x72, 0xf5, 0xd1, 0xa8, 0x4e, 0x53, 0x33, 0x8f, 0x29,
-	0x0d, 0x4f, 0x0a, 0x5e, 0x4d, 0x10, 0xa2, 0x56, 0x74, 0x70, 0xa8, 0xbb, 0xc2, 0xed, 0x4b, 0xba,
-	0x42, 0x35, 0xde, 0xab, 0x0b, 0xa3, 0x9a, 0xc1, 0xf0, 0xe1, 0xb3, 0xf3, 0xbe, 0x79, 0x76, 0xde,
-	0x37, 0xff, 0x3d, 0xef, 0x9b, 0xbf, 0x5d, 0xf4, 0x8d, 0xb3, 0x8b, 0xbe, 0xf1, 0xcf, 0x45, 0xdf,
-	0xf8, 0x6e, 0x7f, 0x65, 0xca, 0xea, 0xcf, 0xd2, 0x74, 0x4d, 0x8e, 0xf1, 0xbb, 0xff, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xf7, 0xf2, 0xb6, 0xa2, 0x40, 0x09, 0x00, 0x00,
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x8f, 0x14, 0xc5,
+	0x1b, 0x9e, 0xd9, 0x1d, 0x76, 0x87, 0x82, 0xdf, 0x0f, 0x28, 0x76, 0xd9, 0xc9, 0x62, 0x66, 0x97,
+	0x55, 0x11, 0x21, 0x3b, 0x0d, 0xa2, 0x02, 0x63, 0x0c, 0xb0, 0x2b, 0x66, 0x4c, 0x58, 0x43, 0x06,
+	0xf1, 0xa0, 0x62, 0xa7, 0xa7, 0xba, 0xec, 0x2d, 0xa7, 0xbb, 0xab, 0xed, 0xaa, 0x1e, 0x7b, 0x8f,
+	0xde, 0x8c, 0xf1, 0x60, 0xe2, 0xc5, 0x23, 0x89, 0x47, 0x6f, 0x86, 0xa3, 0x1f, 0x80, 0x70, 0xe2,
+	0x68, 0x62, 0x42, 0x0c, 0x5c, 0xfc, 0x18, 0xa6, 0xfe, 0x74, 0x4f, 0xff, 0xa9, 0x99, 0x8d, 0x97,
+	0xc9, 0x74, 0x3d, 0xcf, 0xfb, 0x3c, 0x6f, 0x55, 0xf5, 0xfb, 0x56, 0x35, 0x58, 0x45, 0xd4, 0xc5,
+	0xc8, 0x62, 0xdc, 0xb5, 0xe4, 0xbf, 0x5e, 0x14, 0x53, 0x4e, 0xe1, 0x1a, 0xa2, 0x2c, 0xa0, 0xcc,
+	0x66, 0xee, 0xb8, 0xa7, 0xc6, 0x19, 0x77, 0x7b, 0x93, 0x2b, 0xeb, 0x97, 0xf8, 0x3e, 0x89, 0x5d,
+	0x3b, 0x72, 0x62, 0x7e, 0x60, 0x49, 0xae, 0xa5, 0xa8, 0xdb, 0xc5, 0x07, 0xa5, 0xb2, 0x7e, 0xbe,
+	0x4e, 0xf6, 0xa8, 0x47, 0xa7, 0xff, 0x34, 0xaf, 0x93, 0x5a, 0x4e, 0xc2, 0xf7, 0x2d, 0x7e, 0x10,
+	0x61, 0xa6, 0x7e, 0x35, 0xb2, 0xa9, 0x91, 0x09, 0x66, 0x9c, 0x84, 0x9e, 0x81, 0xb1, 0x9e, 0x5a,
+	0x2c, 0x89, 0x22, 0xff, 0xc0, 0x80, 0xbd, 0x92, 0x5a, 0x78, 0x42, 0x5c, 0x1c, 0x22, 0x6c, 0x40,
+	0xd7, 0x52, 0xcb, 0xa3, 0x13, 0x03, 0xf0, 0x6a, 0x6a, 0x45, 0x4e, 0xec, 0x04, 0x7a, 0x54, 0x64,
+	0x1e, 0x51, 0xe6, 0xf8, 0x25, 0xd2, 0xd9, 0xd4, 0x4a, 0x22, 0x2f, 0x76, 0x5c, 0x6c, 0x4e, 0xdb,
+	0x25, 0x8c, 0xc7, 0x64, 0x94, 0x70, 0x42, 0x43, 0x03, 0xe3, 0x6c, 0x6a, 0x21, 0x12, 0xa3, 0x84,
+	0x70, 0x03, 0xd8, 0x49, 0xad, 0x91, 0x13, 0x8e, 0xcd, 0xb3, 0x45, 0x31, 0x61, 0x84, 0x99, 0xa3,
+	0x02, 0x12, 0x72, 0xf3, 0x3a, 0x30, 0xdf, 0x61, 0xfb, 0xe6, 0x15, 0x3c, 0x9b, 0x5a, 0x8c, 0x3b,
+	0x63, 0x23, 0xb8, 0xf5, 0x47, 0x0b, 0x2c, 0xdf, 0x46, 0x88, 0x26, 0x21, 0x87, 0x1f, 0x82, 0xe3,
+	0x23, 0x87, 0x61, 0xdb, 0x51, 0xcf, 0x9d, 0xe6, 0x66, 0xf3, 0xc2, 0xb1, 0xb7, 0xce, 0xf5, 0x0a,
+	0xef, 0x4a, 0xda, 0x13, 0xdb, 0xd5, 0x9b, 0x5c, 0xe9, 0xed, 0x38, 0x0c, 0xeb, 0xc0, 0x41, 0x63,
+	0x78, 0x6c, 0x34, 0x7d, 0x84, 0x13, 0xb0, 0x8e, 0x68, 0xc8, 0x49, 0x98, 0xd0, 0x84, 0xd9, 0x7a,
+	0x6b, 0x73, 0xd5, 0x05, 0xa9, 0xfa, 0xae, 0x49, 0x55, 0x31, 0x85, 0xfa, 0x6e, 0x1e, 0xff, 0xa9,
+	0x1a, 0x9c, 0x5a, 0x75, 0xd0, 0x0c, 0x0c, 0x06, 0x60, 0xcd, 0xc5, 0xbe, 0x73, 0x80, 0xdd, 0x9a,
+	0xe9, 0xa2, 0x34, 0xbd, 0x3a, 0xdf, 0xf4, 0x03, 0x15, 0x5c, 0x73, 0x5c, 0x75, 0x4d, 0x00, 0x8c,
+	0x40, 0x27, 0xc2, 0x31, 0xa1, 0x2e, 0x41, 0x35, 0xbf, 0x96, 0xf4, 0x7b, 0x7b, 0xbe, 0xdf, 0x3d,
+	0x1d, 0x5d, 0x33, 0x3c, 0x13, 0x19, 0x11, 0xf8, 0x31, 0xf8, 0x7f, 0x40, 0xdd, 0xc4, 0x9f, 0x6e,
+	0xd1, 0x11, 0xe9, 0xf3, 0x7a, 0xd9, 0x47, 0xd5, 0x8b, 0x70, 0xd8, 0x93, 0xec, 0xa9, 0xf0, 0xff,
+	0x82, 0xe2, 0x40, 0xff, 0xc6, 0xd3, 0xc7, 0xdb, 0xef, 0x5c, 0xf4, 0x08, 0xdf, 0x4f, 0x46, 0x3d,
+	0x44, 0x03, 0x5d, 0xdd, 0x59, 0xc5, 0x33, 0x77, 0x6c, 0xe9, 0xfa, 0xc4, 0x69, 0x44, 0x63, 0x8e,
+	0xdd, 0x9e, 0x0e, 0xdd, 0x39, 0x02, 0x16, 0x59, 0x12, 0x6c, 0xfd, 0xd0, 0x04, 0x4b, 0xf7, 0xa5,
+	0x1d, 0xbc, 0x0e, 0x96, 0x94, 0xb1, 0x7e, 0x6f, 0xba, 0xb3, 0x92, 0x52, 0xfc, 0x41, 0x63, 0xa8,
+	0xf9, 0xfd, 0x9b, 0xff, 0x3c, 0xda, 0x68, 0x3e, 0x7d, 0xbc, 0x7d, 0xed, 0xb0, 0x54, 0x74, 0x23,
+	0xc8, 0x93, 0x51, 0x4a, 0x1f, 0x65, 0xc9, 0x7c, 0xb7, 0x00, 0xda, 0x77, 0x74, 0x3f, 0x80, 0x77,
+	0xc1, 0x71, 0xfc, 0x4d, 0x42, 0x26, 0x14, 0x39, 0xa2, 0x44, 0x75, 0x52, 0xe7, 0xcb, 0x49, 0x65,
+	0xdd, 0x43, 0xa4, 0x75, 0xa7, 0xc0, 0x1e, 0x34, 0x86, 0xa5, 0x68, 0xf8, 0x10, 0x9c, 0xf6, 0x89,
+	0xb7, 0xcf, 0x6d, 0xe4, 0x13, 0x1c, 0x72, 0xdb, 0xe1, 0xdc, 0x41, 0x63, 0xfd, 0x2e, 0x5f, 0x9a,
+	0x2d, 0x7a, 0x57, 0x04, 0xed, 0xca, 0x98, 0xdb, 0x32, 0x64, 0xd0, 0x18, 0x9e, 0xf2, 0xab, 0x83,
+	0xfd, 0xdb, 0x7a, 0x05, 0x6e, 0x1c, 0xb2, 0x00, 0x79, 0xb7, 0xcb, 0x97, 0x20, 0x9b, 0x6f, 0xb6,
+	0x06, 0xbf, 0x35, 0xc1, 0xa9, 0x3d, 0xe6, 0xdd, 0x4f, 0x46, 0x01, 0xe1, 0xf9, 0x62, 0xbc, 0x0f,
+	0xda, 0x59, 0xa8, 0xa9, 0xaa, 0x8b, 0x27, 0x40, 0xae, 0x38, 0xcc, 0x43, 0xe0, 0x1e, 0x68, 0x89,
+	0xfa, 0xd6, 0xd3, 0xb5, 0x66, 0x4f, 0xb7, 0xe6, 0x2c, 0xba, 0xc4, 0x4e, 0xfb, 0xc9, 0xf3, 0x8d,
+	0xc6, 0xb3, 0xe7, 0x1b, 0xcd, 0xa1, 0x94, 0xe9, 0xb7, 0xbf, 0x7f, 0xb4, 0xd1, 0x10, 0x33, 0xde,
+	0xfa, 0xb5, 0x98, 0xed, 0x3d, 0xdd, 0x86, 0xe1, 0x40, 0xdb, 0xa9, 0x4c, 0x2f, 0x96, 0xed, 0x3c,
+	0x3a, 0x29, 0x39, 0x65, 0x51, 0x26, 0x27, 0xd8, 0x07, 0xcb, 0xa2, 0x5b, 0xe0, 0xbc, 0xed, 0x6c,
+	0xce, 0x9c, 0xf6, 0xae, 0xe2, 0x0d, 0xb3, 0x80, 0x42, 0x96, 0x3f, 0x37, 0x41, 0x3b, 0x4f, 0xee,
+	0x66, 0x29, 0xb9, 0x73, 0xc6, 0xe4, 0xe6, 0xe6, 0x74, 0xeb, 0x3f, 0xe7, 0xb4, 0xd3, 0x12, 0x12,
+	0xd3, 0xcc, 0x5a, 0x32, 0xab, 0xdf, 0x97, 0xc0, 0xb2, 0x26, 0xc0, 0x6b, 0xa0, 0xc5, 0x71, 0xca,
+	0xe7, 0x26, 0xf5, 0x09, 0x4e, 0xf3, 0xc5, 0x1a, 0x34, 0x86, 0x32, 0x00, 0x7e, 0x01, 0x4e, 0xca,
+	0xa3, 0x10, 0x73, 0x1c, 0xdb, 0x68, 0xdf, 0x09, 0xbd, 0x19, 0xbb, 0x2c, 0x59, 0x4c, 0x4e, 0x2e,
+	0xe3, 0xef, 0x4a, 0x7a, 0x41, 0xf2, 0x44, 0x54, 0x86, 0xe0, 0x43, 0x70, 0x92, 0xd1, 0xaf, 0xf8,
+	0xb7, 0x4e, 0x8c, 0x6d, 0x7d, 0x98, 0xea, 0x4e, 0x7c, 0xb9, 0xac, 0xae, 0x41, 0xd9, 0x1d, 0x74,
+	0xc0, 0x03, 0x35, 0x54, 0x94, 0x67, 0x65, 0x08, 0x46, 0x60, 0x0d, 0x39, 0x21, 0xc2, 0xbe, 0x5d,
+	0x73, 0x69, 0x99, 0x0e, 0x99, 0x82, 0xcb, 0xae, 0x8c, 0x9b, 0xed, 0xb5, 0x8a, 0x4c, 0x04, 0xe8,
+	0x83, 0x15, 0x44, 0x83, 0x20, 0x09, 0x09, 0x3f, 0xb0, 0x23, 0x4a, 0x7d, 0x9b, 0x45, 0x38, 0x74,
+	0x75, 0x1b, 0xbe, 0x5e, 0xb6, 0x2b, 0xde, 0x10, 0xd4, 0x6e, 0xea, 0xc8, 0x7b, 0x94, 0xfa, 0xf7,
+	0x45, 0x5c, 0xc1, 0x10, 0xa2, 0x1a, 0x0a, 0x3d, 0xb0, 0xc2, 0x63, 0x12, 0xd9, 0xfa, 0x22, 0x61,
+	0x8f, 0x62, 0xec, 0x8c, 0x71, 0xdc, 0x59, 0x32, 0x1d, 0x66, 0x9a, 0x24, 0x77, 0x3a, 0x26, 0xd1,
+	0xae, 0x7a, 0xdc, 0x51, 0x21, 0x45, 0x23, 0x5e, 0x43, 0xe1, 0xd7, 0x60, 0x35, 0xc6, 0x0c, 0xf3,
+	0x9a, 0xd3, 0xb2, 0xe9, 0x18, 0x2b, 0x38, 0x0d, 0x45, 0xd4, 0x4c, 0xab, 0xd3, 0x71, 0x1d, 0x86,
+	0xef, 0x81, 0x16, 0x4e, 0x31, 0xea, 0xb4, 0xeb, 0x27, 0x57, 0xb9, 0x0d, 0xa5, 0x18, 0x15, 0x5f,
+	0x57, 0x11, 0xd4, 0xbf, 0xae, 0xfb, 0xe4, 0xe5, 0x43, 0xfa, 0x64, 0x7e, 0xef, 0xcb, 0x4b, 0x48,
+	0xb7, 0xc7, 0x1f, 0x9b, 0xe0, 0x78, 0x51, 0x19, 0xae, 0x80, 0x23, 0x9c, 0x70, 0x5f, 0xd5, 0xf3,
+	0xd1, 0xa1, 0x7a, 0x80, 0x9b, 0xe0, 0x98, 0x8b, 0x19, 0x8a, 0x49, 0x24, 0xcf, 0x8e, 0x05, 0x89,
+	0x15, 0x87, 0x60, 0x1f, 0xb4, 0x02, 0xe6, 0xb1, 0xce, 0xe2, 0xe6, 0xe2, 0xdc, 0x12, 0xde, 0xc3,
+	0x8c, 0x39, 0x1e, 0xd6, 0x25, 0x2c, 0x63, 0x54, 0x67, 0xf9, 0xe5, 0xd1, 0x46, 0x63, 0xeb, 0xaf,
+	0x25, 0xb0, 0xac, 0x19, 0xf0, 0x01, 0x80, 0xe2, 0xa8, 0xb5, 0x93, 0xc8, 0x75, 0x38, 0xb6, 0x55,
+	0xc1, 0x75, 0x9a, 0xf5, 0x65, 0x9a, 0xde, 0xc1, 0xf6, 0x98, 0xf7, 0x40, 0xb2, 0x65, 0x4d, 0xb2,
+	0x41, 0x63, 0x78, 0x52, 0x40, 0xc5, 0x31, 0x21, 0x2b, 0xee, 0x9a, 0x15, 0xd9, 0x05, 0x93, 0xac,
+	0xe0, 0xcd, 0x90, 0x15, 0x50, 0x49, 0xf6, 0x73, 0xb0, 0xa2, 0x2e, 0xaa, 0x15, 0x61, 0x55, 0xde,
+	0x6f, 0x54, 0xde, 0x18, 0xc9, 0x34, 0x4b, 0x43, 0x05, 0x96, 0xc4, 0x03, 0xb0, 0x5e, 0x2c, 0x9e,
+	0x8a, 0x85, 0xaa, 0xed, 0xed, 0xf9, 0xc5, 0x56, 0x37, 0xea, 0x14, 0x29, 0x25, 0x3b, 0x07, 0x9c,
+	0xc9, 0x0e, 0xb1, 0x8a, 0x95, 0xaa, 0xeb, 0x37, 0xe7, 0x1e, 0x78, 0x15, 0x9b, 0x95, 0x0c, 0x2e,
+	0x59, 0x0c, 0xc1, 0x29, 0x8f, 0x4e, 0x2a, 0xea, 0xaa, 0x8e, 0x5f, 0x9b, 0x75, 0xbe, 0x55, 0x84,
+	0x4f, 0x78, 0x74, 0x52, 0xdd, 0x59, 0xf1, 0x3d, 0x50, 0x11, 0x5d, 0x36, 0xed, 0xac, 0xe0, 0xcd,
+	0xd8, 0x59, 0x01, 0x55, 0x57, 0x23, 0xfb, 0x98, 0xa8, 0x48, 0xb7, 0x4d, 0xab, 0x91, 0x71, 0x67,
+	0xac, 0x46, 0x06, 0x97, 0x2c, 0xbe, 0x04, 0xab, 0xfa, 0x8b, 0xa4, 0xe2, 0x70, 0x54, 0x3a, 0x5c,
+	0xa8, 0x38, 0x28, 0xaa, 0xd9, 0xe0, 0xb4, 0x46, 0x8b, 0xc3, 0xba, 0xd8, 0x77, 0x6e, 0x3d, 0x79,
+	0xd1, 0x6d, 0x3e, 0x7b, 0xd1, 0x6d, 0xfe, 0xfd, 0xa2, 0xdb, 0xfc, 0xe9, 0x65, 0xb7, 0xf1, 0xec,
+	0x65, 0xb7, 0xf1, 0xe7, 0xcb, 0x6e, 0xe3, 0xb3, 0xf3, 0x73, 0xfb, 0x47, 0xfe, 0xc9, 0x3c, 0x5a,
+	0x92, 0x1f, 0x49, 0x57, 0xff, 0x1d, 0x00, 0x09, 0x1e, 0xd0, 0x72, 0x46, 0x0f, 0x00, 0x00,
 }
 
 func (this *Supply) Equal(that interface{}) bool {
@@ -751,6 +1057,30 @@ func (this *Evidence_Equivocation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Evidence_LightClientAttack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Evidence_LightClientAttack)
+	if !ok {
+		that2, ok := that.(Evidence_LightClientAttack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LightClientAttack.Equal(that1.LightClientAttack) {
+		return false
+	}
+	return true
+}
 func (this *MsgSubmitEvidence) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -982,6 +1312,78 @@ func (this *Content_CommunityPoolSpend) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Content_TripCircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Content_TripCircuitBreaker)
+	if !ok {
+		that2, ok := that.(Content_TripCircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TripCircuitBreaker.Equal(that1.TripCircuitBreaker) {
+		return false
+	}
+	return true
+}
+func (this *Content_ResetCircuitBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Content_ResetCircuitBreaker)
+	if !ok {
+		that2, ok := that.(Content_ResetCircuitBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ResetCircuitBreaker.Equal(that1.ResetCircuitBreaker) {
+		return false
+	}
+	return true
+}
+func (this *Content_Exec) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Content_Exec)
+	if !ok {
+		that2, ok := that.(Content_Exec)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Exec.Equal(that1.Exec) {
+		return false
+	}
+	return true
+}
 func (this *Account) GetAccount() github_com_cosmos_cosmos_sdk_x_auth_exported.Account {
 	if x := this.GetBaseAccount(); x != nil {
 		return x
@@ -1050,6 +1452,9 @@ func (this *Evidence) GetEvidence() github_com_cosmos_cosmos_sdk_x_evidence_expo
 	if x := this.GetEquivocation(); x != nil {
 		return x
 	}
+	if x := this.GetLightClientAttack(); x != nil {
+		return x
+	}
 	return nil
 }
 
@@ -1065,6 +1470,12 @@ func (this *Evidence) SetEvidence(value github_com_cosmos_cosmos_sdk_x_evidence_
 	case types3.Equivocation:
 		this.Sum = &Evidence_Equivocation{&vt}
 		return nil
+	case *types3.LightClientAttack:
+		this.Sum = &Evidence_LightClientAttack{vt}
+		return nil
+	case types3.LightClientAttack:
+		this.Sum = &Evidence_LightClientAttack{&vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message Evidence", value)
 }
@@ -1085,6 +1496,15 @@ func (this *Content) GetContent() github_com_cosmos_cosmos_sdk_x_gov_types.Conte
 	if x := this.GetCommunityPoolSpend(); x != nil {
 		return x
 	}
+	if x := this.GetTripCircuitBreaker(); x != nil {
+		return x
+	}
+	if x := this.GetResetCircuitBreaker(); x != nil {
+		return x
+	}
+	if x := this.GetExec(); x != nil {
+		return x
+	}
 	return nil
 }
 
@@ -1124,6 +1544,24 @@ func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.C
 	case types6.CommunityPoolSpendProposal:
 		this.Sum = &Content_CommunityPoolSpend{&vt}
 		return nil
+	case *types7.TripCircuitBreakerProposal:
+		this.Sum = &Content_TripCircuitBreaker{vt}
+		return nil
+	case types7.TripCircuitBreakerProposal:
+		this.Sum = &Content_TripCircuitBreaker{&vt}
+		return nil
+	case *types7.ResetCircuitBreakerProposal:
+		this.Sum = &Content_ResetCircuitBreaker{vt}
+		return nil
+	case types7.ResetCircuitBreakerProposal:
+		this.Sum = &Content_ResetCircuitBreaker{&vt}
+		return nil
+	case *ExecProposal:
+		this.Sum = &Content_Exec{vt}
+		return nil
+	case ExecProposal:
+		this.Sum = &Content_Exec{&vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message Content", value)
 }
@@ -1371,6 +1809,27 @@ func (m *Evidence_Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LightClientAttack != nil {
+		{
+			size, err := m.LightClientAttack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1641,260 +2100,1588 @@ func (m *Content_CommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCodec(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+func (m *Content_TripCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Account_BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Content_TripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TripCircuitBreaker != nil {
+		{
+			size, err := m.TripCircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Account_ContinuousVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContinuousVestingAccount != nil {
-		l = m.ContinuousVestingAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Content_ResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Account_DelayedVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DelayedVestingAccount != nil {
-		l = m.DelayedVestingAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Content_ResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResetCircuitBreaker != nil {
+		{
+			size, err := m.ResetCircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Account_PeriodicVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PeriodicVestingAccount != nil {
-		l = m.PeriodicVestingAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Content_Exec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Account_ModuleAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ModuleAccount != nil {
-		l = m.ModuleAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Content_Exec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Exec != nil {
+		{
+			size, err := m.Exec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Supply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (m *ExecProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Supply_Supply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Supply != nil {
-		l = m.Supply.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
-}
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+func (m *ExecProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Evidence_Equivocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExecProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Equivocation != nil {
-		l = m.Equivocation.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
-}
-func (m *MsgSubmitEvidence) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MsgSubmitEvidenceBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MsgSubmitProposalBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ProposalBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	l = m.Content.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	return n
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Content) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Sum != nil {
-		n += m.Sum.Size()
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Content_Text) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_AuthUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Content_ParameterChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParameterChange != nil {
-		l = m.ParameterChange.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_AuthUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AuthUpdateParams != nil {
+		{
+			size, err := m.AuthUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Content_SoftwareUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SoftwareUpgrade != nil {
-		l = m.SoftwareUpgrade.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_BankUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Content_CancelSoftwareUpgrade) Size() (n int) {
+
+func (m *Message_BankUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BankUpdateParams != nil {
+		{
+			size, err := m.BankUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_CrisisUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CrisisUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CrisisUpdateParams != nil {
+		{
+			size, err := m.CrisisUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_DistributionUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_DistributionUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DistributionUpdateParams != nil {
+		{
+			size, err := m.DistributionUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_EvidenceUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_EvidenceUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EvidenceUpdateParams != nil {
+		{
+			size, err := m.EvidenceUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_GovUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_GovUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GovUpdateParams != nil {
+		{
+			size, err := m.GovUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MintUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MintUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MintUpdateParams != nil {
+		{
+			size, err := m.MintUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_SlashingUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_SlashingUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SlashingUpdateParams != nil {
+		{
+			size, err := m.SlashingUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_StakingUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_StakingUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StakingUpdateParams != nil {
+		{
+			size, err := m.StakingUpdateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCodec(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Account_BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ContinuousVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContinuousVestingAccount != nil {
+		l = m.ContinuousVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_DelayedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedVestingAccount != nil {
+		l = m.DelayedVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_PeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVestingAccount != nil {
+		l = m.PeriodicVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModuleAccount != nil {
+		l = m.ModuleAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Supply_Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supply != nil {
+		l = m.Supply.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Evidence_Equivocation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.CancelSoftwareUpgrade != nil {
-		l = m.CancelSoftwareUpgrade.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	var l int
+	_ = l
+	if m.Equivocation != nil {
+		l = m.Equivocation.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightClientAttack != nil {
+		l = m.LightClientAttack.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.MsgSubmitEvidenceBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MsgSubmitProposalBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProposalBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.Content.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *Content) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Content_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_ParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParameterChange != nil {
+		l = m.ParameterChange.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_SoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SoftwareUpgrade != nil {
+		l = m.SoftwareUpgrade.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_CancelSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelSoftwareUpgrade != nil {
+		l = m.CancelSoftwareUpgrade.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_CommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommunityPoolSpend != nil {
+		l = m.CommunityPoolSpend.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_TripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TripCircuitBreaker != nil {
+		l = m.TripCircuitBreaker.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_ResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetCircuitBreaker != nil {
+		l = m.ResetCircuitBreaker.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_Exec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exec != nil {
+		l = m.Exec.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Message_AuthUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthUpdateParams != nil {
+		l = m.AuthUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_BankUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BankUpdateParams != nil {
+		l = m.BankUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_CrisisUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrisisUpdateParams != nil {
+		l = m.CrisisUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_DistributionUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionUpdateParams != nil {
+		l = m.DistributionUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_EvidenceUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvidenceUpdateParams != nil {
+		l = m.EvidenceUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_GovUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateParams != nil {
+		l = m.GovUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MintUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MintUpdateParams != nil {
+		l = m.MintUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_SlashingUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashingUpdateParams != nil {
+		l = m.SlashingUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_StakingUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakingUpdateParams != nil {
+		l = m.StakingUpdateParams.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.BaseAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_BaseAccount{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.ContinuousVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ContinuousVestingAccount{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.DelayedVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_DelayedVestingAccount{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.PeriodicVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_PeriodicVestingAccount{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types2.ModuleAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ModuleAccount{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types2.Supply{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Supply_Supply{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types3.Equivocation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_Equivocation{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClientAttack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types3.LightClientAttack{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_LightClientAttack{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitEvidenceBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgSubmitEvidenceBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposalBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgSubmitProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &Content{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-func (m *Content_CommunityPoolSpend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CommunityPoolSpend != nil {
-		l = m.CommunityPoolSpend.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCodec(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Account) Unmarshal(dAtA []byte) error {
+func (m *Content) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,15 +3704,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: Content: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1952,15 +3739,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.BaseAccount{}
+			v := &types4.TextProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_BaseAccount{v}
+			m.Sum = &Content_Text{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1987,15 +3774,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types1.ContinuousVestingAccount{}
+			v := &proposal.ParameterChangeProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_ContinuousVestingAccount{v}
+			m.Sum = &Content_ParameterChange{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2022,15 +3809,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types1.DelayedVestingAccount{}
+			v := &types5.SoftwareUpgradeProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_DelayedVestingAccount{v}
+			m.Sum = &Content_SoftwareUpgrade{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelSoftwareUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2057,15 +3844,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types1.PeriodicVestingAccount{}
+			v := &types5.CancelSoftwareUpgradeProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_PeriodicVestingAccount{v}
+			m.Sum = &Content_CancelSoftwareUpgrade{v}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2092,68 +3879,50 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types2.ModuleAccount{}
+			v := &types6.CommunityPoolSpendProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_ModuleAccount{v}
+			m.Sum = &Content_CommunityPoolSpend{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripCircuitBreaker", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Supply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types7.TripCircuitBreakerProposal{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Content_TripCircuitBreaker{v}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetCircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2180,68 +3949,15 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types2.Supply{}
+			v := &types7.ResetCircuitBreakerProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Supply_Supply{v}
+			m.Sum = &Content_ResetCircuitBreaker{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Evidence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equivocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2268,11 +3984,11 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types3.Equivocation{}
+			v := &ExecProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Evidence_Equivocation{v}
+			m.Sum = &Content_Exec{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2298,7 +4014,7 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+func (m *ExecProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,17 +4037,17 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2341,31 +4057,59 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &Evidence{}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitEvidenceBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2392,7 +4136,8 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MsgSubmitEvidenceBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, Message{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2420,7 +4165,7 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2443,15 +4188,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposalBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2478,13 +4223,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MsgSubmitProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types.MsgUpdateParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_AuthUpdateParams{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2511,69 +4258,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &Content{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types8.MsgUpdateParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_BankUpdateParams{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrisisUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2600,13 +4293,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types9.MsgUpdateParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_CrisisUpdateParams{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2633,66 +4328,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types6.MsgUpdateParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_DistributionUpdateParams{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Content) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Content: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2719,15 +4363,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types4.TextProposal{}
+			v := &types3.MsgUpdateParams{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_Text{v}
+			m.Sum = &Message_EvidenceUpdateParams{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParameterChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2754,15 +4398,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &proposal.ParameterChangeProposal{}
+			v := &types4.MsgUpdateParams{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_ParameterChange{v}
+			m.Sum = &Message_GovUpdateParams{v}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2789,15 +4433,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types5.SoftwareUpgradeProposal{}
+			v := &types10.MsgUpdateParams{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_SoftwareUpgrade{v}
+			m.Sum = &Message_MintUpdateParams{v}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelSoftwareUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2824,15 +4468,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types5.CancelSoftwareUpgradeProposal{}
+			v := &types11.MsgUpdateParams{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_CancelSoftwareUpgrade{v}
+			m.Sum = &Message_SlashingUpdateParams{v}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUpdateParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2859,11 +4503,11 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types6.CommunityPoolSpendProposal{}
+			v := &types12.MsgUpdateParams{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_CommunityPoolSpend{v}
+			m.Sum = &Message_StakingUpdateParams{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
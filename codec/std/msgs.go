@@ -11,6 +11,7 @@ import (
 var (
 	_ eviexported.MsgSubmitEvidence = MsgSubmitEvidence{}
 	_ gov.MsgSubmitProposalI        = MsgSubmitProposal{}
+	_ sdk.MsgWithNestedMsgs         = MsgSubmitProposal{}
 )
 
 // NewMsgSubmitEvidence returns a new MsgSubmitEvidence.
@@ -82,3 +83,16 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 func (msg MsgSubmitProposal) GetContent() gov.Content      { return msg.Content.GetContent() }
 func (msg MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return msg.InitialDeposit }
 func (msg MsgSubmitProposal) GetProposer() sdk.AccAddress  { return msg.Proposer }
+
+// GetNestedMsgs implements sdk.MsgWithNestedMsgs, returning the messages
+// executed by the content of the proposal if it is an ExecProposal.
+func (msg MsgSubmitProposal) GetNestedMsgs() []sdk.Msg {
+	if msg.Content == nil {
+		return nil
+	}
+	if c, ok := msg.GetContent().(gov.ExecContent); ok {
+		return c.GetMsgs()
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package std
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var _ gov.ExecContent = ExecProposal{}
+
+func init() {
+	gov.RegisterProposalTypeInterface((*isMessage_Sum)(nil))
+	registerExecProposal(gov.RegisterProposalTypeCodec)
+}
+
+// RegisterCodec registers the ExecProposal and the messages it can carry on the
+// given Amino codec.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*isMessage_Sum)(nil), nil)
+	registerExecProposal(func(o interface{}, name string) { cdc.RegisterConcrete(o, name, nil) })
+}
+
+func registerExecProposal(register func(o interface{}, name string)) {
+	register(ExecProposal{}, "cosmos-sdk/ExecProposal")
+	register(&Message_AuthUpdateParams{}, "cosmos-sdk/ExecMsgUpdateAuthParams")
+	register(&Message_BankUpdateParams{}, "cosmos-sdk/ExecMsgUpdateBankParams")
+	register(&Message_CrisisUpdateParams{}, "cosmos-sdk/ExecMsgUpdateCrisisParams")
+	register(&Message_DistributionUpdateParams{}, "cosmos-sdk/ExecMsgUpdateDistributionParams")
+	register(&Message_EvidenceUpdateParams{}, "cosmos-sdk/ExecMsgUpdateEvidenceParams")
+	register(&Message_GovUpdateParams{}, "cosmos-sdk/ExecMsgUpdateGovParams")
+	register(&Message_MintUpdateParams{}, "cosmos-sdk/ExecMsgUpdateMintParams")
+	register(&Message_SlashingUpdateParams{}, "cosmos-sdk/ExecMsgUpdateSlashingParams")
+	register(&Message_StakingUpdateParams{}, "cosmos-sdk/ExecMsgUpdateStakingParams")
+}
+
+// NewExecProposal returns a new ExecProposal executing the given messages. An
+// error is returned if a message cannot be executed by an ExecProposal.
+func NewExecProposal(title, description string, msgs ...sdk.Msg) (ExecProposal, error) {
+	p := ExecProposal{Title: title, Description: description, Msgs: make([]Message, len(msgs))}
+	for i, msg := range msgs {
+		if err := p.Msgs[i].SetMsg(msg); err != nil {
+			return ExecProposal{}, err
+		}
+	}
+
+	return p, nil
+}
+
+// GetTitle returns the title of the proposal.
+func (p ExecProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p ExecProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p ExecProposal) ProposalRoute() string { return gov.ExecRouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p ExecProposal) ProposalType() string { return gov.ProposalTypeExec }
+
+// GetMsgs returns the messages executed by the proposal.
+func (p ExecProposal) GetMsgs() []sdk.Msg {
+	msgs := make([]sdk.Msg, len(p.Msgs))
+	for i, m := range p.Msgs {
+		msgs[i] = m.GetMsg()
+	}
+
+	return msgs
+}
+
+// ValidateBasic runs basic stateless validity checks on the proposal and each of
+// its messages.
+func (p ExecProposal) ValidateBasic() error {
+	if err := gov.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Msgs) == 0 {
+		return sdkerrors.Wrap(gov.ErrInvalidProposalContent, "proposal must contain at least one message")
+	}
+
+	for i, msg := range p.GetMsgs() {
+		if msg == nil {
+			return sdkerrors.Wrapf(gov.ErrInvalidProposalMsg, "message %d is empty", i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Equal returns true if both proposals have the same encoding.
+func (p *ExecProposal) Equal(that interface{}) bool {
+	var other *ExecProposal
+	switch t := that.(type) {
+	case *ExecProposal:
+		other = t
+	case ExecProposal:
+		other = &t
+	default:
+		return false
+	}
+
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	bz, err := p.Marshal()
+	if err != nil {
+		return false
+	}
+	otherBz, err := other.Marshal()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(bz, otherBz)
+}
+
+// String implements the Stringer interface.
+func (p ExecProposal) String() string {
+	out, _ := yaml.Marshal(struct {
+		Title       string
+		Description string
+		Msgs        []sdk.Msg
+	}{p.Title, p.Description, p.GetMsgs()})
+	return string(out)
+}
+
+// GetMsg returns the message held by the Message, or nil if it is empty.
+func (m Message) GetMsg() sdk.Msg {
+	switch sum := m.Sum.(type) {
+	case *Message_AuthUpdateParams:
+		return *sum.AuthUpdateParams
+	case *Message_BankUpdateParams:
+		return *sum.BankUpdateParams
+	case *Message_CrisisUpdateParams:
+		return *sum.CrisisUpdateParams
+	case *Message_DistributionUpdateParams:
+		return *sum.DistributionUpdateParams
+	case *Message_EvidenceUpdateParams:
+		return *sum.EvidenceUpdateParams
+	case *Message_GovUpdateParams:
+		return *sum.GovUpdateParams
+	case *Message_MintUpdateParams:
+		return *sum.MintUpdateParams
+	case *Message_SlashingUpdateParams:
+		return *sum.SlashingUpdateParams
+	case *Message_StakingUpdateParams:
+		return *sum.StakingUpdateParams
+	default:
+		return nil
+	}
+}
+
+// SetMsg sets the message held by the Message. An error is returned if the
+// message cannot be executed by an ExecProposal.
+func (m *Message) SetMsg(msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case auth.MsgUpdateParams:
+		m.Sum = &Message_AuthUpdateParams{&msg}
+	case bank.MsgUpdateParams:
+		m.Sum = &Message_BankUpdateParams{&msg}
+	case crisis.MsgUpdateParams:
+		m.Sum = &Message_CrisisUpdateParams{&msg}
+	case distribution.MsgUpdateParams:
+		m.Sum = &Message_DistributionUpdateParams{&msg}
+	case evidence.MsgUpdateParams:
+		m.Sum = &Message_EvidenceUpdateParams{&msg}
+	case gov.MsgUpdateParams:
+		m.Sum = &Message_GovUpdateParams{&msg}
+	case mint.MsgUpdateParams:
+		m.Sum = &Message_MintUpdateParams{&msg}
+	case slashing.MsgUpdateParams:
+		m.Sum = &Message_SlashingUpdateParams{&msg}
+	case staking.MsgUpdateParams:
+		m.Sum = &Message_StakingUpdateParams{&msg}
+	default:
+		return fmt.Errorf("message %T is not supported by an exec proposal", msg)
+	}
+
+	return nil
+}
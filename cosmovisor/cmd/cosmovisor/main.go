@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+)
+
+func main() {
+	if err := Run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "cosmovisor: %+v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Run runs the node with the given args, restarting it after each upgrade if
+// DAEMON_RESTART_AFTER_UPGRADE is set
+func Run(args []string) error {
+	cfg, err := cosmovisor.GetConfigFromEnv()
+	if err != nil {
+		return err
+	}
+
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr)).With("module", "cosmovisor")
+	launcher := cosmovisor.NewLauncher(logger, *cfg)
+
+	for {
+		upgraded, err := launcher.Run(args, os.Stdout, os.Stderr)
+		if err != nil {
+			return err
+		}
+
+		if !upgraded || !cfg.RestartAfterUpgrade {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package cosmovisor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+)
+
+// environment variables used to configure the supervisor
+const (
+	EnvHome                = "DAEMON_HOME"
+	EnvName                = "DAEMON_NAME"
+	EnvDownloadBinaries    = "DAEMON_ALLOW_DOWNLOAD_BINARIES"
+	EnvRestartAfterUpgrade = "DAEMON_RESTART_AFTER_UPGRADE"
+	EnvPollInterval        = "DAEMON_POLL_INTERVAL"
+	EnvShutdownGrace       = "DAEMON_SHUTDOWN_GRACE"
+	EnvSkipBackup          = "UNSAFE_SKIP_BACKUP"
+)
+
+// default values of the optional settings
+const (
+	DefaultPollInterval  = 300 * time.Millisecond
+	DefaultShutdownGrace = 10 * time.Second
+)
+
+// directory layout under the home directory
+const (
+	rootName        = "cosmovisor"
+	genesisDir      = "genesis"
+	upgradesDir     = "upgrades"
+	currentLink     = "current"
+	binDir          = "bin"
+	dataDir         = "data"
+	backupDirPrefix = "data-backup-"
+)
+
+// Config is the information passed in to control the supervisor
+type Config struct {
+	// Home is the home directory of the node, which holds the data directory
+	// and the cosmovisor directory with the node binaries
+	Home string
+	// Name is the name of the node binary
+	Name string
+	// AllowDownloadBinaries enables the download of the upgraded binary when
+	// it is missing from the upgrade directory
+	AllowDownloadBinaries bool
+	// RestartAfterUpgrade restarts the node with the upgraded binary
+	RestartAfterUpgrade bool
+	// UnsafeSkipBackup skips the backup of the data directory before upgrading
+	UnsafeSkipBackup bool
+	// PollInterval is the interval at which the upgrade info file is polled
+	PollInterval time.Duration
+	// ShutdownGrace is the time given to the node to exit once the upgrade is
+	// detected before it is killed
+	ShutdownGrace time.Duration
+}
+
+// Root returns the root directory of the binaries, $DAEMON_HOME/cosmovisor
+func (cfg Config) Root() string {
+	return filepath.Join(cfg.Home, rootName)
+}
+
+// GenesisBin is the path to the genesis binary, which is run until the first
+// upgrade
+func (cfg Config) GenesisBin() string {
+	return filepath.Join(cfg.Root(), genesisDir, binDir, cfg.Name)
+}
+
+// UpgradeDir is the directory of the named upgrade
+func (cfg Config) UpgradeDir(upgradeName string) string {
+	return filepath.Join(cfg.Root(), upgradesDir, upgradeName)
+}
+
+// UpgradeBin is the path to the binary of the named upgrade
+func (cfg Config) UpgradeBin(upgradeName string) string {
+	return filepath.Join(cfg.UpgradeDir(upgradeName), binDir, cfg.Name)
+}
+
+// UpgradeInfoFilePath is the path to the upgrade info file written by the
+// node when an upgrade is needed
+func (cfg Config) UpgradeInfoFilePath() string {
+	return filepath.Join(cfg.Home, dataDir, upgradekeeper.UpgradeInfoFileName)
+}
+
+// DataDir is the data directory of the node, which is backed up before upgrading
+func (cfg Config) DataDir() string {
+	return filepath.Join(cfg.Home, dataDir)
+}
+
+// BackupDir is the directory the data directory is backed up to before the
+// named upgrade
+func (cfg Config) BackupDir(upgradeName string) string {
+	return filepath.Join(cfg.Home, backupDirPrefix+upgradeName)
+}
+
+// symlink is the path to the link to the directory of the current binary
+func (cfg Config) symlink() string {
+	return filepath.Join(cfg.Root(), currentLink)
+}
+
+// CurrentDir returns the directory of the current binary, that is the genesis
+// directory or the directory of the last applied upgrade
+func (cfg Config) CurrentDir() (string, error) {
+	dest, err := os.Readlink(cfg.symlink())
+	switch {
+	case os.IsNotExist(err):
+		return filepath.Join(cfg.Root(), genesisDir), nil
+	case err != nil:
+		return "", err
+	}
+
+	if !filepath.IsAbs(dest) {
+		dest = filepath.Join(cfg.Root(), dest)
+	}
+
+	return dest, nil
+}
+
+// CurrentBin returns the path to the current binary
+func (cfg Config) CurrentBin() (string, error) {
+	dir, err := cfg.CurrentDir()
+	if err != nil {
+		return "", err
+	}
+
+	bin := filepath.Join(dir, binDir, cfg.Name)
+	if err := EnsureBinary(bin); err != nil {
+		return "", err
+	}
+
+	return bin, nil
+}
+
+// CurrentUpgradeName returns the name of the last applied upgrade, or an empty
+// string if the genesis binary is run
+func (cfg Config) CurrentUpgradeName() (string, error) {
+	dir, err := cfg.CurrentDir()
+	if err != nil {
+		return "", err
+	}
+
+	if filepath.Dir(dir) != filepath.Join(cfg.Root(), upgradesDir) {
+		return "", nil
+	}
+
+	return filepath.Base(dir), nil
+}
+
+// SetCurrentUpgrade points the current binary to the one of the named upgrade
+func (cfg Config) SetCurrentUpgrade(upgradeName string) error {
+	if err := validateUpgradeName(upgradeName); err != nil {
+		return err
+	}
+
+	// create the new link next to the current one and rename it over the
+	// current one, so the switch is atomic
+	link := cfg.symlink()
+	tmpLink := link + ".tmp"
+	_ = os.Remove(tmpLink)
+
+	if err := os.Symlink(cfg.UpgradeDir(upgradeName), tmpLink); err != nil {
+		return fmt.Errorf("creating current link: %w", err)
+	}
+
+	if err := os.Rename(tmpLink, link); err != nil {
+		return fmt.Errorf("updating current link: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks that the config is complete
+func (cfg Config) Validate() error {
+	if cfg.Name == "" {
+		return fmt.Errorf("%s is not set", EnvName)
+	}
+
+	if cfg.Home == "" {
+		return fmt.Errorf("%s is not set", EnvHome)
+	}
+
+	if !filepath.IsAbs(cfg.Home) {
+		return fmt.Errorf("%s must be an absolute path", EnvHome)
+	}
+
+	if cfg.PollInterval <= 0 {
+		return errors.New("poll interval must be positive")
+	}
+
+	info, err := os.Stat(cfg.Root())
+	if err != nil {
+		return fmt.Errorf("cannot stat home dir: %w", err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", cfg.Root())
+	}
+
+	return nil
+}
+
+// GetConfigFromEnv parses the config from the environment variables
+func GetConfigFromEnv() (*Config, error) {
+	cfg := &Config{
+		Home: os.Getenv(EnvHome),
+		Name: os.Getenv(EnvName),
+	}
+
+	var err error
+	if cfg.AllowDownloadBinaries, err = boolFromEnv(EnvDownloadBinaries); err != nil {
+		return nil, err
+	}
+
+	if cfg.RestartAfterUpgrade, err = boolFromEnv(EnvRestartAfterUpgrade); err != nil {
+		return nil, err
+	}
+
+	if cfg.UnsafeSkipBackup, err = boolFromEnv(EnvSkipBackup); err != nil {
+		return nil, err
+	}
+
+	if cfg.PollInterval, err = durationFromEnv(EnvPollInterval, DefaultPollInterval); err != nil {
+		return nil, err
+	}
+
+	if cfg.ShutdownGrace, err = durationFromEnv(EnvShutdownGrace, DefaultShutdownGrace); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func boolFromEnv(name string) (bool, error) {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", name, err)
+	}
+
+	return b, nil
+}
+
+// durationFromEnv parses a duration such as "1s", or a number of milliseconds
+func durationFromEnv(name string, defaultValue time.Duration) (time.Duration, error) {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		ms, msErr := strconv.ParseUint(value, 10, 64)
+		if msErr != nil {
+			return 0, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		d = time.Duration(ms) * time.Millisecond
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be positive", name)
+	}
+
+	return d, nil
+}
+
+// validateUpgradeName ensures the upgrade name can be used as a directory name
+func validateUpgradeName(upgradeName string) error {
+	if upgradeName == "" || upgradeName == "." || upgradeName == ".." ||
+		strings.ContainsAny(upgradeName, `/\`) {
+		return fmt.Errorf("invalid upgrade name %q", upgradeName)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package cosmovisor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfigFromEnv(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+
+	testCases := []struct {
+		msg       string
+		env       map[string]string
+		expected  *Config
+		expectErr bool
+	}{
+		{
+			"defaults",
+			map[string]string{EnvHome: cfg.Home, EnvName: "dummyd"},
+			&Config{Home: cfg.Home, Name: "dummyd", PollInterval: DefaultPollInterval, ShutdownGrace: DefaultShutdownGrace},
+			false,
+		},
+		{
+			"all settings",
+			map[string]string{
+				EnvHome: cfg.Home, EnvName: "dummyd", EnvDownloadBinaries: "true", EnvRestartAfterUpgrade: "true",
+				EnvSkipBackup: "true", EnvPollInterval: "100", EnvShutdownGrace: "1m",
+			},
+			&Config{
+				Home: cfg.Home, Name: "dummyd", AllowDownloadBinaries: true, RestartAfterUpgrade: true,
+				UnsafeSkipBackup: true, PollInterval: 100 * time.Millisecond, ShutdownGrace: time.Minute,
+			},
+			false,
+		},
+		{"missing name", map[string]string{EnvHome: cfg.Home}, nil, true},
+		{"missing home", map[string]string{EnvName: "dummyd"}, nil, true},
+		{"relative home", map[string]string{EnvHome: "testdata/validate", EnvName: "dummyd"}, nil, true},
+		{"home without binaries", map[string]string{EnvHome: filepath.Dir(cfg.Home), EnvName: "dummyd"}, nil, true},
+		{"invalid bool", map[string]string{EnvHome: cfg.Home, EnvName: "dummyd", EnvDownloadBinaries: "maybe"}, nil, true},
+		{"invalid duration", map[string]string{EnvHome: cfg.Home, EnvName: "dummyd", EnvPollInterval: "soon"}, nil, true},
+		{"negative duration", map[string]string{EnvHome: cfg.Home, EnvName: "dummyd", EnvShutdownGrace: "-1s"}, nil, true},
+	}
+
+	envs := []string{
+		EnvHome, EnvName, EnvDownloadBinaries, EnvRestartAfterUpgrade, EnvSkipBackup, EnvPollInterval, EnvShutdownGrace,
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			for _, env := range envs {
+				os.Unsetenv(env)
+			}
+			for env, value := range tc.env {
+				os.Setenv(env, value)
+			}
+			defer func() {
+				for _, env := range envs {
+					os.Unsetenv(env)
+				}
+			}()
+
+			res, err := GetConfigFromEnv()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestCurrentUpgrade(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+
+	bin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.GenesisBin(), bin)
+
+	require.NoError(t, cfg.SetCurrentUpgrade("chain2"))
+	current, err := cfg.CurrentUpgradeName()
+	require.NoError(t, err)
+	require.Equal(t, "chain2", current)
+
+	bin, err = cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("chain2"), bin)
+
+	// the link can point to an upgrade without binary, which is then invalid
+	require.NoError(t, cfg.SetCurrentUpgrade("chain3"))
+	_, err = cfg.CurrentBin()
+	require.Error(t, err)
+
+	for _, name := range []string{"", ".", "..", "../genesis", `a\b`} {
+		require.Error(t, cfg.SetCurrentUpgrade(name), name)
+	}
+}
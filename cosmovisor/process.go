@@ -0,0 +1,125 @@
+package cosmovisor
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Launcher runs the node binary as a child process and switches the binary
+// when the node reports an upgrade through the upgrade info file.
+type Launcher struct {
+	cfg    Config
+	logger log.Logger
+}
+
+// NewLauncher creates a new Launcher instance
+func NewLauncher(logger log.Logger, cfg Config) Launcher {
+	return Launcher{cfg: cfg, logger: logger}
+}
+
+// Run runs the current binary with the given args until it exits or until an
+// upgrade is needed. In the latter case the node is stopped, the upgrade is
+// performed and true is returned, so the caller can restart the node with the
+// upgraded binary.
+func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
+	// an upgrade may have been reported while the supervisor was not running
+	if info, err := l.pendingUpgrade(); err != nil {
+		return false, err
+	} else if info != nil {
+		return true, DoUpgrade(l.logger, l.cfg, *info)
+	}
+
+	bin, err := l.cfg.CurrentBin()
+	if err != nil {
+		return false, fmt.Errorf("current binary is invalid: %w", err)
+	}
+
+	cmd := exec.Command(bin, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	l.logger.Info("starting node", "binary", bin, "args", args)
+	if err := cmd.Start(); err != nil {
+		return false, fmt.Errorf("launching node %s: %w", bin, err)
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	ticker := time.NewTicker(l.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-exited:
+			// the node may exit on its own after reporting the upgrade
+			info, infoErr := l.pendingUpgrade()
+			if infoErr != nil {
+				return false, infoErr
+			}
+			if info == nil {
+				return false, err
+			}
+
+			return true, DoUpgrade(l.logger, l.cfg, *info)
+
+		case <-ticker.C:
+			info, err := l.pendingUpgrade()
+			if err != nil {
+				l.logger.Error("cannot read upgrade info", "err", err)
+				continue
+			}
+			if info == nil {
+				continue
+			}
+
+			l.logger.Info("upgrade detected, stopping node", "upgrade", info.Name, "height", info.Height)
+			l.stop(cmd, exited)
+
+			return true, DoUpgrade(l.logger, l.cfg, *info)
+		}
+	}
+}
+
+// pendingUpgrade returns the upgrade reported in the upgrade info file if it is
+// not the current one
+func (l Launcher) pendingUpgrade() (*UpgradeInfo, error) {
+	info, err := ReadUpgradeInfo(l.cfg)
+	if err != nil || info == nil {
+		return nil, err
+	}
+
+	current, err := l.cfg.CurrentUpgradeName()
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Name == current {
+		return nil, nil
+	}
+
+	return info, nil
+}
+
+// stop asks the node to exit and kills it if it is still running after the
+// shutdown grace period. The node halts without exiting on upgrades.
+func (l Launcher) stop(cmd *exec.Cmd, exited <-chan error) {
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		l.logger.Error("cannot signal node", "err", err)
+	}
+
+	select {
+	case <-exited:
+	case <-time.After(l.cfg.ShutdownGrace):
+		l.logger.Info("node did not exit in time, killing it")
+		if err := cmd.Process.Kill(); err != nil {
+			l.logger.Error("cannot kill node", "err", err)
+		}
+		<-exited
+	}
+}
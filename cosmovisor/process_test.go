@@ -0,0 +1,198 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// setupTestHome copies the given testdata directory to a temporary home
+// directory and returns the config using it
+func setupTestHome(t *testing.T, testdata string) Config {
+	home, err := ioutil.TempDir("", "cosmovisor")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(home) })
+
+	// copyDir requires the destination to be missing
+	home = filepath.Join(home, "home")
+	require.NoError(t, copyDir(filepath.Join("testdata", testdata), home))
+
+	return Config{
+		Home:          home,
+		Name:          "dummyd",
+		PollInterval:  20 * time.Millisecond,
+		ShutdownGrace: time.Second,
+	}
+}
+
+func TestLaunchProcess(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+	launcher := NewLauncher(log.NewNopLogger(), cfg)
+
+	current, err := cfg.CurrentUpgradeName()
+	require.NoError(t, err)
+	require.Empty(t, current)
+
+	// the genesis binary reports the upgrade and halts
+	var stdout, stderr bytes.Buffer
+	upgraded, err := launcher.Run([]string{"foo", "bar"}, &stdout, &stderr)
+	require.NoError(t, err)
+	require.True(t, upgraded)
+	require.Equal(t, "Genesis foo bar\n", stdout.String())
+
+	current, err = cfg.CurrentUpgradeName()
+	require.NoError(t, err)
+	require.Equal(t, "chain2", current)
+
+	// the data was backed up before the upgrade
+	bz, err := ioutil.ReadFile(filepath.Join(cfg.BackupDir("chain2"), "blockstore.db"))
+	require.NoError(t, err)
+	require.Equal(t, "fake database\n", string(bz))
+
+	// the upgraded binary runs to completion
+	stdout.Reset()
+	upgraded, err = launcher.Run([]string{"foo", "bar"}, &stdout, &stderr)
+	require.NoError(t, err)
+	require.False(t, upgraded)
+	require.Equal(t, "Chain 2 is live!\nArgs: foo bar\n", stdout.String())
+}
+
+func TestLaunchProcessWithDownload(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+	cfg.AllowDownloadBinaries = true
+	cfg.UnsafeSkipBackup = true
+
+	// serve the chain3 binary and make the chain2 binary report its upgrade
+	chain3 := []byte("#!/bin/sh\necho Chain 3 is live!\n")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(chain3) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	sum := sha256.Sum256(chain3)
+	planInfo := fmt.Sprintf(`{"binaries":{"any":"%s/dummyd?checksum=sha256:%s"}}`, server.URL, hex.EncodeToString(sum[:]))
+	writeScript(t, cfg.UpgradeBin("chain2"), fmt.Sprintf(`echo Chain 2 is live!
+printf '%%s' '{"name":"chain3","height":100,"info":%q}' > "$(dirname "$0")/../../../../data/upgrade-info.json"
+exec sleep 60
+`, planInfo))
+	require.NoError(t, cfg.SetCurrentUpgrade("chain2"))
+
+	launcher := NewLauncher(log.NewNopLogger(), cfg)
+
+	var stdout, stderr bytes.Buffer
+	upgraded, err := launcher.Run(nil, &stdout, &stderr)
+	require.NoError(t, err, stderr.String())
+	require.True(t, upgraded)
+	require.Equal(t, "Chain 2 is live!\n", stdout.String())
+
+	current, err := cfg.CurrentUpgradeName()
+	require.NoError(t, err)
+	require.Equal(t, "chain3", current)
+
+	// the backup was skipped
+	_, err = os.Stat(cfg.BackupDir("chain3"))
+	require.True(t, os.IsNotExist(err))
+
+	stdout.Reset()
+	upgraded, err = launcher.Run(nil, &stdout, &stderr)
+	require.NoError(t, err)
+	require.False(t, upgraded)
+	require.Equal(t, "Chain 3 is live!\n", stdout.String())
+}
+
+func TestLaunchProcessMissingBinary(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+	require.NoError(t, os.RemoveAll(cfg.UpgradeDir("chain2")))
+
+	launcher := NewLauncher(log.NewNopLogger(), cfg)
+
+	// the upgrade cannot be performed without the binary
+	var stdout, stderr bytes.Buffer
+	upgraded, err := launcher.Run(nil, &stdout, &stderr)
+	require.Error(t, err)
+	require.True(t, upgraded)
+
+	current, err := cfg.CurrentUpgradeName()
+	require.NoError(t, err)
+	require.Empty(t, current)
+
+	// the pending upgrade is retried without starting the genesis binary again
+	stdout.Reset()
+	_, err = launcher.Run(nil, &stdout, &stderr)
+	require.Error(t, err)
+	require.Empty(t, stdout.String())
+}
+
+func TestLaunchProcessExitWithoutUpgrade(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+	writeScript(t, cfg.GenesisBin(), "echo Genesis\nexit 3\n")
+
+	launcher := NewLauncher(log.NewNopLogger(), cfg)
+
+	var stdout, stderr bytes.Buffer
+	upgraded, err := launcher.Run(nil, &stdout, &stderr)
+	require.Error(t, err)
+	require.False(t, upgraded)
+	require.Equal(t, "Genesis\n", stdout.String())
+}
+
+func TestDownloadBinaryChecksum(t *testing.T) {
+	cfg := setupTestHome(t, "validate")
+
+	bin := []byte("#!/bin/sh\necho downloaded\n")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bin) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	sum := sha256.Sum256(bin)
+	planInfo := func(url string) string {
+		return fmt.Sprintf(`{"binaries":{"any":%q}}`, url)
+	}
+
+	testCases := []struct {
+		msg       string
+		info      string
+		expectErr bool
+	}{
+		{"valid checksum", planInfo(server.URL + "?checksum=sha256:" + hex.EncodeToString(sum[:])), false},
+		{"checksum mismatch", planInfo(server.URL + "?checksum=sha256:" + hex.EncodeToString(make([]byte, 32))), true},
+		{"missing checksum", planInfo(server.URL), true},
+		{"unsupported algorithm", planInfo(server.URL + "?checksum=md5:abcd"), true},
+		{"no binary for the platform", `{"binaries":{"plan9/arm":"https://example.com"}}`, true},
+		{"invalid plan info", "upgrade to v2", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			name := fmt.Sprintf("upgrade-%x", sha256.Sum256([]byte(tc.msg)))
+			err := DownloadBinary(cfg, UpgradeInfo{Name: name, Info: tc.info})
+			if tc.expectErr {
+				require.Error(t, err)
+				_, statErr := os.Stat(cfg.UpgradeBin(name))
+				require.True(t, os.IsNotExist(statErr))
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, EnsureBinary(cfg.UpgradeBin(name)))
+		})
+	}
+}
+
+func writeScript(t *testing.T, path, script string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755)) // nolint: gosec
+}
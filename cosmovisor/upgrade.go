@@ -0,0 +1,281 @@
+package cosmovisor
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// platformAny is the key of the binary used on any platform in the plan info
+const platformAny = "any"
+
+// UpgradeInfo is the information written by the node to the upgrade info file
+// when an upgrade is needed
+type UpgradeInfo = storetypes.UpgradeInfo
+
+// PlanInfo is the expected format of the Info of an upgrade plan, which lists
+// the URL of the upgraded binary for each platform, in the "os/arch" format or
+// "any". Each URL must hold the checksum of the binary in the checksum query
+// param, as in "https://example.com/simd?checksum=sha256:<hex>".
+type PlanInfo struct {
+	Binaries map[string]string `json:"binaries"`
+}
+
+// ReadUpgradeInfo reads the upgrade info file. It returns nil if there is none.
+func ReadUpgradeInfo(cfg Config) (*UpgradeInfo, error) {
+	bz, err := ioutil.ReadFile(cfg.UpgradeInfoFilePath())
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var info UpgradeInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("invalid upgrade info file: %w", err)
+	}
+
+	if info.Name == "" {
+		return nil, errors.New("invalid upgrade info file: missing upgrade name")
+	}
+
+	return &info, nil
+}
+
+// DoUpgrade backs up the data directory, ensures the binary of the upgrade is
+// present, downloading it when allowed, and switches the current binary to it.
+func DoUpgrade(logger log.Logger, cfg Config, info UpgradeInfo) error {
+	if err := validateUpgradeName(info.Name); err != nil {
+		return err
+	}
+
+	if !cfg.UnsafeSkipBackup {
+		if err := BackupData(logger, cfg, info.Name); err != nil {
+			return fmt.Errorf("backing up data: %w", err)
+		}
+	}
+
+	bin := cfg.UpgradeBin(info.Name)
+	if err := EnsureBinary(bin); err != nil {
+		if !cfg.AllowDownloadBinaries {
+			return fmt.Errorf("binary of upgrade %s not found and downloads are disabled: %w", info.Name, err)
+		}
+
+		logger.Info("downloading binary", "upgrade", info.Name)
+		if err := DownloadBinary(cfg, info); err != nil {
+			return fmt.Errorf("downloading binary of upgrade %s: %w", info.Name, err)
+		}
+	}
+
+	if err := cfg.SetCurrentUpgrade(info.Name); err != nil {
+		return err
+	}
+
+	logger.Info("switched binary", "upgrade", info.Name, "binary", bin)
+	return nil
+}
+
+// DownloadBinary downloads the binary of the upgrade for the current platform
+// from the URL listed in the plan info and verifies its checksum.
+func DownloadBinary(cfg Config, info UpgradeInfo) error {
+	var planInfo PlanInfo
+	if err := json.Unmarshal([]byte(info.Info), &planInfo); err != nil {
+		return fmt.Errorf("invalid plan info: %w", err)
+	}
+
+	binURL, ok := planInfo.Binaries[runtime.GOOS+"/"+runtime.GOARCH]
+	if !ok {
+		binURL, ok = planInfo.Binaries[platformAny]
+	}
+	if !ok {
+		return fmt.Errorf("no binary listed for platform %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+
+	hasher, checksum, err := parseChecksum(binURL)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Get(binURL) // nolint: gosec
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	bin := cfg.UpgradeBin(info.Name)
+	if err := os.MkdirAll(filepath.Dir(bin), 0755); err != nil {
+		return err
+	}
+
+	// download to a temporary file which is only moved into place once the
+	// checksum is verified
+	tmp, err := ioutil.TempFile(filepath.Dir(bin), cfg.Name+".download")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(io.MultiWriter(tmp, hasher), resp.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if sum := hex.EncodeToString(hasher.Sum(nil)); sum != checksum {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", checksum, sum)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), bin)
+}
+
+// parseChecksum returns the hash function and the expected checksum from the
+// checksum query param of a binary URL
+func parseChecksum(binURL string) (hash.Hash, string, error) {
+	u, err := url.Parse(binURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid binary URL: %w", err)
+	}
+
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return nil, "", fmt.Errorf("binary URL %s has no checksum", binURL)
+	}
+
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return nil, "", fmt.Errorf("invalid checksum %s, expected <algorithm>:<hex>", checksum)
+	}
+
+	var hasher hash.Hash
+	switch parts[0] {
+	case "sha256":
+		hasher = sha256.New()
+	case "sha512":
+		hasher = sha512.New()
+	default:
+		return nil, "", fmt.Errorf("unsupported checksum algorithm %s", parts[0])
+	}
+
+	return hasher, strings.ToLower(parts[1]), nil
+}
+
+// EnsureBinary checks that the binary exists and is executable
+func EnsureBinary(bin string) error {
+	info, err := os.Stat(bin)
+	if err != nil {
+		return fmt.Errorf("cannot stat binary %s: %w", bin, err)
+	}
+
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", bin)
+	}
+
+	if info.Mode().Perm()&0111 == 0 {
+		return fmt.Errorf("%s is not executable", bin)
+	}
+
+	return nil
+}
+
+// BackupData copies the data directory to the backup directory of the upgrade.
+// The copy is made to a temporary directory first, so an existing backup
+// directory is always complete and is kept as is.
+func BackupData(logger log.Logger, cfg Config, upgradeName string) error {
+	backupDir := cfg.BackupDir(upgradeName)
+	if _, err := os.Stat(backupDir); err == nil {
+		logger.Info("data already backed up", "upgrade", upgradeName, "backup", backupDir)
+		return nil
+	}
+
+	tmpDir := backupDir + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	logger.Info("backing up data", "upgrade", upgradeName, "backup", backupDir)
+	if err := copyDir(cfg.DataDir(), tmpDir); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpDir, backupDir)
+}
+
+// copyDir recursively copies the src directory to dst, which must not exist
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch mode := info.Mode(); {
+		case mode.IsDir():
+			return os.MkdirAll(target, mode.Perm())
+
+		case mode&os.ModeSymlink != 0:
+			dest, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(dest, target)
+
+		case mode.IsRegular():
+			return copyFile(path, target, mode.Perm())
+
+		default:
+			return fmt.Errorf("cannot back up %s: unsupported file type", path)
+		}
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
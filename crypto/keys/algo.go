@@ -0,0 +1,156 @@
+package keys
+
+import (
+	"fmt"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/pubkeys"
+)
+
+// SignatureAlgo defines a signing algorithm which can be used for account keys.
+// It provides key derivation from a mnemonic and HD path, private key
+// generation from the derived bytes and Amino registration of its key types.
+// The gas consumed to verify one of its signatures is an auth param.
+type SignatureAlgo interface {
+	// Name returns the name of the algorithm, e.g. as passed to --algo.
+	Name() SigningAlgo
+	// Derive derives the private key material for the given mnemonic,
+	// BIP39 passphrase and HD path.
+	Derive(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
+	// Generate creates a private key from the bytes returned by Derive.
+	Generate(bz []byte) tmcrypto.PrivKey
+	// IsPubKey returns true if the public key belongs to the algorithm.
+	IsPubKey(pubKey tmcrypto.PubKey) bool
+	// RegisterCodec registers the algorithm's key types on an Amino codec.
+	RegisterCodec(cdc *codec.Codec)
+}
+
+var (
+	signatureAlgos     = map[SigningAlgo]SignatureAlgo{}
+	signatureAlgoNames []SigningAlgo
+)
+
+func init() {
+	RegisterSignatureAlgo(secp256k1Algo{})
+	RegisterSignatureAlgo(ed25519Algo{})
+	RegisterSignatureAlgo(secp256r1Algo{})
+}
+
+// RegisterSignatureAlgo registers a signing algorithm so that the keybase can
+// derive keys for it, and registers its key types on the codecs of this
+// package and its public keys for the ante handler to look their gas cost up
+// in the auth params. Algorithms must be registered from an init function,
+// before any other codec is built with RegisterSignatureAlgoCodecs, e.g. by
+// std.MakeCodec. It panics if an algorithm with the same name is already
+// registered.
+func RegisterSignatureAlgo(algo SignatureAlgo) {
+	name := algo.Name()
+	if _, ok := signatureAlgos[name]; ok {
+		panic(fmt.Sprintf("signature algorithm %s already registered", name))
+	}
+
+	signatureAlgos[name] = algo
+	signatureAlgoNames = append(signatureAlgoNames, name)
+
+	algo.RegisterCodec(CryptoCdc)
+	algo.RegisterCodec(remoteSignerCdc)
+	pubkeys.Register(pubkeys.PubKeyType{Name: string(name), IsPubKey: algo.IsPubKey})
+}
+
+// GetSignatureAlgo returns the registered signing algorithm with the given name.
+func GetSignatureAlgo(name SigningAlgo) (SignatureAlgo, bool) {
+	algo, ok := signatureAlgos[name]
+	return algo, ok
+}
+
+// SignatureAlgoByPubKey returns the registered signing algorithm the given
+// public key belongs to.
+func SignatureAlgoByPubKey(pubKey tmcrypto.PubKey) (SignatureAlgo, bool) {
+	for _, name := range signatureAlgoNames {
+		if algo := signatureAlgos[name]; algo.IsPubKey(pubKey) {
+			return algo, true
+		}
+	}
+	return nil, false
+}
+
+// SignatureAlgos returns the names of all registered signing algorithms in
+// registration order.
+func SignatureAlgos() []SigningAlgo {
+	names := make([]SigningAlgo, len(signatureAlgoNames))
+	copy(names, signatureAlgoNames)
+	return names
+}
+
+// RegisterSignatureAlgoCodecs registers the key types of all registered
+// signing algorithms on the given codec. The built-in algorithms are
+// registered by codec.RegisterCrypto and are skipped.
+func RegisterSignatureAlgoCodecs(cdc *codec.Codec) {
+	for _, name := range signatureAlgoNames {
+		signatureAlgos[name].RegisterCodec(cdc)
+	}
+}
+
+//-------------------------------------
+
+// secp256k1Algo derives keys along a BIP32 path as implemented by the hd package.
+type secp256k1Algo struct{}
+
+func (secp256k1Algo) Name() SigningAlgo { return Secp256k1 }
+
+func (secp256k1Algo) Derive(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+	return SecpDeriveKey(mnemonic, bip39Passphrase, hdPath)
+}
+
+func (secp256k1Algo) Generate(bz []byte) tmcrypto.PrivKey { return SecpPrivKeyGen(bz) }
+
+func (secp256k1Algo) IsPubKey(pubKey tmcrypto.PubKey) bool {
+	_, ok := pubKey.(secp256k1.PubKeySecp256k1)
+	return ok
+}
+
+func (secp256k1Algo) RegisterCodec(*codec.Codec) {}
+
+// ed25519Algo uses the secp256k1 BIP32 derived key as the seed of an ed25519
+// key. Note, this is not SLIP-0010 derivation and the resulting keys are not
+// compatible with other SLIP-0010 wallets.
+type ed25519Algo struct{}
+
+func (ed25519Algo) Name() SigningAlgo { return Ed25519 }
+
+func (ed25519Algo) Derive(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+	return SecpDeriveKey(mnemonic, bip39Passphrase, hdPath)
+}
+
+func (ed25519Algo) Generate(bz []byte) tmcrypto.PrivKey { return ed25519.GenPrivKeyFromSecret(bz) }
+
+func (ed25519Algo) IsPubKey(pubKey tmcrypto.PubKey) bool {
+	_, ok := pubKey.(ed25519.PubKeyEd25519)
+	return ok
+}
+
+func (ed25519Algo) RegisterCodec(*codec.Codec) {}
+
+// secp256r1Algo uses the secp256k1 BIP32 derived key as the secret of a
+// secp256r1 key. As for ed25519, this is not SLIP-0010 derivation.
+type secp256r1Algo struct{}
+
+func (secp256r1Algo) Name() SigningAlgo { return Secp256r1 }
+
+func (secp256r1Algo) Derive(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+	return SecpDeriveKey(mnemonic, bip39Passphrase, hdPath)
+}
+
+func (secp256r1Algo) Generate(bz []byte) tmcrypto.PrivKey { return secp256r1.GenPrivKeyFromSecret(bz) }
+
+func (secp256r1Algo) IsPubKey(pubKey tmcrypto.PubKey) bool {
+	_, ok := pubKey.(secp256r1.PubKeySecp256r1)
+	return ok
+}
+
+func (secp256r1Algo) RegisterCodec(*codec.Codec) {}
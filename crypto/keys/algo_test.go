@@ -0,0 +1,203 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/pubkeys"
+)
+
+// pluginAlgo is a signing algorithm registered from an init function besides
+// the built-in ones, as by a plugin package, whose keys wrap ed25519 keys.
+type pluginAlgo struct{}
+
+const pluginAlgoName = SigningAlgo("plugin")
+
+const (
+	pluginPrivKeyName = "test/plugin/PrivKey"
+	pluginPubKeyName  = "test/plugin/PubKey"
+)
+
+func init() {
+	// the keybase decrypts private keys with the Tendermint codec
+	tmamino.RegisterKeyType(pluginPrivKey{}, pluginPrivKeyName)
+	RegisterSignatureAlgo(pluginAlgo{})
+}
+
+func (pluginAlgo) Name() SigningAlgo { return pluginAlgoName }
+
+func (pluginAlgo) Derive(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+	return SecpDeriveKey(mnemonic, bip39Passphrase, hdPath)
+}
+
+func (pluginAlgo) Generate(bz []byte) tmcrypto.PrivKey {
+	return pluginPrivKey(ed25519.GenPrivKeyFromSecret(bz))
+}
+
+func (pluginAlgo) IsPubKey(pubKey tmcrypto.PubKey) bool {
+	_, ok := pubKey.(pluginPubKey)
+	return ok
+}
+
+func (pluginAlgo) RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(pluginPrivKey{}, pluginPrivKeyName, nil)
+	cdc.RegisterConcrete(pluginPubKey{}, pluginPubKeyName, nil)
+}
+
+type pluginPrivKey [64]byte
+
+func (key pluginPrivKey) Bytes() []byte { return CryptoCdc.MustMarshalBinaryBare(key) }
+
+func (key pluginPrivKey) Sign(msg []byte) ([]byte, error) {
+	return ed25519.PrivKeyEd25519(key).Sign(msg)
+}
+
+func (key pluginPrivKey) PubKey() tmcrypto.PubKey {
+	return pluginPubKey(ed25519.PrivKeyEd25519(key).PubKey().(ed25519.PubKeyEd25519))
+}
+
+func (key pluginPrivKey) Equals(other tmcrypto.PrivKey) bool {
+	o, ok := other.(pluginPrivKey)
+	return ok && o == key
+}
+
+type pluginPubKey [32]byte
+
+func (key pluginPubKey) Address() tmcrypto.Address { return ed25519.PubKeyEd25519(key).Address() }
+
+func (key pluginPubKey) Bytes() []byte { return CryptoCdc.MustMarshalBinaryBare(key) }
+
+func (key pluginPubKey) VerifyBytes(msg, sig []byte) bool {
+	return ed25519.PubKeyEd25519(key).VerifyBytes(msg, sig)
+}
+
+func (key pluginPubKey) Equals(other tmcrypto.PubKey) bool {
+	o, ok := other.(pluginPubKey)
+	return ok && o == key
+}
+
+func TestSignatureAlgoRegistry(t *testing.T) {
+	require.Equal(t, []SigningAlgo{Secp256k1, Ed25519, Secp256r1, pluginAlgoName}, SignatureAlgos())
+
+	_, ok := GetSignatureAlgo(Sr25519)
+	require.False(t, ok)
+	require.Panics(t, func() { RegisterSignatureAlgo(secp256r1Algo{}) })
+
+	for _, algo := range []SigningAlgo{Secp256k1, Ed25519, Secp256r1} {
+		signatureAlgo, ok := GetSignatureAlgo(algo)
+		require.True(t, ok)
+		require.Equal(t, algo, signatureAlgo.Name())
+	}
+
+	algo, ok := SignatureAlgoByPubKey(secp256k1.GenPrivKey().PubKey())
+	require.True(t, ok)
+	require.Equal(t, Secp256k1, algo.Name())
+
+	algo, ok = SignatureAlgoByPubKey(ed25519.GenPrivKey().PubKey())
+	require.True(t, ok)
+	require.Equal(t, Ed25519, algo.Name())
+
+	algo, ok = SignatureAlgoByPubKey(secp256r1.GenPrivKey().PubKey())
+	require.True(t, ok)
+	require.Equal(t, Secp256r1, algo.Name())
+
+	// registered algorithms are known to the ante handler
+	pkType, ok := pubkeys.ByPubKey(secp256r1.GenPrivKey().PubKey())
+	require.True(t, ok)
+	require.Equal(t, string(Secp256r1), pkType.Name)
+}
+
+func TestPluginSignatureAlgo(t *testing.T) {
+	pub := pluginAlgo{}.Generate([]byte("secret")).PubKey()
+
+	algo, ok := SignatureAlgoByPubKey(pub)
+	require.True(t, ok)
+	require.Equal(t, pluginAlgoName, algo.Name())
+	pkType, ok := pubkeys.ByPubKey(pub)
+	require.True(t, ok)
+	require.Equal(t, string(pluginAlgoName), pkType.Name)
+
+	// the algorithms registered after the package codecs were built reach them
+	// and the codecs built with RegisterSignatureAlgoCodecs
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	RegisterSignatureAlgoCodecs(cdc)
+	for _, c := range []*codec.Codec{CryptoCdc, remoteSignerCdc, cdc} {
+		var decoded tmcrypto.PubKey
+		require.NoError(t, c.UnmarshalBinaryBare(c.MustMarshalBinaryBare(pub), &decoded))
+		require.True(t, pub.Equals(decoded))
+	}
+
+	// keybases support the registered algorithms by default
+	kb := NewInMemory()
+	require.Contains(t, kb.SupportedAlgos(), pluginAlgoName)
+
+	info, _, err := kb.CreateMnemonic("plugin", English, nums, pluginAlgoName)
+	require.NoError(t, err)
+	require.IsType(t, pluginPubKey{}, info.GetPubKey())
+
+	stored, err := kb.Get("plugin")
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().Equals(stored.GetPubKey()))
+
+	msg := []byte("hello world")
+	sig, pub, err := kb.Sign("plugin", nums, msg)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(info.GetPubKey()))
+	require.True(t, pub.VerifyBytes(msg, sig))
+}
+
+func TestKeybaseSecp256r1Multisig(t *testing.T) {
+	kb := NewInMemory()
+
+	r1, _, err := kb.CreateMnemonic("r1", English, nums, Secp256r1)
+	require.NoError(t, err)
+	k1, _, err := kb.CreateMnemonic("k1", English, nums, Secp256k1)
+	require.NoError(t, err)
+
+	pk := multisig.NewPubKeyMultisigThreshold(2, []tmcrypto.PubKey{r1.GetPubKey(), k1.GetPubKey()})
+	info, err := kb.CreateMulti("multi", pk)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), info.(*multiInfo).Threshold)
+	require.Len(t, info.(*multiInfo).PubKeys, 2)
+
+	stored, err := kb.Get("multi")
+	require.NoError(t, err)
+	require.True(t, pk.Equals(stored.GetPubKey()))
+	require.Equal(t, pk.Address().Bytes(), stored.GetAddress().Bytes())
+}
+
+func TestKeybaseSignatureAlgos(t *testing.T) {
+	kb := NewInMemory()
+
+	msg := []byte("hello world")
+	hdPath := CreateHDPath(0, 0).String()
+
+	for _, algo := range []SigningAlgo{Secp256k1, Ed25519, Secp256r1} {
+		info, mnemonic, err := kb.CreateMnemonic(string(algo), English, nums, algo)
+		require.NoError(t, err)
+		require.Equal(t, algo, info.GetAlgo())
+
+		sig, pub, err := kb.Sign(string(algo), nums, msg)
+		require.NoError(t, err)
+		require.True(t, pub.Equals(info.GetPubKey()))
+		require.True(t, pub.VerifyBytes(msg, sig))
+
+		signatureAlgo, ok := SignatureAlgoByPubKey(pub)
+		require.True(t, ok)
+		require.Equal(t, algo, signatureAlgo.Name())
+
+		// the same mnemonic and path must recover the same key
+		recovered, err := kb.CreateAccount(string(algo)+"-recovered", mnemonic, "", nums, hdPath, algo)
+		require.NoError(t, err)
+		require.True(t, info.GetPubKey().Equals(recovered.GetPubKey()))
+	}
+}
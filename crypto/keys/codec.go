@@ -1,20 +1,19 @@
 package keys
 
 import (
-	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
 )
 
-// CryptoCdc defines the codec required for keys and info
-var CryptoCdc *codec.Codec
+// CryptoCdc defines the codec required for keys and info. It is not sealed, as
+// RegisterSignatureAlgo registers the key types of the signing algorithms on it.
+var CryptoCdc = newCryptoCodec()
 
-func init() {
-	CryptoCdc = codec.New()
-	cryptoAmino.RegisterAmino(CryptoCdc)
-	RegisterCodec(CryptoCdc)
-	CryptoCdc.Seal()
+func newCryptoCodec() *codec.Codec {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	RegisterCodec(cdc)
+	return cdc
 }
 
 // RegisterCodec registers concrete types and interfaces on the given codec.
@@ -25,4 +24,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ledgerInfo{}, "crypto/keys/ledgerInfo", nil)
 	cdc.RegisterConcrete(offlineInfo{}, "crypto/keys/offlineInfo", nil)
 	cdc.RegisterConcrete(multiInfo{}, "crypto/keys/multiInfo", nil)
+	cdc.RegisterConcrete(remoteInfo{}, "crypto/keys/remoteInfo", nil)
 }
@@ -21,6 +21,8 @@ type (
 		deriveFunc           DeriveKeyFunc
 		supportedAlgos       []SigningAlgo
 		supportedAlgosLedger []SigningAlgo
+		remoteSignerAddr     string
+		remoteSignerAuthKey  string
 	}
 
 	// baseKeybase is an auxiliary type that groups Keybase storage agnostic features
@@ -71,13 +73,31 @@ func WithSupportedAlgosLedger(algos []SigningAlgo) KeybaseOption {
 	}
 }
 
-// newBaseKeybase generates the base keybase with defaulting to tendermint SECP256K1 key type
+// WithRemoteSignerAddr sets the address of the remote signer the remote keyring
+// backend connects to, e.g. "unix:///path/to/signer.sock" or "tcp://127.0.0.1:26659".
+func WithRemoteSignerAddr(addr string) KeybaseOption {
+	return func(o *kbOptions) {
+		o.remoteSignerAddr = addr
+	}
+}
+
+// WithRemoteSignerAuthKeyFile sets the file holding the auth key the remote
+// keyring backend authenticates with to the remote signer.
+func WithRemoteSignerAuthKeyFile(path string) KeybaseOption {
+	return func(o *kbOptions) {
+		o.remoteSignerAuthKey = path
+	}
+}
+
+// newBaseKeybase generates the base keybase supporting the keys of all the
+// signing algorithms registered with RegisterSignatureAlgo by default. Ledger
+// devices only support secp256k1.
 func newBaseKeybase(optionsFns ...KeybaseOption) baseKeybase {
 	// Default options for keybase
 	options := kbOptions{
 		keygenFunc:           StdPrivKeyGen,
 		deriveFunc:           StdDeriveKey,
-		supportedAlgos:       []SigningAlgo{Secp256k1},
+		supportedAlgos:       SignatureAlgos(),
 		supportedAlgosLedger: []SigningAlgo{Secp256k1},
 	}
 
@@ -89,12 +109,13 @@ func newBaseKeybase(optionsFns ...KeybaseOption) baseKeybase {
 }
 
 // StdPrivKeyGen is the default PrivKeyGen function in the keybase.
-// For now, it only supports Secp256k1
+// It supports all signing algorithms registered with RegisterSignatureAlgo.
 func StdPrivKeyGen(bz []byte, algo SigningAlgo) (tmcrypto.PrivKey, error) {
-	if algo == Secp256k1 {
-		return SecpPrivKeyGen(bz), nil
+	signatureAlgo, ok := GetSignatureAlgo(algo)
+	if !ok {
+		return nil, ErrUnsupportedSigningAlgo
 	}
-	return nil, ErrUnsupportedSigningAlgo
+	return signatureAlgo.Generate(bz), nil
 }
 
 // SecpPrivKeyGen generates a secp256k1 private key from the given bytes
@@ -231,12 +252,13 @@ func (kb baseKeybase) writeMultisigKey(w infoWriter, name string, pub tmcrypto.P
 }
 
 // StdDeriveKey is the default DeriveKey function in the keybase.
-// For now, it only supports Secp256k1
+// It supports all signing algorithms registered with RegisterSignatureAlgo.
 func StdDeriveKey(mnemonic string, bip39Passphrase, hdPath string, algo SigningAlgo) ([]byte, error) {
-	if algo == Secp256k1 {
-		return SecpDeriveKey(mnemonic, bip39Passphrase, hdPath)
+	signatureAlgo, ok := GetSignatureAlgo(algo)
+	if !ok {
+		return nil, ErrUnsupportedSigningAlgo
 	}
-	return nil, ErrUnsupportedSigningAlgo
+	return signatureAlgo.Derive(mnemonic, bip39Passphrase, hdPath)
 }
 
 // SecpDeriveKey derives and returns the secp256k1 private key for the given seed and HD path.
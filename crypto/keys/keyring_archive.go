@@ -0,0 +1,282 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// keyringArchiveVersion is the version of the keyring archive format.
+const keyringArchiveVersion = 1
+
+// KeyringImportConflict defines how ImportKeyring handles an archived key whose
+// name is already used by a different key.
+type KeyringImportConflict string
+
+const (
+	// ConflictSkip does not import the archived key.
+	ConflictSkip KeyringImportConflict = "skip"
+	// ConflictRename imports the archived key under the first free name of the
+	// form <name>-<n>.
+	ConflictRename KeyringImportConflict = "rename"
+	// ConflictOverwrite deletes the existing key and imports the archived key.
+	ConflictOverwrite KeyringImportConflict = "overwrite"
+)
+
+// ParseKeyringImportConflict returns the KeyringImportConflict with the given name.
+func ParseKeyringImportConflict(s string) (KeyringImportConflict, error) {
+	switch c := KeyringImportConflict(s); c {
+	case ConflictSkip, ConflictRename, ConflictOverwrite:
+		return c, nil
+	default:
+		return "", fmt.Errorf("invalid name conflict resolution %q, expected one of %s, %s or %s",
+			s, ConflictSkip, ConflictRename, ConflictOverwrite)
+	}
+}
+
+// KeyringImportAction is the action ImportKeyring takes with an archived key.
+type KeyringImportAction string
+
+const (
+	ImportActionCreate    KeyringImportAction = "create"
+	ImportActionRename    KeyringImportAction = "rename"
+	ImportActionOverwrite KeyringImportAction = "overwrite"
+	ImportActionSkip      KeyringImportAction = "skip"
+)
+
+// KeyringImportResult reports the action ImportKeyring took, or would take in
+// a dry-run, with an archived key.
+type KeyringImportResult struct {
+	Name         string              `json:"name"`
+	ImportedName string              `json:"imported_name,omitempty"`
+	Type         string              `json:"type"`
+	Address      types.AccAddress    `json:"address"`
+	Action       KeyringImportAction `json:"action"`
+	Reason       string              `json:"reason,omitempty"`
+}
+
+type (
+	// keyringArchive is the plaintext of an exported keyring.
+	keyringArchive struct {
+		Version uint32        `json:"version"`
+		Keys    []archivedKey `json:"keys"`
+	}
+
+	// archivedKey is an exported key. Info holds the public information of the
+	// key, including the HD path of Ledger keys and the public keys of multisig
+	// keys. PrivKey is only set for local keys.
+	archivedKey struct {
+		Info    Info             `json:"info"`
+		Address types.AccAddress `json:"address"`
+		PrivKey tmcrypto.PrivKey `json:"priv_key"`
+	}
+)
+
+// ExportKeyring exports all keys of the keybase into an archive encrypted and
+// armored with encryptPassphrase. Local keys are exported with their private
+// keys, which legacy keybases decrypt with decryptPassphrase. Ledger, offline
+// and multisig keys are exported as references. Keys held by a remote signer
+// are not exported. It returns the armored archive and the exported keys.
+func ExportKeyring(kb Keybase, decryptPassphrase, encryptPassphrase string) (string, []Info, error) {
+	infos, err := kb.List()
+	if err != nil {
+		return "", nil, err
+	}
+
+	archive := keyringArchive{Version: keyringArchiveVersion}
+	exported := make([]Info, 0, len(infos))
+
+	for _, info := range infos {
+		key := archivedKey{Info: info, Address: info.GetAddress()}
+
+		switch info.GetType() {
+		case TypeRemote:
+			continue
+
+		case TypeLocal:
+			priv, err := kb.ExportPrivateKeyObject(info.GetName(), decryptPassphrase)
+			if err != nil {
+				return "", nil, errors.Wrapf(err, "failed to export private key %s", info.GetName())
+			}
+
+			key.Info = newLocalInfo(info.GetName(), info.GetPubKey(), "", info.GetAlgo())
+			key.PrivKey = priv
+		}
+
+		archive.Keys = append(archive.Keys, key)
+		exported = append(exported, info)
+	}
+
+	bz, err := CryptoCdc.MarshalBinaryBare(archive)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return mintkey.EncryptArmorKeyring(bz, encryptPassphrase), exported, nil
+}
+
+// ImportKeyring imports the keys of an archive created by ExportKeyring. All
+// archived keys are verified before any key is imported, and the address of
+// each imported key is checked against the archive. Keys whose address is
+// already in the keybase are skipped and name conflicts with other keys are
+// resolved according to conflict. In a dry-run the keybase is left untouched
+// and the returned results list the actions an import would take. Legacy
+// keybases encrypt imported private keys with the archive passphrase.
+func ImportKeyring(
+	kb Keybase, armor, passphrase string, conflict KeyringImportConflict, dryRun bool,
+) ([]KeyringImportResult, error) {
+
+	writer, ok := kb.(keyWriter)
+	if !ok {
+		return nil, errors.New("keybase does not support importing keyrings")
+	}
+
+	bz, err := mintkey.UnarmorDecryptKeyring(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var archive keyringArchive
+	if err := CryptoCdc.UnmarshalBinaryBare(bz, &archive); err != nil {
+		return nil, err
+	}
+	if archive.Version != keyringArchiveVersion {
+		return nil, fmt.Errorf("unsupported keyring archive version %d", archive.Version)
+	}
+
+	for _, key := range archive.Keys {
+		if err := key.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// names claimed by keys imported earlier in the same import
+	claimed := make(map[string]bool)
+	inUse := func(name string) bool {
+		if claimed[name] {
+			return true
+		}
+		_, err := kb.Get(name)
+		return err == nil
+	}
+
+	results := make([]KeyringImportResult, 0, len(archive.Keys))
+	for _, key := range archive.Keys {
+		name := key.Info.GetName()
+		res := KeyringImportResult{
+			Name:    name,
+			Type:    key.Info.GetType().String(),
+			Address: key.Address,
+		}
+
+		existing, err := kb.GetByAddress(key.Address)
+		switch {
+		case err == nil:
+			res.Action = ImportActionSkip
+			res.Reason = fmt.Sprintf("address already stored as %s", existing.GetName())
+
+		case !inUse(name):
+			res.Action = ImportActionCreate
+			res.ImportedName = name
+
+		case conflict == ConflictRename:
+			res.Action = ImportActionRename
+			for i := 1; ; i++ {
+				if candidate := fmt.Sprintf("%s-%d", name, i); !inUse(candidate) {
+					res.ImportedName = candidate
+					break
+				}
+			}
+
+		case conflict == ConflictOverwrite && !claimed[name]:
+			res.Action = ImportActionOverwrite
+			res.ImportedName = name
+
+		default:
+			res.Action = ImportActionSkip
+			res.Reason = "name already in use"
+		}
+
+		if res.ImportedName != "" {
+			claimed[res.ImportedName] = true
+		}
+
+		if !dryRun && res.Action != ImportActionSkip {
+			if err := importArchivedKey(kb, writer, key, res, passphrase); err != nil {
+				return results, errors.Wrapf(err, "failed to import key %s", name)
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// validate checks that the archived key is consistent with its address.
+func (key archivedKey) validate() error {
+	if key.Info == nil || key.Info.GetPubKey() == nil {
+		return errors.New("archived key without public key")
+	}
+
+	name := key.Info.GetName()
+	if !key.Info.GetAddress().Equals(key.Address) {
+		return fmt.Errorf("archived key %s does not match its address %s", name, key.Address)
+	}
+
+	switch key.Info.GetType() {
+	case TypeLocal:
+		if key.PrivKey == nil || !key.PrivKey.PubKey().Equals(key.Info.GetPubKey()) {
+			return fmt.Errorf("archived private key %s does not match its public key", name)
+		}
+
+	case TypeLedger, TypeOffline, TypeMulti:
+		if key.PrivKey != nil {
+			return fmt.Errorf("archived key %s of type %s has a private key", name, key.Info.GetType())
+		}
+
+	default:
+		return fmt.Errorf("archived key %s has unsupported type %s", name, key.Info.GetType())
+	}
+
+	return nil
+}
+
+// importArchivedKey writes the archived key under res.ImportedName and checks
+// the address of the stored key.
+func importArchivedKey(kb Keybase, writer keyWriter, key archivedKey, res KeyringImportResult, passphrase string) error {
+	name := res.ImportedName
+
+	if res.Action == ImportActionOverwrite {
+		if err := kb.Delete(name, "", true); err != nil {
+			return err
+		}
+	}
+
+	switch info := key.Info.(type) {
+	case localInfo:
+		writer.writeLocalKey(name, key.PrivKey, passphrase, info.Algo)
+	case ledgerInfo:
+		writer.writeInfo(name, newLedgerInfo(name, info.PubKey, info.Path, info.Algo))
+	case offlineInfo:
+		writer.writeInfo(name, newOfflineInfo(name, info.PubKey, info.Algo))
+	case multiInfo:
+		writer.writeInfo(name, NewMultiInfo(name, info.PubKey))
+	default:
+		return fmt.Errorf("unsupported key type %T", info)
+	}
+
+	stored, err := kb.Get(name)
+	if err != nil {
+		return err
+	}
+	if !stored.GetAddress().Equals(key.Address) {
+		return fmt.Errorf("imported address %s does not match archived address %s", stored.GetAddress(), key.Address)
+	}
+
+	return nil
+}
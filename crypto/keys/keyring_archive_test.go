@@ -0,0 +1,155 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+)
+
+func TestExportImportKeyring(t *testing.T) {
+	src := NewInMemory().(dbKeybase)
+
+	local, _, err := src.CreateMnemonic("local", English, nums, Secp256k1)
+	require.NoError(t, err)
+	ledgerPath := *hd.NewFundraiserParams(0, 118, 3)
+	ledger := src.base.writeLedgerKey(src, "ledger", secp256k1.GenPrivKey().PubKey(), ledgerPath, Secp256k1)
+	offline, err := src.CreateOffline("offline", secp256k1.GenPrivKey().PubKey(), Secp256k1)
+	require.NoError(t, err)
+	multi, err := src.CreateMulti("multi", multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{local.GetPubKey(), offline.GetPubKey()}))
+	require.NoError(t, err)
+
+	armor, exported, err := ExportKeyring(src, nums, "backup passphrase")
+	require.NoError(t, err)
+	require.Len(t, exported, 4)
+
+	_, err = mintkey.UnarmorDecryptKeyring(armor, "wrong passphrase")
+	require.Error(t, err)
+
+	// the destination already holds a different key named "offline" and the
+	// same key as "ledger" under another name
+	dst := NewInMemory().(dbKeybase)
+	_, err = dst.CreateOffline("offline", secp256k1.GenPrivKey().PubKey(), Secp256k1)
+	require.NoError(t, err)
+	_, err = dst.CreateOffline("my-ledger", ledger.GetPubKey(), Secp256k1)
+	require.NoError(t, err)
+
+	_, err = ImportKeyring(dst, armor, "wrong passphrase", ConflictRename, false)
+	require.Error(t, err)
+
+	// a dry-run reports the actions without importing anything
+	results, err := ImportKeyring(dst, armor, "backup passphrase", ConflictRename, true)
+	require.NoError(t, err)
+	actions := make(map[string]KeyringImportResult)
+	for _, res := range results {
+		actions[res.Name] = res
+	}
+	require.Equal(t, ImportActionCreate, actions["local"].Action)
+	require.Equal(t, ImportActionSkip, actions["ledger"].Action)
+	require.Equal(t, ImportActionRename, actions["offline"].Action)
+	require.Equal(t, "offline-1", actions["offline"].ImportedName)
+	require.Equal(t, ImportActionCreate, actions["multi"].Action)
+
+	infos, err := dst.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	results, err = ImportKeyring(dst, armor, "backup passphrase", ConflictRename, false)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	info, err := dst.Get("local")
+	require.NoError(t, err)
+	require.Equal(t, TypeLocal, info.GetType())
+	require.Equal(t, local.GetAddress(), info.GetAddress())
+
+	// imported local keys sign with the archive passphrase in legacy keybases
+	sig, pub, err := dst.Sign("local", "backup passphrase", []byte("msg"))
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes([]byte("msg"), sig))
+
+	info, err = dst.Get("offline-1")
+	require.NoError(t, err)
+	require.Equal(t, offline.GetAddress(), info.GetAddress())
+	require.Equal(t, "offline-1", info.GetName())
+
+	info, err = dst.Get("multi")
+	require.NoError(t, err)
+	require.Equal(t, TypeMulti, info.GetType())
+	require.Equal(t, multi.GetAddress(), info.GetAddress())
+
+	// importing again skips every key
+	results, err = ImportKeyring(dst, armor, "backup passphrase", ConflictOverwrite, false)
+	require.NoError(t, err)
+	for _, res := range results {
+		require.Equal(t, ImportActionSkip, res.Action, res.Name)
+	}
+
+	// Ledger references keep their HD path
+	dst = NewInMemory().(dbKeybase)
+	_, err = ImportKeyring(dst, armor, "backup passphrase", ConflictSkip, false)
+	require.NoError(t, err)
+	info, err = dst.Get("ledger")
+	require.NoError(t, err)
+	require.Equal(t, TypeLedger, info.GetType())
+	path, err := info.GetPath()
+	require.NoError(t, err)
+	require.Equal(t, ledgerPath, *path)
+}
+
+func TestImportKeyringConflicts(t *testing.T) {
+	src := NewInMemory().(dbKeybase)
+	key, err := src.CreateOffline("key", secp256k1.GenPrivKey().PubKey(), Secp256k1)
+	require.NoError(t, err)
+
+	armor, _, err := ExportKeyring(src, "", "passphrase")
+	require.NoError(t, err)
+
+	dst := NewInMemory().(dbKeybase)
+	_, err = dst.CreateOffline("key", secp256k1.GenPrivKey().PubKey(), Secp256k1)
+	require.NoError(t, err)
+
+	results, err := ImportKeyring(dst, armor, "passphrase", ConflictSkip, false)
+	require.NoError(t, err)
+	require.Equal(t, ImportActionSkip, results[0].Action)
+	require.Equal(t, "name already in use", results[0].Reason)
+
+	results, err = ImportKeyring(dst, armor, "passphrase", ConflictOverwrite, false)
+	require.NoError(t, err)
+	require.Equal(t, ImportActionOverwrite, results[0].Action)
+
+	info, err := dst.Get("key")
+	require.NoError(t, err)
+	require.Equal(t, key.GetAddress(), info.GetAddress())
+
+	_, err = ParseKeyringImportConflict("merge")
+	require.Error(t, err)
+}
+
+func TestImportKeyringTamperedArchive(t *testing.T) {
+	// an archive whose key does not match its recorded address is rejected
+	// before anything is imported
+	archive := keyringArchive{
+		Version: keyringArchiveVersion,
+		Keys: []archivedKey{
+			{
+				Info:    newOfflineInfo("key", secp256k1.GenPrivKey().PubKey(), Secp256k1),
+				Address: secp256k1.GenPrivKey().PubKey().Address().Bytes(),
+			},
+		},
+	}
+	armor := mintkey.EncryptArmorKeyring(CryptoCdc.MustMarshalBinaryBare(archive), "passphrase")
+
+	dst := NewInMemory()
+	_, err := ImportKeyring(dst, armor, "passphrase", ConflictSkip, false)
+	require.Error(t, err)
+
+	infos, err := dst.List()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+}
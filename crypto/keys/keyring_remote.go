@@ -0,0 +1,282 @@
+package keys
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	tmos "github.com/tendermint/tendermint/libs/os"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	remoteKeyringDirNameFmt = "keyring-remote-%s"
+	remoteKeyringCacheName  = "keys"
+)
+
+var (
+	_ Keybase = remoteKeybase{}
+
+	// errRemoteKeyManagement is returned by the remote keyring backend for
+	// operations that create, modify or reveal keys, which are managed by the
+	// remote signer.
+	errRemoteKeyManagement = errors.New("unsupported operation: keys are managed by the remote signer")
+)
+
+// remoteKeybase implements the Keybase interface by sending signing requests
+// to a remote signer. The public keys of the signer are cached on disk so that
+// List and Get also work while the signer is unreachable.
+type remoteKeybase struct {
+	base   baseKeybase
+	client remoteSignerClient
+	dir    string
+}
+
+// newRemoteKeybase returns a remote keybase caching its keys under rootDir. It
+// connects to the signer set with WithRemoteSignerAddr or, by default, to the
+// DefaultRemoteSignerSocket of rootDir, and authenticates with the key of the
+// file set with WithRemoteSignerAuthKeyFile or, by default, of the
+// DefaultRemoteSignerAuthKeyFile of rootDir.
+func newRemoteKeybase(appName, rootDir string, opts ...KeybaseOption) (Keybase, error) {
+	dir := filepath.Join(rootDir, fmt.Sprintf(remoteKeyringDirNameFmt, appName))
+	if err := tmos.EnsureDir(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	base := newBaseKeybase(opts...)
+
+	addr := base.options.remoteSignerAddr
+	if addr == "" {
+		addr = "unix://" + filepath.Join(rootDir, DefaultRemoteSignerSocket)
+	}
+
+	authKeyFile := base.options.remoteSignerAuthKey
+	if authKeyFile == "" {
+		authKeyFile = filepath.Join(rootDir, DefaultRemoteSignerAuthKeyFile)
+	}
+
+	return remoteKeybase{
+		base:   base,
+		client: remoteSignerClient{addr: addr, authKeyFile: authKeyFile},
+		dir:    dir,
+	}, nil
+}
+
+// List returns the keys of the remote signer and refreshes the local cache. The
+// cached keys are returned if the signer cannot be reached.
+func (kb remoteKeybase) List() ([]Info, error) {
+	// an unreachable signer is not an error as the cached keys are listed
+	_ = kb.sync()
+
+	var infos []Info
+	err := kb.withCache(func(cache dbKeybase) (err error) {
+		infos, err = cache.List()
+		return err
+	})
+	return infos, err
+}
+
+// Get returns the cached public information about one key. The cache is
+// refreshed from the remote signer if the key is not cached.
+func (kb remoteKeybase) Get(name string) (Info, error) {
+	var info Info
+	get := func(cache dbKeybase) (err error) {
+		info, err = cache.Get(name)
+		return err
+	}
+
+	if err := kb.withCache(get); err == nil {
+		return info, nil
+	}
+	if err := kb.sync(); err != nil {
+		return nil, err
+	}
+
+	return info, kb.withCache(get)
+}
+
+// GetByAddress returns the cached public information about the key with the
+// given address. The cache is refreshed from the remote signer if the key is
+// not cached.
+func (kb remoteKeybase) GetByAddress(address types.AccAddress) (Info, error) {
+	var info Info
+	get := func(cache dbKeybase) (err error) {
+		info, err = cache.GetByAddress(address)
+		return err
+	}
+
+	if err := kb.withCache(get); err == nil {
+		return info, nil
+	}
+	if err := kb.sync(); err != nil {
+		return nil, err
+	}
+
+	return info, kb.withCache(get)
+}
+
+// Sign sends msg to the remote signer to be signed by the named key. The
+// passphrase is ignored. The returned signature is checked against the cached
+// public key of the key.
+func (kb remoteKeybase) Sign(name, _ string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, pub, err := kb.client.Sign(name, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pub == nil || !pub.Equals(info.GetPubKey()) {
+		return nil, nil, fmt.Errorf("remote signer signed with a different key than %s", name)
+	}
+	if !pub.VerifyBytes(msg, sig) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature for %s", name)
+	}
+
+	return sig, pub, nil
+}
+
+// Export exports the cached Info of a key in ASCII armored format.
+func (kb remoteKeybase) Export(name string) (armor string, err error) {
+	if _, err = kb.Get(name); err != nil {
+		return "", err
+	}
+
+	err = kb.withCache(func(cache dbKeybase) (err error) {
+		armor, err = cache.Export(name)
+		return err
+	})
+	return armor, err
+}
+
+// ExportPubKey returns the cached public key of a key in ASCII armored format.
+func (kb remoteKeybase) ExportPubKey(name string) (armor string, err error) {
+	if _, err = kb.Get(name); err != nil {
+		return "", err
+	}
+
+	err = kb.withCache(func(cache dbKeybase) (err error) {
+		armor, err = cache.ExportPubKey(name)
+		return err
+	})
+	return armor, err
+}
+
+// Delete is not supported by the remote backend.
+func (kb remoteKeybase) Delete(name, passphrase string, skipPass bool) error {
+	return errRemoteKeyManagement
+}
+
+// CreateMnemonic is not supported by the remote backend.
+func (kb remoteKeybase) CreateMnemonic(string, Language, string, SigningAlgo) (Info, string, error) {
+	return nil, "", errRemoteKeyManagement
+}
+
+// CreateAccount is not supported by the remote backend.
+func (kb remoteKeybase) CreateAccount(string, string, string, string, string, SigningAlgo) (Info, error) {
+	return nil, errRemoteKeyManagement
+}
+
+// CreateLedger is not supported by the remote backend.
+func (kb remoteKeybase) CreateLedger(string, SigningAlgo, string, uint32, uint32) (Info, error) {
+	return nil, errRemoteKeyManagement
+}
+
+// CreateOffline is not supported by the remote backend.
+func (kb remoteKeybase) CreateOffline(string, tmcrypto.PubKey, SigningAlgo) (Info, error) {
+	return nil, errRemoteKeyManagement
+}
+
+// CreateMulti is not supported by the remote backend.
+func (kb remoteKeybase) CreateMulti(string, tmcrypto.PubKey) (Info, error) {
+	return nil, errRemoteKeyManagement
+}
+
+// Update is not supported by the remote backend.
+func (kb remoteKeybase) Update(string, string, func() (string, error)) error {
+	return errRemoteKeyManagement
+}
+
+// Import is not supported by the remote backend.
+func (kb remoteKeybase) Import(string, string) error {
+	return errRemoteKeyManagement
+}
+
+// ImportPrivKey is not supported by the remote backend.
+func (kb remoteKeybase) ImportPrivKey(string, string, string) error {
+	return errRemoteKeyManagement
+}
+
+// ImportPubKey is not supported by the remote backend.
+func (kb remoteKeybase) ImportPubKey(string, string) error {
+	return errRemoteKeyManagement
+}
+
+// ExportPrivKey is not supported by the remote backend.
+func (kb remoteKeybase) ExportPrivKey(string, string, string) (string, error) {
+	return "", errRemoteKeyManagement
+}
+
+// ExportPrivateKeyObject is not supported by the remote backend.
+func (kb remoteKeybase) ExportPrivateKeyObject(string, string) (tmcrypto.PrivKey, error) {
+	return nil, errRemoteKeyManagement
+}
+
+// SupportedAlgos returns a list of supported signing algorithms.
+func (kb remoteKeybase) SupportedAlgos() []SigningAlgo {
+	return kb.base.SupportedAlgos()
+}
+
+// SupportedAlgosLedger returns a list of supported ledger signing algorithms.
+func (kb remoteKeybase) SupportedAlgosLedger() []SigningAlgo {
+	return kb.base.SupportedAlgosLedger()
+}
+
+// CloseDB is a no-op as the cache is only opened for the duration of each operation.
+func (kb remoteKeybase) CloseDB() {}
+
+// sync replaces the cached keys with the keys of the remote signer.
+func (kb remoteKeybase) sync() error {
+	keys, err := kb.client.ListKeys()
+	if err != nil {
+		return err
+	}
+
+	return kb.withCache(func(cache dbKeybase) error {
+		cached, err := cache.List()
+		if err != nil {
+			return err
+		}
+
+		for _, info := range cached {
+			if err := cache.Delete(info.GetName(), "", true); err != nil {
+				return err
+			}
+		}
+
+		for _, key := range keys {
+			if key.PubKey == nil {
+				return fmt.Errorf("remote signer returned no public key for %s", key.Name)
+			}
+			cache.writeInfo(key.Name, newRemoteInfo(key.Name, key.PubKey, key.Algo))
+		}
+
+		return nil
+	})
+}
+
+// withCache opens the key cache for the duration of fn.
+func (kb remoteKeybase) withCache(fn func(cache dbKeybase) error) error {
+	db, err := types.NewLevelDB(remoteKeyringCacheName, kb.dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return fn(dbKeybase{base: kb.base, db: db})
+}
@@ -0,0 +1,168 @@
+package keys
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func TestRemoteKeyring(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	// the signer holds two local keys and an offline key it cannot sign with
+	signerKb := NewInMemory().(dbKeybase)
+	priv1, priv2 := secp256k1.GenPrivKey(), secp256r1.GenPrivKey()
+	signerKb.writeLocalKey("key1", priv1, "", Secp256k1)
+	signerKb.writeLocalKey("key2", priv2, "", Secp256r1)
+	_, err := signerKb.CreateOffline("offline", secp256k1.GenPrivKey().PubKey(), Secp256k1)
+	require.NoError(t, err)
+
+	authKey, err := GenRemoteSignerAuthKey(filepath.Join(dir, DefaultRemoteSignerAuthKeyFile))
+	require.NoError(t, err)
+
+	socket := filepath.Join(dir, DefaultRemoteSignerSocket)
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	go NewRemoteSigner(signerKb, authKey, log.NewNopLogger()).Serve(ln) // nolint: errcheck
+
+	// the remote backend connects to the default socket of its home directory
+	// and authenticates with its default auth key
+	kb, err := NewKeyring("keybasename", BackendRemote, dir, nil)
+	require.NoError(t, err)
+
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, "key1", infos[0].GetName())
+	require.Equal(t, TypeRemote, infos[0].GetType())
+	require.Equal(t, Secp256k1, infos[0].GetAlgo())
+	require.True(t, priv1.PubKey().Equals(infos[0].GetPubKey()))
+	require.Equal(t, Secp256r1, infos[1].GetAlgo())
+
+	info, err := kb.GetByAddress(infos[1].GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "key2", info.GetName())
+
+	msg := []byte("hello world")
+	for _, name := range []string{"key1", "key2"} {
+		sig, pub, err := kb.Sign(name, "", msg)
+		require.NoError(t, err)
+		require.True(t, pub.VerifyBytes(msg, sig))
+	}
+
+	_, _, err = kb.Sign("offline", "", msg)
+	require.Error(t, err)
+
+	// keys are managed by the signer
+	_, _, err = kb.CreateMnemonic("key3", English, "", Secp256k1)
+	require.Equal(t, errRemoteKeyManagement, err)
+	require.Equal(t, errRemoteKeyManagement, kb.Delete("key1", "", true))
+	_, err = kb.ExportPrivKey("key1", "", "")
+	require.Equal(t, errRemoteKeyManagement, err)
+
+	_, err = kb.ExportPubKey("key1")
+	require.NoError(t, err)
+
+	// the cached keys remain available while the signer is unreachable
+	require.NoError(t, ln.Close())
+
+	infos, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	info, err = kb.Get("key2")
+	require.NoError(t, err)
+	require.True(t, priv2.PubKey().Equals(info.GetPubKey()))
+
+	_, _, err = kb.Sign("key1", "", msg)
+	require.Error(t, err)
+
+	_, err = kb.Get("unknown")
+	require.Error(t, err)
+}
+
+func TestRemoteKeyringSignerKeyMismatch(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	signerKb := NewInMemory().(dbKeybase)
+	signerKb.writeLocalKey("key1", secp256k1.GenPrivKey(), "", Secp256k1)
+
+	authKey, err := GenRemoteSignerAuthKey(filepath.Join(dir, DefaultRemoteSignerAuthKeyFile))
+	require.NoError(t, err)
+
+	socket := filepath.Join(dir, "signer.sock")
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go NewRemoteSigner(signerKb, authKey, log.NewNopLogger()).Serve(ln) // nolint: errcheck
+
+	kb, err := NewKeyring("keybasename", BackendRemote, dir, nil, WithRemoteSignerAddr("unix://"+socket))
+	require.NoError(t, err)
+
+	_, err = kb.Get("key1")
+	require.NoError(t, err)
+
+	// the signer replaces the key behind the cached name
+	require.NoError(t, signerKb.Delete("key1", "", true))
+	signerKb.writeLocalKey("key1", secp256k1.GenPrivKey(), "", Secp256k1)
+
+	_, _, err = kb.Sign("key1", "", []byte("hello world"))
+	require.Error(t, err)
+}
+
+func TestRemoteKeyringAuthentication(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+
+	signerKb := NewInMemory().(dbKeybase)
+	signerKb.writeLocalKey("key1", secp256k1.GenPrivKey(), "", Secp256k1)
+
+	authKeyFile := filepath.Join(dir, "signer.key")
+	authKey, err := GenRemoteSignerAuthKey(authKeyFile)
+	require.NoError(t, err)
+
+	// the generated key is only readable by its owner and loaded on restart
+	fi, err := os.Stat(authKeyFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	reloaded, err := GenRemoteSignerAuthKey(authKeyFile)
+	require.NoError(t, err)
+	require.Equal(t, authKey, reloaded)
+
+	socket := filepath.Join(dir, "signer.sock")
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go NewRemoteSigner(signerKb, authKey, log.NewNopLogger()).Serve(ln) // nolint: errcheck
+
+	// clients without the auth key or with another key are rejected
+	kb, err := NewKeyring("keybasename", BackendRemote, dir, nil, WithRemoteSignerAddr("unix://"+socket))
+	require.NoError(t, err)
+	_, _, err = kb.Sign("key1", "", []byte("hello world"))
+	require.Error(t, err)
+
+	otherKeyFile := filepath.Join(dir, "other.key")
+	_, err = GenRemoteSignerAuthKey(otherKeyFile)
+	require.NoError(t, err)
+	kb, err = NewKeyring("keybasename", BackendRemote, dir, nil,
+		WithRemoteSignerAddr("unix://"+socket), WithRemoteSignerAuthKeyFile(otherKeyFile))
+	require.NoError(t, err)
+	_, _, err = kb.Sign("key1", "", []byte("hello world"))
+	require.Error(t, err)
+
+	kb, err = NewKeyring("keybasename", BackendRemote, dir, nil,
+		WithRemoteSignerAddr("unix://"+socket), WithRemoteSignerAuthKeyFile(authKeyFile))
+	require.NoError(t, err)
+	_, _, err = kb.Sign("key1", "", []byte("hello world"))
+	require.NoError(t, err)
+}
@@ -30,8 +30,8 @@ func TestLazyKeyManagementKeyRing(t *testing.T) {
 	require.Nil(t, err)
 	assert.Empty(t, l)
 
-	_, _, err = kb.CreateMnemonic(n1, English, p1, Ed25519)
-	require.Error(t, err, "ed25519 keys are currently not supported by keybase")
+	_, _, err = kb.CreateMnemonic(n1, English, p1, Sr25519)
+	require.Error(t, err, "sr25519 keys are currently not supported by keybase")
 
 	// create some keys
 	_, err = kb.Get(n1)
@@ -408,6 +408,6 @@ func TestSupportedAlgos(t *testing.T) {
 	t.Cleanup(cleanup)
 	kb, err := NewKeyring("keybasename", "test", dir, nil)
 	require.NoError(t, err)
-	require.Equal(t, []SigningAlgo([]SigningAlgo{"secp256k1"}), kb.SupportedAlgos())
+	require.Equal(t, SignatureAlgos(), kb.SupportedAlgos())
 	require.Equal(t, []SigningAlgo([]SigningAlgo{"secp256k1"}), kb.SupportedAlgosLedger())
 }
@@ -9,8 +9,11 @@ const (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = SigningAlgo("secp256k1")
 	// Ed25519 represents the Ed25519 signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// It is not supported by ledger devices.
 	Ed25519 = SigningAlgo("ed25519")
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	// It is not supported by ledger devices.
+	Secp256r1 = SigningAlgo("secp256r1")
 	// Sr25519 represents the Sr25519 signature system.
 	Sr25519 = SigningAlgo("sr25519")
 )
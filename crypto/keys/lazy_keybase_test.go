@@ -40,8 +40,8 @@ func TestLazyKeyManagement(t *testing.T) {
 	require.Nil(t, err)
 	assert.Empty(t, l)
 
-	_, _, err = kb.CreateMnemonic(n1, English, p1, Ed25519)
-	require.Error(t, err, "ed25519 keys are currently not supported by keybase")
+	_, _, err = kb.CreateMnemonic(n1, English, p1, Sr25519)
+	require.Error(t, err, "sr25519 keys are currently not supported by keybase")
 
 	// create some keys
 	_, err = kb.Get(n1)
@@ -19,6 +19,7 @@ const (
 	blockTypePrivKey = "TENDERMINT PRIVATE KEY"
 	blockTypeKeyInfo = "TENDERMINT KEY INFO"
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
+	blockTypeKeyring = "TENDERMINT KEYRING"
 
 	defaultAlgo = "secp256k1"
 
@@ -140,6 +141,57 @@ func encryptPrivKey(privKey crypto.PrivKey, passphrase string) (saltBytes []byte
 	return saltBytes, xsalsa20symmetric.EncryptSymmetric(privKeyBytes, key)
 }
 
+// EncryptArmorKeyring encrypts the given keyring archive with the passphrase
+// and armors it. The archive is encrypted with xsalsa20 and authenticated with
+// poly1305 using a key derived from the passphrase with bcrypt.
+func EncryptArmorKeyring(bz []byte, passphrase string) string {
+	saltBytes := crypto.CRandBytes(16)
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+	if err != nil {
+		panic(errors.Wrap(err, "error generating bcrypt key from passphrase"))
+	}
+	key = crypto.Sha256(key) // get 32 bytes
+
+	header := map[string]string{
+		"kdf":         "bcrypt",
+		"salt":        fmt.Sprintf("%X", saltBytes),
+		headerVersion: "0.0.1",
+	}
+	return armor.EncodeArmor(blockTypeKeyring, header, xsalsa20symmetric.EncryptSymmetric(bz, key))
+}
+
+// UnarmorDecryptKeyring returns the keyring archive armored by
+// EncryptArmorKeyring. It returns an error if the archive was not encrypted
+// with the given passphrase or has been tampered with.
+func UnarmorDecryptKeyring(armorStr string, passphrase string) ([]byte, error) {
+	encBytes, header, err := unarmorBytes(armorStr, blockTypeKeyring)
+	if err != nil {
+		return nil, err
+	}
+	if header[headerVersion] != "0.0.1" {
+		return nil, fmt.Errorf("unrecognized version: %v", header[headerVersion])
+	}
+	if header["kdf"] != "bcrypt" {
+		return nil, fmt.Errorf("unrecognized KDF type: %v", header["kdf"])
+	}
+	saltBytes, err := hex.DecodeString(header["salt"])
+	if err != nil || len(saltBytes) == 0 {
+		return nil, fmt.Errorf("missing or invalid salt bytes")
+	}
+
+	key, err := bcrypt.GenerateFromPassword(saltBytes, []byte(passphrase), BcryptSecurityParameter)
+	if err != nil {
+		return nil, errors.Wrap(err, "error generating bcrypt key from passphrase")
+	}
+	key = crypto.Sha256(key) // get 32 bytes
+
+	bz, err := xsalsa20symmetric.DecryptSymmetric(encBytes, key)
+	if err != nil && err.Error() == "Ciphertext decryption failed" {
+		return nil, keyerror.NewErrWrongPassword()
+	}
+	return bz, err
+}
+
 // UnarmorDecryptPrivKey returns the privkey byte slice, a string of the algo type, and an error
 func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKey crypto.PrivKey, algo string, err error) {
 	blockType, header, encBytes, err := armor.DecodeArmor(armorStr)
@@ -135,3 +135,27 @@ func TestUnarmorInfoBytesErrors(t *testing.T) {
 	require.Equal(t, "unrecognized version: 0.0.1", err.Error())
 	require.Nil(t, unarmoredBytes)
 }
+
+func TestArmorUnarmorKeyring(t *testing.T) {
+	archive := []byte("keyring archive")
+	armored := mintkey.EncryptArmorKeyring(archive, "passphrase")
+
+	_, err := mintkey.UnarmorDecryptKeyring(armored, "wrongpassphrase")
+	require.Error(t, err)
+
+	decrypted, err := mintkey.UnarmorDecryptKeyring(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, archive, decrypted)
+
+	// tampered ciphertext
+	blockType, header, encBytes, err := armor.DecodeArmor(armored)
+	require.NoError(t, err)
+	encBytes[len(encBytes)-1] ^= 0x01
+	_, err = mintkey.UnarmorDecryptKeyring(armor.EncodeArmor(blockType, header, encBytes), "passphrase")
+	require.Error(t, err)
+
+	// wrong armor type
+	_, err = mintkey.UnarmorDecryptKeyring(mintkey.EncryptArmorPrivKey(secp256k1.GenPrivKey(), "passphrase", ""), "passphrase")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unrecognized armor type")
+}
@@ -0,0 +1,407 @@
+package keys
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmnet "github.com/tendermint/tendermint/libs/net"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// The remote signer protocol lets the remote keyring backend sign with keys
+// held by a separate signing service. The client connects to the signer over a
+// Unix domain socket ("unix:///path/to/signer.sock") or TCP
+// ("tcp://host:port") and exchanges Amino encoded, length prefixed
+// RemoteSignerMsg messages.
+//
+// The signer first authenticates the client. It sends an AuthChallenge with a
+// random nonce, which the client answers with an AuthRequest holding the
+// HMAC-SHA256 of the nonce keyed by the auth key shared by both ends. The
+// signer closes the connection after an AuthResponse with a non-empty Error if
+// the MAC does not match. An authenticated client then writes requests, each
+// of which the signer answers with exactly one response:
+//
+//   ListKeysRequest -> ListKeysResponse
+//   SignRequest     -> SignResponse
+//
+// A non-empty Error field in a response reports a failed request. The
+// protocol does not encrypt connections; TCP signers must only listen on
+// trusted networks or behind an encrypting tunnel.
+
+const (
+	// DefaultRemoteSignerSocket is the Unix socket, relative to the home
+	// directory, the remote keyring backend connects to by default.
+	DefaultRemoteSignerSocket = "remote-signer.sock"
+
+	// DefaultRemoteSignerAuthKeyFile is the file, relative to the home
+	// directory, holding the auth key shared by the remote signer and its
+	// clients by default.
+	DefaultRemoteSignerAuthKeyFile = "remote-signer.key"
+
+	// remoteSignerAuthKeySize is the size of generated auth keys and nonces.
+	remoteSignerAuthKeySize = 32
+
+	// maxRemoteSignerMsgSize is the maximum size of an encoded request or response.
+	maxRemoteSignerMsgSize = 1024 * 1024
+
+	// remoteSignerTimeout is the deadline for a request and its response.
+	remoteSignerTimeout = 30 * time.Second
+)
+
+// remoteSignerCdc is the codec of the remote signer protocol messages. It is
+// not sealed, as RegisterSignatureAlgo registers the key types of the signing
+// algorithms on it.
+var remoteSignerCdc = newRemoteSignerCodec()
+
+func newRemoteSignerCodec() *codec.Codec {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterInterface((*RemoteSignerMsg)(nil), nil)
+	cdc.RegisterConcrete(AuthChallenge{}, "cosmos-sdk/remotesigner/AuthChallenge", nil)
+	cdc.RegisterConcrete(AuthRequest{}, "cosmos-sdk/remotesigner/AuthRequest", nil)
+	cdc.RegisterConcrete(AuthResponse{}, "cosmos-sdk/remotesigner/AuthResponse", nil)
+	cdc.RegisterConcrete(ListKeysRequest{}, "cosmos-sdk/remotesigner/ListKeysRequest", nil)
+	cdc.RegisterConcrete(ListKeysResponse{}, "cosmos-sdk/remotesigner/ListKeysResponse", nil)
+	cdc.RegisterConcrete(SignRequest{}, "cosmos-sdk/remotesigner/SignRequest", nil)
+	cdc.RegisterConcrete(SignResponse{}, "cosmos-sdk/remotesigner/SignResponse", nil)
+	return cdc
+}
+
+type (
+	// RemoteSignerMsg is a request or response of the remote signer protocol.
+	RemoteSignerMsg interface{}
+
+	// AuthChallenge is the nonce the client must authenticate with.
+	AuthChallenge struct {
+		Nonce []byte `json:"nonce"`
+	}
+
+	// AuthRequest authenticates the client with the MAC of the challenge nonce.
+	AuthRequest struct {
+		MAC []byte `json:"mac"`
+	}
+
+	// AuthResponse reports whether the client has been authenticated.
+	AuthResponse struct {
+		Error string `json:"error"`
+	}
+
+	// ListKeysRequest requests the keys the signer can sign with.
+	ListKeysRequest struct{}
+
+	// ListKeysResponse returns the keys the signer can sign with.
+	ListKeysResponse struct {
+		Keys  []RemoteKey `json:"keys"`
+		Error string      `json:"error"`
+	}
+
+	// RemoteKey is the public information about a key held by a remote signer.
+	RemoteKey struct {
+		Name   string          `json:"name"`
+		PubKey tmcrypto.PubKey `json:"pubkey"`
+		Algo   SigningAlgo     `json:"algo"`
+	}
+
+	// SignRequest requests a signature of Msg by the key named Name.
+	SignRequest struct {
+		Name string `json:"name"`
+		Msg  []byte `json:"msg"`
+	}
+
+	// SignResponse returns the signature and the public key it verifies with.
+	SignResponse struct {
+		Signature []byte          `json:"signature"`
+		PubKey    tmcrypto.PubKey `json:"pubkey"`
+		Error     string          `json:"error"`
+	}
+)
+
+// GenRemoteSignerAuthKey returns the auth key stored in the given file. A new
+// random key is generated and written to the file, readable by its owner only,
+// if the file does not exist.
+func GenRemoteSignerAuthKey(path string) ([]byte, error) {
+	if _, err := os.Stat(path); err == nil {
+		return LoadRemoteSignerAuthKey(path)
+	}
+
+	key := make([]byte, remoteSignerAuthKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(key)), 0600); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// LoadRemoteSignerAuthKey returns the hex encoded auth key stored in the given
+// file.
+func LoadRemoteSignerAuthKey(path string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read remote signer auth key")
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid remote signer auth key in %s", path)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("empty remote signer auth key in %s", path)
+	}
+
+	return key, nil
+}
+
+// remoteSignerMAC returns the MAC authenticating a challenge nonce.
+func remoteSignerMAC(authKey, nonce []byte) []byte {
+	mac := hmac.New(sha256.New, authKey)
+	mac.Write(nonce) // nolint: errcheck
+	return mac.Sum(nil)
+}
+
+// RemoteSigner serves the local keys of a keybase over the remote signer
+// protocol. Offline, Ledger and multisig keys are not served.
+type RemoteSigner struct {
+	kb      Keybase
+	authKey []byte
+	logger  log.Logger
+}
+
+// NewRemoteSigner returns a RemoteSigner signing with the local keys of kb on
+// behalf of the clients authenticating with authKey.
+func NewRemoteSigner(kb Keybase, authKey []byte, logger log.Logger) *RemoteSigner {
+	return &RemoteSigner{kb: kb, authKey: authKey, logger: logger}
+}
+
+// Serve accepts connections on the listener and serves each of them in its
+// own goroutine. It blocks until the listener fails or is closed.
+func (rs *RemoteSigner) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+
+		go rs.serveConn(conn)
+	}
+}
+
+func (rs *RemoteSigner) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	if err := rs.authenticate(conn); err != nil {
+		rs.logger.Error("failed to authenticate client", "remote", conn.RemoteAddr(), "err", err)
+		return
+	}
+
+	for {
+		var req RemoteSignerMsg
+		if _, err := remoteSignerCdc.UnmarshalBinaryLengthPrefixedReader(conn, &req, maxRemoteSignerMsgSize); err != nil {
+			if err != io.EOF {
+				rs.logger.Error("failed to read request", "err", err)
+			}
+			return
+		}
+
+		res := rs.handleRequest(req)
+		if _, err := remoteSignerCdc.MarshalBinaryLengthPrefixedWriter(conn, res); err != nil {
+			rs.logger.Error("failed to write response", "err", err)
+			return
+		}
+	}
+}
+
+// authenticate runs the authentication handshake with a new client.
+func (rs *RemoteSigner) authenticate(conn net.Conn) error {
+	if err := conn.SetDeadline(time.Now().Add(remoteSignerTimeout)); err != nil {
+		return err
+	}
+
+	nonce := make([]byte, remoteSignerAuthKeySize)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	if _, err := remoteSignerCdc.MarshalBinaryLengthPrefixedWriter(conn, AuthChallenge{Nonce: nonce}); err != nil {
+		return err
+	}
+
+	var msg RemoteSignerMsg
+	if _, err := remoteSignerCdc.UnmarshalBinaryLengthPrefixedReader(conn, &msg, maxRemoteSignerMsgSize); err != nil {
+		return err
+	}
+
+	req, ok := msg.(AuthRequest)
+	if !ok || !hmac.Equal(req.MAC, remoteSignerMAC(rs.authKey, nonce)) {
+		_, _ = remoteSignerCdc.MarshalBinaryLengthPrefixedWriter(conn, AuthResponse{Error: "authentication failed"})
+		return errors.New("invalid authentication")
+	}
+
+	if _, err := remoteSignerCdc.MarshalBinaryLengthPrefixedWriter(conn, AuthResponse{}); err != nil {
+		return err
+	}
+
+	// authenticated connections are kept open for further requests
+	return conn.SetDeadline(time.Time{})
+}
+
+func (rs *RemoteSigner) handleRequest(req RemoteSignerMsg) RemoteSignerMsg {
+	switch req := req.(type) {
+	case ListKeysRequest:
+		infos, err := rs.kb.List()
+		if err != nil {
+			return ListKeysResponse{Error: err.Error()}
+		}
+
+		keys := make([]RemoteKey, 0, len(infos))
+		for _, info := range infos {
+			if info.GetType() == TypeLocal {
+				keys = append(keys, RemoteKey{Name: info.GetName(), PubKey: info.GetPubKey(), Algo: info.GetAlgo()})
+			}
+		}
+		return ListKeysResponse{Keys: keys}
+
+	case SignRequest:
+		info, err := rs.kb.Get(req.Name)
+		if err != nil {
+			return SignResponse{Error: err.Error()}
+		}
+		if info.GetType() != TypeLocal {
+			return SignResponse{Error: fmt.Sprintf("key %s is not a local key", req.Name)}
+		}
+
+		sig, pub, err := rs.kb.Sign(req.Name, "", req.Msg)
+		if err != nil {
+			return SignResponse{Error: err.Error()}
+		}
+
+		rs.logger.Info("signed message", "key", req.Name, "address", info.GetAddress())
+		return SignResponse{Signature: sig, PubKey: pub}
+
+	default:
+		return SignResponse{Error: fmt.Sprintf("unknown remote signer request: %T", req)}
+	}
+}
+
+// remoteSignerClient sends remote signer protocol requests to a signer. The
+// auth key is read from authKeyFile when connecting.
+type remoteSignerClient struct {
+	addr        string
+	authKeyFile string
+}
+
+// ListKeys returns the keys the signer can sign with.
+func (c remoteSignerClient) ListKeys() ([]RemoteKey, error) {
+	res, err := c.request(ListKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	listRes, ok := res.(ListKeysResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected remote signer response: %T", res)
+	}
+	if listRes.Error != "" {
+		return nil, errors.New(listRes.Error)
+	}
+
+	return listRes.Keys, nil
+}
+
+// Sign returns the signature of msg by the named key and its public key.
+func (c remoteSignerClient) Sign(name string, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	res, err := c.request(SignRequest{Name: name, Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signRes, ok := res.(SignResponse)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected remote signer response: %T", res)
+	}
+	if signRes.Error != "" {
+		return nil, nil, errors.New(signRes.Error)
+	}
+
+	return signRes.Signature, signRes.PubKey, nil
+}
+
+func (c remoteSignerClient) request(req RemoteSignerMsg) (RemoteSignerMsg, error) {
+	conn, err := tmnet.Connect(c.addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to remote signer %s", c.addr)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(remoteSignerTimeout)); err != nil {
+		return nil, err
+	}
+
+	if err := c.authenticate(conn); err != nil {
+		return nil, errors.Wrapf(err, "failed to authenticate with remote signer %s", c.addr)
+	}
+
+	if _, err := remoteSignerCdc.MarshalBinaryLengthPrefixedWriter(conn, req); err != nil {
+		return nil, err
+	}
+
+	var res RemoteSignerMsg
+	if _, err := remoteSignerCdc.UnmarshalBinaryLengthPrefixedReader(conn, &res, maxRemoteSignerMsgSize); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// authenticate answers the authentication challenge of the signer.
+func (c remoteSignerClient) authenticate(conn net.Conn) error {
+	authKey, err := LoadRemoteSignerAuthKey(c.authKeyFile)
+	if err != nil {
+		return err
+	}
+
+	var msg RemoteSignerMsg
+	if _, err := remoteSignerCdc.UnmarshalBinaryLengthPrefixedReader(conn, &msg, maxRemoteSignerMsgSize); err != nil {
+		return err
+	}
+
+	challenge, ok := msg.(AuthChallenge)
+	if !ok {
+		return fmt.Errorf("unexpected remote signer message: %T", msg)
+	}
+
+	if _, err := remoteSignerCdc.MarshalBinaryLengthPrefixedWriter(conn, AuthRequest{MAC: remoteSignerMAC(authKey, challenge.Nonce)}); err != nil {
+		return err
+	}
+
+	var resMsg RemoteSignerMsg
+	if _, err := remoteSignerCdc.UnmarshalBinaryLengthPrefixedReader(conn, &resMsg, maxRemoteSignerMsgSize); err != nil {
+		return err
+	}
+
+	res, ok := resMsg.(AuthResponse)
+	if !ok {
+		return fmt.Errorf("unexpected remote signer message: %T", resMsg)
+	}
+	if res.Error != "" {
+		return errors.New(res.Error)
+	}
+
+	return nil
+}
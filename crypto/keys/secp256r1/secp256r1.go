@@ -0,0 +1,201 @@
+// Package secp256r1 implements the NIST P-256 (secp256r1) ECDSA signature
+// scheme as a Tendermint crypto key type so that it can be used for account
+// keys.
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PrivKeySize is the size, in bytes, of a private key scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of a compressed public key.
+	PubKeySize = 33
+	// SignatureSize is the size, in bytes, of a signature in r || s form.
+	SignatureSize = 64
+)
+
+var (
+	cdc = amino.NewCodec()
+
+	curve     = elliptic.P256()
+	curveN    = curve.Params().N
+	halfOrder = new(big.Int).Rsh(curveN, 1)
+)
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	RegisterCodec(cdc)
+
+	// register the key types with Tendermint's global codec so that keys can
+	// be decoded with PubKeyFromBytes and PrivKeyFromBytes
+	cryptoamino.RegisterKeyType(PubKeySecp256r1{}, PubKeyAminoName)
+	cryptoamino.RegisterKeyType(PrivKeySecp256r1{}, PrivKeyAminoName)
+}
+
+// RegisterCodec registers the secp256r1 key types on an Amino codec which
+// already has the crypto.PubKey and crypto.PrivKey interfaces registered.
+func RegisterCodec(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements crypto.PrivKey as a big-endian P-256 scalar.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// GenPrivKey generates a new secp256r1 private key using OS randomness.
+func GenPrivKey() PrivKeySecp256r1 {
+	key, err := ecdsa.GenerateKey(curve, crypto.CReader())
+	if err != nil {
+		panic(err)
+	}
+
+	var privKey PrivKeySecp256r1
+	key.D.FillBytes(privKey[:])
+	return privKey
+}
+
+// GenPrivKeyFromSecret deterministically derives a private key from the given
+// secret. The secret is hashed with SHA-256 and reduced to a valid, non-zero
+// scalar of the curve.
+func GenPrivKeyFromSecret(secret []byte) PrivKeySecp256r1 {
+	hash := sha256.Sum256(secret)
+
+	// d = (hash mod (n - 1)) + 1 lies in [1, n-1]
+	nMinusOne := new(big.Int).Sub(curveN, big.NewInt(1))
+	d := new(big.Int).SetBytes(hash[:])
+	d.Mod(d, nMinusOne)
+	d.Add(d, big.NewInt(1))
+
+	var privKey PrivKeySecp256r1
+	d.FillBytes(privKey[:])
+	return privKey
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign creates an ECDSA signature over the SHA-256 digest of msg. The
+// signature is returned in the 64 byte r || s form with s normalized to the
+// lower half of the curve order.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), privKey.toECDSA(), hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curveN, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig, nil
+}
+
+// PubKey returns the compressed public key corresponding to the private key.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	x, y := curve.ScalarBaseMult(privKey[:])
+
+	var pubKey PubKeySecp256r1
+	copy(pubKey[:], elliptic.MarshalCompressed(curve, x, y))
+	return pubKey
+}
+
+// Equals runs in constant time based on the length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherSecp, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+	}
+	return false
+}
+
+func (privKey PrivKeySecp256r1) toECDSA() *ecdsa.PrivateKey {
+	key := new(ecdsa.PrivateKey)
+	key.Curve = curve
+	key.D = new(big.Int).SetBytes(privKey[:])
+	key.X, key.Y = curve.ScalarBaseMult(privKey[:])
+	return key
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 implements crypto.PubKey as a compressed P-256 point: a
+// 0x02 or 0x03 prefix byte followed by the 32 byte big-endian X coordinate.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the truncated SHA-256 hash of the compressed public key.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.AddressHash(pubKey[:])
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	bz, err := cdc.MarshalBinaryBare(pubKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyBytes verifies a 64 byte r || s signature over the SHA-256 digest of
+// msg. Signatures whose s value lies in the upper half of the curve order are
+// rejected to prevent signature malleability.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if r.Sign() == 0 || s.Sign() == 0 || r.Cmp(curveN) >= 0 || s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey[:])
+	if x == nil {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, hash[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals returns true if the other key is a secp256r1 public key with the same
+// bytes.
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherSecp, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package secp256r1_test
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+	require.True(t, pubKey.VerifyBytes(msg, sig))
+
+	// wrong message
+	require.False(t, pubKey.VerifyBytes([]byte("goodbye"), sig))
+
+	// wrong key
+	require.False(t, secp256r1.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// truncated signature
+	require.False(t, pubKey.VerifyBytes(msg, sig[:63]))
+
+	// malleated signature with high s
+	n := elliptic.P256().Params().N
+	s := new(big.Int).SetBytes(sig[32:])
+	highS := new(big.Int).Sub(n, s)
+	malleated := make([]byte, secp256r1.SignatureSize)
+	copy(malleated, sig[:32])
+	highS.FillBytes(malleated[32:])
+	require.False(t, pubKey.VerifyBytes(msg, malleated))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	a := secp256r1.GenPrivKeyFromSecret([]byte("secret"))
+	b := secp256r1.GenPrivKeyFromSecret([]byte("secret"))
+	c := secp256r1.GenPrivKeyFromSecret([]byte("other secret"))
+
+	require.True(t, a.Equals(b))
+	require.False(t, a.Equals(c))
+	require.True(t, a.PubKey().Equals(b.PubKey()))
+	require.Len(t, a.PubKey().Address(), 20)
+}
+
+func TestAminoRoundTrip(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	decodedPriv, err := cryptoamino.PrivKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decodedPriv))
+
+	decodedPub, err := cryptoamino.PubKeyFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(decodedPub))
+}
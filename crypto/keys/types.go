@@ -4,9 +4,9 @@ import (
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
-	"github.com/tendermint/tendermint/crypto/multisig"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -94,6 +94,7 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	TypeRemote  KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
@@ -101,6 +102,7 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+	TypeRemote:  "remote",
 }
 
 // String implements the stringer interface for KeyType.
@@ -129,6 +131,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &remoteInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -274,6 +277,51 @@ func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// remoteInfo is the public information about a key held by a remote signer
+type remoteInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+	Algo   SigningAlgo   `json:"algo"`
+}
+
+func newRemoteInfo(name string, pub crypto.PubKey, algo SigningAlgo) Info {
+	return &remoteInfo{
+		Name:   name,
+		PubKey: pub,
+		Algo:   algo,
+	}
+}
+
+// GetType implements Info interface
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+// GetName implements Info interface
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i remoteInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo returns the signing algorithm for the key
+func (i remoteInfo) GetAlgo() SigningAlgo {
+	return i.Algo
+}
+
+// GetAddress implements Info interface
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetPath implements Info interface
+func (i remoteInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 type multisigPubKeyInfo struct {
 	PubKey crypto.PubKey `json:"pubkey"`
 	Weight uint          `json:"weight"`
@@ -289,10 +337,10 @@ type multiInfo struct {
 
 // NewMultiInfo creates a new multiInfo instance
 func NewMultiInfo(name string, pub crypto.PubKey) Info {
-	multiPK := pub.(multisig.PubKeyMultisigThreshold)
+	threshold, multiPubKeys, _ := multisig.Threshold(pub)
 
-	pubKeys := make([]multisigPubKeyInfo, len(multiPK.PubKeys))
-	for i, pk := range multiPK.PubKeys {
+	pubKeys := make([]multisigPubKeyInfo, len(multiPubKeys))
+	for i, pk := range multiPubKeys {
 		// TODO: Recursively check pk for total weight?
 		pubKeys[i] = multisigPubKeyInfo{pk, 1}
 	}
@@ -300,7 +348,7 @@ func NewMultiInfo(name string, pub crypto.PubKey) Info {
 	return &multiInfo{
 		Name:      name,
 		PubKey:    pub,
-		Threshold: multiPK.K,
+		Threshold: threshold,
 		PubKeys:   pubKeys,
 	}
 }
@@ -0,0 +1,156 @@
+// Package multisig implements a K of N threshold multisig public key which,
+// unlike the Tendermint multisig public key it mirrors, can hold any account key
+// type of the SDK, e.g. secp256r1 keys. The Tendermint multisig public key
+// encodes its keys with a codec only knowing the Tendermint key types.
+package multisig
+
+import (
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	tmmultisig "github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+// PubKeyAminoName is the Amino name of the multisig public key.
+const PubKeyAminoName = "cosmos-sdk/PubKeyMultisigThreshold"
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cryptoamino.RegisterAmino(cdc)
+	secp256r1.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+
+	// register the key with the Tendermint codec so that Bech32 encoded keys can
+	// be decoded with PubKeyFromBytes
+	cryptoamino.RegisterKeyType(PubKeyMultisigThreshold{}, PubKeyAminoName)
+}
+
+// RegisterCodec registers the multisig public key on the given codec.
+func RegisterCodec(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeyMultisigThreshold{}, PubKeyAminoName, nil)
+}
+
+// PubKeyMultisigThreshold implements a K of N threshold multisig. Signatures are
+// Tendermint multisignatures.
+type PubKeyMultisigThreshold struct {
+	K       uint            `json:"threshold"`
+	PubKeys []crypto.PubKey `json:"pubkeys"`
+}
+
+var _ crypto.PubKey = PubKeyMultisigThreshold{}
+
+// NewPubKeyMultisigThreshold returns a K of N threshold multisig public key. The
+// Tendermint multisig public key is returned if it can hold all the keys, so
+// that the addresses of such multisig keys are unchanged. It panics if
+// len(pubkeys) < k or 0 >= k.
+func NewPubKeyMultisigThreshold(k int, pubkeys []crypto.PubKey) crypto.PubKey {
+	pk := tmmultisig.NewPubKeyMultisigThreshold(k, pubkeys)
+
+	for _, pubkey := range pubkeys {
+		if !isTendermintPubKey(pubkey) {
+			return PubKeyMultisigThreshold{uint(k), pubkeys}
+		}
+	}
+
+	return pk
+}
+
+// isTendermintPubKey returns true if the Tendermint multisig public key can
+// encode the public key.
+func isTendermintPubKey(pubkey crypto.PubKey) bool {
+	switch pubkey.(type) {
+	case ed25519.PubKeyEd25519, secp256k1.PubKeySecp256k1, sr25519.PubKeySr25519, tmmultisig.PubKeyMultisigThreshold:
+		return true
+	default:
+		return false
+	}
+}
+
+// Threshold returns the threshold and the public keys of a Tendermint or SDK
+// multisig public key. It returns false if the public key is not a multisig
+// public key.
+func Threshold(pubkey crypto.PubKey) (uint, []crypto.PubKey, bool) {
+	switch pk := pubkey.(type) {
+	case tmmultisig.PubKeyMultisigThreshold:
+		return pk.K, pk.PubKeys, true
+	case PubKeyMultisigThreshold:
+		return pk.K, pk.PubKeys, true
+	default:
+		return 0, nil, false
+	}
+}
+
+// VerifyBytes expects sig to be an amino encoded Tendermint Multisignature.
+// It returns true iff the multisignature contains k or more signatures for the
+// corresponding keys and all signatures are valid.
+func (pk PubKeyMultisigThreshold) VerifyBytes(msg []byte, marshalledSig []byte) bool {
+	var sig tmmultisig.Multisignature
+	if err := cdc.UnmarshalBinaryBare(marshalledSig, &sig); err != nil {
+		return false
+	}
+	if sig.BitArray == nil {
+		return false
+	}
+
+	size := sig.BitArray.Size()
+	// ensure bit array is the correct size
+	if len(pk.PubKeys) != size {
+		return false
+	}
+	// ensure size of signature list
+	if len(sig.Sigs) < int(pk.K) || len(sig.Sigs) > size {
+		return false
+	}
+	// ensure at least k signatures are set
+	if sig.BitArray.NumTrueBitsBefore(size) < int(pk.K) {
+		return false
+	}
+
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if sig.BitArray.GetIndex(i) {
+			if !pk.PubKeys[i].VerifyBytes(msg, sig.Sigs[sigIndex]) {
+				return false
+			}
+			sigIndex++
+		}
+	}
+
+	return true
+}
+
+// Bytes returns the amino encoded public key.
+func (pk PubKeyMultisigThreshold) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pk)
+}
+
+// Address returns tmhash(PubKeyMultisigThreshold.Bytes()).
+func (pk PubKeyMultisigThreshold) Address() crypto.Address {
+	return crypto.AddressHash(pk.Bytes())
+}
+
+// Equals returns true iff pk and other both have the same threshold and the
+// same keys in the same order.
+func (pk PubKeyMultisigThreshold) Equals(other crypto.PubKey) bool {
+	otherKey, sameType := other.(PubKeyMultisigThreshold)
+	if !sameType {
+		return false
+	}
+	if pk.K != otherKey.K || len(pk.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+
+	for i := 0; i < len(pk.PubKeys); i++ {
+		if !pk.PubKeys[i].Equals(otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,93 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	tmmultisig "github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
+)
+
+func TestNewPubKeyMultisigThreshold(t *testing.T) {
+	k1 := secp256k1.GenPrivKey().PubKey()
+	k2 := secp256k1.GenPrivKey().PubKey()
+	r1 := secp256r1.GenPrivKey().PubKey()
+
+	// Tendermint keys keep their Tendermint multisig key and address
+	pk := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{k1, k2})
+	require.Equal(t, tmmultisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{k1, k2}), pk)
+
+	pk = multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{k1, r1})
+	require.Equal(t, multisig.PubKeyMultisigThreshold{K: 1, PubKeys: []crypto.PubKey{k1, r1}}, pk)
+	require.NotPanics(t, func() { pk.Address() })
+
+	require.Panics(t, func() { multisig.NewPubKeyMultisigThreshold(3, []crypto.PubKey{k1, r1}) })
+}
+
+func TestThreshold(t *testing.T) {
+	pks := []crypto.PubKey{secp256k1.GenPrivKey().PubKey(), secp256r1.GenPrivKey().PubKey()}
+
+	k, pubKeys, ok := multisig.Threshold(multisig.NewPubKeyMultisigThreshold(2, pks))
+	require.True(t, ok)
+	require.Equal(t, uint(2), k)
+	require.Equal(t, pks, pubKeys)
+
+	k, pubKeys, ok = multisig.Threshold(tmmultisig.NewPubKeyMultisigThreshold(1, pks[:1]))
+	require.True(t, ok)
+	require.Equal(t, uint(1), k)
+	require.Equal(t, pks[:1], pubKeys)
+
+	_, _, ok = multisig.Threshold(pks[0])
+	require.False(t, ok)
+}
+
+func TestVerifyBytes(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	privs := []crypto.PrivKey{secp256r1.GenPrivKey(), secp256k1.GenPrivKey(), secp256r1.GenPrivKey()}
+	pks := make([]crypto.PubKey, len(privs))
+	sigs := make([][]byte, len(privs))
+	for i, priv := range privs {
+		pks[i] = priv.PubKey()
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+	pk := multisig.NewPubKeyMultisigThreshold(2, pks)
+
+	sig := tmmultisig.NewMultisig(len(pks))
+	require.NoError(t, sig.AddSignatureFromPubKey(sigs[0], pks[0], pks))
+	require.False(t, pk.VerifyBytes(msg, sig.Marshal()), "threshold not met")
+
+	require.NoError(t, sig.AddSignatureFromPubKey(sigs[2], pks[2], pks))
+	require.True(t, pk.VerifyBytes(msg, sig.Marshal()))
+	require.False(t, pk.VerifyBytes([]byte{1, 2, 3}, sig.Marshal()))
+
+	// signatures must be in the order of the keys
+	sig.Sigs[0], sig.Sigs[1] = sig.Sigs[1], sig.Sigs[0]
+	require.False(t, pk.VerifyBytes(msg, sig.Marshal()))
+}
+
+func TestAminoEncoding(t *testing.T) {
+	pk := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{
+		secp256r1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	})
+
+	var decoded crypto.PubKey
+	require.NoError(t, codec.Cdc.UnmarshalBinaryBare(pk.Bytes(), &decoded))
+	require.True(t, pk.Equals(decoded))
+
+	decoded, err := cryptoamino.PubKeyFromBytes(pk.Bytes())
+	require.NoError(t, err)
+	require.True(t, pk.Equals(decoded))
+
+	bz, err := codec.Cdc.MarshalJSON(pk)
+	require.NoError(t, err)
+	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+}
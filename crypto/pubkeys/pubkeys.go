@@ -0,0 +1,46 @@
+// Package pubkeys is a registry of the public key types of account keys besides
+// the key types known to the ante handler, which identifies their signing
+// algorithm. The ante handler only accepts them if the auth params set the gas
+// cost of their signing algorithm. It only depends on the Tendermint crypto
+// interfaces so that the state machine can look public keys up without
+// depending on the keyring.
+package pubkeys
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// PubKeyType describes a public key type accepted for account keys.
+type PubKeyType struct {
+	// Name is the name of the signing algorithm of the keys.
+	Name string
+	// IsPubKey returns true if the public key is of this type.
+	IsPubKey func(crypto.PubKey) bool
+}
+
+var pubKeyTypes []PubKeyType
+
+// Register registers a public key type. Types must be registered from an init
+// function. It panics if a type with the same name is already registered.
+func Register(pkType PubKeyType) {
+	for _, t := range pubKeyTypes {
+		if t.Name == pkType.Name {
+			panic(fmt.Sprintf("public key type %s already registered", pkType.Name))
+		}
+	}
+
+	pubKeyTypes = append(pubKeyTypes, pkType)
+}
+
+// ByPubKey returns the registered type of the given public key.
+func ByPubKey(pubKey crypto.PubKey) (PubKeyType, bool) {
+	for _, t := range pubKeyTypes {
+		if t.IsPubKey(pubKey) {
+			return t, true
+		}
+	}
+
+	return PubKeyType{}, false
+}
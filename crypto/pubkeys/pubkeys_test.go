@@ -0,0 +1,32 @@
+package pubkeys_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/pubkeys"
+)
+
+func TestRegister(t *testing.T) {
+	pk := sr25519.GenPrivKey().PubKey()
+	_, ok := pubkeys.ByPubKey(pk)
+	require.False(t, ok)
+
+	pkType := pubkeys.PubKeyType{
+		Name: "sr25519",
+		IsPubKey: func(pubKey crypto.PubKey) bool {
+			_, ok := pubKey.(sr25519.PubKeySr25519)
+			return ok
+		},
+	}
+	pubkeys.Register(pkType)
+
+	got, ok := pubkeys.ByPubKey(pk)
+	require.True(t, ok)
+	require.Equal(t, "sr25519", got.Name)
+
+	require.Panics(t, func() { pubkeys.Register(pkType) })
+}
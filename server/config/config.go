@@ -36,6 +36,11 @@ type BaseConfig struct {
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
 	Pruning string `mapstructure:"pruning"`
+
+	// InvariantPolicies contains the invariant policies chosen by the node
+	// operator, as "<module>/<route>=<policy>" entries, which take precedence
+	// over the policies set by governance.
+	InvariantPolicies []string `mapstructure:"invariant-policies"`
 }
 
 // Config defines the server's top level configuration
@@ -74,9 +79,10 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig{
-			MinGasPrices:    defaultMinGasPrices,
-			InterBlockCache: true,
-			Pruning:         store.PruningStrategySyncable,
+			MinGasPrices:      defaultMinGasPrices,
+			InterBlockCache:   true,
+			Pruning:           store.PruningStrategySyncable,
+			InvariantPolicies: []string{},
 		},
 	}
 }
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +22,24 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestInvariantPoliciesConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := DefaultConfig()
+	cfg.InvariantPolicies = []string{"bank/total-supply=log-and-emit-event", "staking/supply=halt"}
+	path := filepath.Join(dir, "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.InvariantPolicies, v.GetStringSlice("invariant-policies"))
+
+	// no policies are overridden by default
+	WriteConfigFile(path, DefaultConfig())
+	require.NoError(t, v.ReadInConfig())
+	require.Empty(t, v.GetStringSlice("invariant-policies"))
+}
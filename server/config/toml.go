@@ -39,6 +39,12 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state
 pruning = "{{ .BaseConfig.Pruning }}"
+
+# InvariantPolicies overrides the policies of broken invariants set by governance,
+# as "<module>/<route>=<policy>" entries, e.g. ["bank/total-supply=log-and-emit-event"].
+# Policies other than halt change the state, so they must be coordinated between
+# validators, e.g. to restart a halted chain.
+invariant-policies = [{{ range $i, $p := .BaseConfig.InvariantPolicies }}{{ if $i }}, {{ end }}"{{ $p }}"{{ end }}]
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// PreUpgrader is implemented by the applications able to prepare the node for
+// the scheduled upgrade while it still runs the current binary, e.g. by running
+// the pre-upgrade handler of the upgrade module.
+type PreUpgrader interface {
+	PreUpgrade(cliCtx context.CLIContext) error
+}
+
+// PreUpgradeCmd runs the pre-upgrade handler of the upgrade plan scheduled on
+// the node with the application of this binary, created on an in-memory
+// database for the home directory of the node.
+func PreUpgradeCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preupgrade",
+		Short: "Run the pre-upgrade handler of the scheduled upgrade plan",
+		Long: `Query the currently scheduled upgrade plan from the node and run the pre-upgrade handler this binary
+registered for it, for example to check that enough disk space is available or to prepare data for the migrations of
+the upgrade. It is meant to be run with the binary of the upgrade while the node still runs the current binary, and
+fails if the binary has no handler for the upgrade or if the pre-upgrade handler fails.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode)); err != nil {
+				return err
+			}
+			return viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appCreator(ctx.Logger, dbm.NewMemDB(), nil)
+
+			upgrader, ok := app.(PreUpgrader)
+			if !ok {
+				return fmt.Errorf("the application does not support pre-upgrade handlers")
+			}
+
+			return upgrader.PreUpgrade(context.NewCLIContext().WithOutput(cmd.OutOrStdout()))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().Bool(flags.FlagTrustNode, true, "Trust connected full node (don't verify proofs for responses)")
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// preUpgradeApp is an application recording the context of its pre-upgrade.
+type preUpgradeApp struct {
+	abci.BaseApplication
+	cliCtx *context.CLIContext
+}
+
+func (app preUpgradeApp) PreUpgrade(cliCtx context.CLIContext) error {
+	*app.cliCtx = cliCtx
+	return errors.New("pre-upgrade failed")
+}
+
+func TestPreUpgradeCmd(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	var cliCtx context.CLIContext
+	appCreator := func(log.Logger, dbm.DB, io.Writer) abci.Application {
+		return preUpgradeApp{cliCtx: &cliCtx}
+	}
+
+	cmd := PreUpgradeCmd(NewDefaultContext(), appCreator)
+	require.False(t, viper.IsSet(flags.FlagNode), "the flags must not be bound when the command is created")
+
+	cmd.SetArgs([]string{"--node", "tcp://localhost:36657"})
+	require.EqualError(t, cmd.Execute(), "pre-upgrade failed")
+	require.Equal(t, "tcp://localhost:36657", cliCtx.NodeURI)
+	require.True(t, cliCtx.TrustNode)
+}
+
+func TestPreUpgradeCmdUnsupported(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	appCreator := func(log.Logger, dbm.DB, io.Writer) abci.Application {
+		return abci.NewBaseApplication()
+	}
+
+	cmd := PreUpgradeCmd(NewDefaultContext(), appCreator)
+	cmd.SetArgs([]string{})
+	require.EqualError(t, cmd.Execute(), "the application does not support pre-upgrade handlers")
+}
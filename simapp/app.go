@@ -10,14 +10,16 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitclient "github.com/cosmos/cosmos-sdk/x/circuit/client"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
@@ -32,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
 )
 
 const appName = "SimApp"
@@ -56,12 +59,14 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.ProposalHandler,
+			circuitclient.TripProposalHandler, circuitclient.ResetProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -111,10 +116,14 @@ type SimApp struct {
 	UpgradeKeeper  upgrade.Keeper
 	ParamsKeeper   params.Keeper
 	EvidenceKeeper evidence.Keeper
+	CircuitKeeper  circuit.Keeper
 
 	// the module manager
 	mm *module.Manager
 
+	// the configurator holding the in-place store migrations of the modules
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -137,6 +146,7 @@ func NewSimApp(
 		bam.MainStoreKey, auth.StoreKey, bank.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
+		crisis.StoreKey, circuit.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
@@ -161,53 +171,68 @@ func NewSimApp(
 	app.subspaces[crisis.ModuleName] = app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[evidence.ModuleName] = app.ParamsKeeper.Subspace(evidence.DefaultParamspace)
 
+	// the gov module account is the authority allowed to update the parameters
+	// of the modules storing them in their own store, gov executing the messages
+	// of the exec proposals that pass on its behalf
+	authority := supply.NewModuleAddress(gov.ModuleName)
+
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
-		appCodec, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount,
+		appCodec, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount, authority,
 	)
 	app.BankKeeper = bank.NewBaseKeeper(
 		appCodec, keys[bank.StoreKey], app.AccountKeeper, app.subspaces[bank.ModuleName], app.BlacklistedAccAddrs(),
+		authority,
 	)
 	app.SupplyKeeper = supply.NewKeeper(
 		appCodec, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms,
 	)
 	stakingKeeper := staking.NewKeeper(
 		appCodec, keys[staking.StoreKey], app.BankKeeper, app.SupplyKeeper, app.subspaces[staking.ModuleName],
+		authority,
 	)
 	app.MintKeeper = mint.NewKeeper(
 		appCodec, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &stakingKeeper,
-		app.SupplyKeeper, auth.FeeCollectorName,
+		app.SupplyKeeper, auth.FeeCollectorName, authority,
 	)
 	app.DistrKeeper = distr.NewKeeper(
 		appCodec, keys[distr.StoreKey], app.subspaces[distr.ModuleName], app.BankKeeper, &stakingKeeper,
-		app.SupplyKeeper, auth.FeeCollectorName, app.ModuleAccountAddrs(),
+		app.SupplyKeeper, auth.FeeCollectorName, app.ModuleAccountAddrs(), authority,
 	)
 	app.SlashingKeeper = slashing.NewKeeper(
 		appCodec, keys[slashing.StoreKey], &stakingKeeper, app.subspaces[slashing.ModuleName],
+		authority,
 	)
 	app.CrisisKeeper = crisis.NewKeeper(
-		app.subspaces[crisis.ModuleName], invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName,
+		appCodec, keys[crisis.StoreKey], app.subspaces[crisis.ModuleName], invCheckPeriod,
+		app.SupplyKeeper, auth.FeeCollectorName, authority,
 	)
 	app.UpgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], appCodec, homePath)
+	app.CircuitKeeper = circuit.NewKeeper(appCodec, keys[circuit.StoreKey])
 
 	// create evidence keeper with router
 	evidenceKeeper := evidence.NewKeeper(
 		appCodec, keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &app.StakingKeeper, app.SlashingKeeper,
+		authority,
 	)
 	evidenceRouter := evidence.NewRouter()
 	// TODO: Register evidence routes.
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
+	msgFilter := sdk.ChainMsgFilters(app.CrisisKeeper.MsgFilter(), app.CircuitKeeper.MsgFilter())
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(circuit.RouterKey, circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper)).
+		AddRoute(gov.ExecRouterKey, gov.NewExecProposalHandler(app.Router(), msgFilter, authority))
 	app.GovKeeper = gov.NewKeeper(
 		appCodec, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, govRouter, authority,
 	)
 
 	// register the staking hooks
@@ -216,6 +241,18 @@ func NewSimApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// parameter change proposals targeting the subspaces of the modules storing
+	// their parameters in their own store are applied to these stores
+	app.ParamsKeeper.RegisterParamSetStore(auth.DefaultParamspace, app.AccountKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(bank.DefaultParamspace, app.BankKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(staking.DefaultParamspace, app.StakingKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(distr.DefaultParamspace, app.DistrKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(mint.DefaultParamspace, app.MintKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(slashing.DefaultParamspace, app.SlashingKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(evidence.DefaultParamspace, app.EvidenceKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(crisis.DefaultParamspace, app.CrisisKeeper)
+	app.ParamsKeeper.RegisterParamSetStore(gov.DefaultParamspace, app.GovKeeper)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -225,12 +262,13 @@ func NewSimApp(
 		crisis.NewAppModule(&app.CrisisKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.BankKeeper, app.AccountKeeper),
 		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper),
-		mint.NewAppModule(app.MintKeeper, app.SupplyKeeper),
+		mint.NewAppModule(app.MintKeeper, app.SupplyKeeper, nil),
 		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.StakingKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
+		circuit.NewAppModule(app.CircuitKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -244,12 +282,19 @@ func NewSimApp(
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
-		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName,
+		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName, circuit.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
+	// the params module is not part of the module manager, so its querier has
+	// to be registered explicitly
+	app.QueryRouter().AddRoute(params.QuerierRoute, params.NewQuerier(app.ParamsKeeper))
+
+	app.configurator = module.NewConfigurator(appCodec)
+	app.mm.RegisterMigrations(app.configurator)
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
@@ -259,7 +304,7 @@ func NewSimApp(
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.BankKeeper, app.AccountKeeper),
 		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper),
-		mint.NewAppModule(app.MintKeeper, app.SupplyKeeper),
+		mint.NewAppModule(app.MintKeeper, app.SupplyKeeper, nil),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.StakingKeeper),
 		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -275,7 +320,10 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(
+		NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.CircuitKeeper, auth.DefaultSigVerificationGasConsumer),
+	)
+	app.SetMsgFilter(msgFilter)
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -305,6 +353,7 @@ func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Re
 func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.cdc, genesisState)
 }
 
@@ -333,6 +382,25 @@ func (app *SimApp) BlacklistedAccAddrs() map[string]bool {
 	return blacklistedAddrs
 }
 
+// SetInvariantPolicyOverrides sets the invariant policies chosen by the node
+// operator, e.g. from the server.FlagInvariantPolicies flag or the
+// invariant-policies option of app.toml, as "<module>/<route>=<policy>" entries.
+func (app *SimApp) SetInvariantPolicyOverrides(entries []string) error {
+	overrides, err := crisis.ParseInvariantPolicyOverrides(entries)
+	if err != nil {
+		return err
+	}
+
+	app.CrisisKeeper.SetInvariantPolicyOverrides(overrides)
+	return nil
+}
+
+// PreUpgrade runs the pre-upgrade handler of the upgrade plan scheduled on the
+// node of the CLIContext. It implements server.PreUpgrader.
+func (app *SimApp) PreUpgrade(cliCtx context.CLIContext) error {
+	return upgradecli.RunPreUpgrade(cliCtx.WithCodec(app.cdc), app.UpgradeKeeper)
+}
+
 // Codec returns SimApp's codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
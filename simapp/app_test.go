@@ -9,6 +9,12 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -50,3 +56,68 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestSetInvariantPolicyOverrides(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	require.NoError(t, app.SetInvariantPolicyOverrides([]string{"bank/total-supply=log-and-emit-event"}))
+	require.Equal(t, crisis.PolicyLogAndEmitEvent, app.CrisisKeeper.GetInvariantPolicy(ctx, "bank/total-supply"))
+
+	require.Error(t, app.SetInvariantPolicyOverrides([]string{"bank/total-supply"}))
+	require.Error(t, app.SetInvariantPolicyOverrides([]string{"bank/total-supply=unknown"}))
+}
+
+func TestExecProposal(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	authority := app.GovKeeper.GetAuthority()
+
+	content, err := std.NewExecProposal("title", "description", bank.NewMsgUpdateParams(authority, bank.NewParams(false)))
+	require.NoError(t, err)
+	require.NoError(t, content.ValidateBasic())
+
+	// the proposal can be stored and submitted through an Amino encoded message
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+	require.NoError(t, err)
+	stored, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, content.GetMsgs(), stored.Content.(gov.ExecContent).GetMsgs())
+
+	var msg gov.MsgSubmitProposal
+	bz := app.Codec().MustMarshalJSON(gov.NewMsgSubmitProposal(content, nil, authority))
+	require.NoError(t, app.Codec().UnmarshalJSON(bz, &msg))
+	require.Equal(t, content.GetMsgs(), msg.Content.(gov.ExecContent).GetMsgs())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+	require.Equal(t, content.GetMsgs(), msg.GetNestedMsgs())
+	submit, err := std.NewMsgSubmitProposal(content, nil, authority)
+	require.NoError(t, err)
+	require.Equal(t, content.GetMsgs(), submit.GetNestedMsgs())
+	require.Nil(t, gov.NewMsgSubmitProposal(gov.NewTextProposal("title", "description"), nil, authority).GetNestedMsgs())
+
+	// the messages are executed on behalf of the gov module account
+	handler := app.GovKeeper.Router().GetRoute(gov.ExecRouterKey)
+	require.NoError(t, handler(ctx, content))
+	require.False(t, app.BankKeeper.GetSendEnabled(ctx))
+
+	other := sdk.AccAddress([]byte("addr1_______________"))
+	content, err = std.NewExecProposal("title", "description", bank.NewMsgUpdateParams(other, bank.DefaultParams()))
+	require.NoError(t, err)
+	require.Error(t, handler(ctx, content))
+	require.False(t, app.BankKeeper.GetSendEnabled(ctx))
+
+	_, err = std.NewExecProposal("title", "description", bank.NewMsgSend(authority, other, nil))
+	require.Error(t, err)
+
+	// the messages disabled for transactions are rejected
+	content, err = std.NewExecProposal("title", "description", bank.NewMsgUpdateParams(authority, bank.DefaultParams()))
+	require.NoError(t, err)
+	app.CircuitKeeper.TripCircuitBreaker(ctx, []string{"bank/update_params"})
+	require.True(t, circuit.ErrMsgTypeDisabled.Is(handler(ctx, content)))
+	require.False(t, app.BankKeeper.GetSendEnabled(ctx))
+
+	app.CircuitKeeper.ResetCircuitBreaker(ctx, []string{"bank/update_params"})
+	app.CrisisKeeper.DisableModuleMsgs(ctx, bank.ModuleName)
+	require.True(t, crisis.ErrModuleMsgsDisabled.Is(handler(ctx, content)))
+	require.False(t, app.BankKeeper.GetSendEnabled(ctx))
+}
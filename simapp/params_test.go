@@ -0,0 +1,216 @@
+package simapp
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// moduleParamStore is implemented by the keepers of the modules storing their
+// parameters in their own store.
+type moduleParamStore interface {
+	paramtypes.ParamSetStore
+
+	MigrateParams(ctx sdk.Context) error
+}
+
+type otherParams struct{}
+
+func (p *otherParams) ParamSetPairs() paramtypes.ParamSetPairs { return nil }
+
+func requireParamsEqual(t *testing.T, expected, actual paramtypes.ParamSet) {
+	t.Helper()
+
+	// compare the encoded parameters as the sdk.Dec values are not comparable
+	exp, err := expected.(codec.ProtoMarshaler).Marshal()
+	require.NoError(t, err)
+	act, err := actual.(codec.ProtoMarshaler).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, exp, act)
+}
+
+func TestModuleParams(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	authParams := authtypes.DefaultParams()
+	authParams.TxSigLimit = 10
+	authInvalid := authParams
+	authInvalid.TxSigLimit = 0
+
+	bankParams := banktypes.NewParams(false)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.MaxValidators = 50
+	stakingInvalid := stakingParams
+	stakingInvalid.BondDenom = ""
+
+	slashingParams := slashingtypes.DefaultParams()
+	slashingParams.SignedBlocksWindow = 1000
+	slashingInvalid := slashingParams
+	slashingInvalid.SlashFractionDowntime = sdk.NewDec(2)
+
+	distrParams := distrtypes.DefaultParams()
+	distrParams.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	distrInvalid := distrParams
+	distrInvalid.CommunityTax = sdk.NewDec(-1)
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.BlocksPerYear = 1000
+	mintInvalid := mintParams
+	mintInvalid.MintDenom = ""
+
+	crisisParams := crisistypes.DefaultParams()
+	crisisParams.ConstantFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)
+	crisisParams.DisabledModules = []string{banktypes.ModuleName}
+	crisisInvalid := crisisParams
+	crisisInvalid.DisabledModules = []string{crisistypes.ModuleName}
+
+	evidenceParams := evidencetypes.DefaultParams()
+	evidenceParams.MaxAgeNumBlocks = 1000
+	evidenceInvalid := evidenceParams
+	evidenceInvalid.MaxAgeNumBlocks = 0
+
+	govParams := govtypes.DefaultParams()
+	govParams.VotingParams = govtypes.NewVotingParams(time.Hour)
+	govInvalid := govParams
+	govInvalid.VotingParams = govtypes.NewVotingParams(0)
+
+	testCases := []struct {
+		module    string
+		storeKey  string
+		paramsKey []byte
+		keeper    moduleParamStore
+		authority sdk.AccAddress
+		update    func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error
+		params    paramtypes.ModuleParamSet
+		invalid   paramtypes.ModuleParamSet
+	}{
+		{
+			authtypes.ModuleName, authtypes.StoreKey, authtypes.ParamsKey,
+			app.AccountKeeper, app.AccountKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.AccountKeeper.UpdateParams(ctx, authority, *ps.(*authtypes.Params))
+			},
+			&authParams, &authInvalid,
+		},
+		{
+			banktypes.ModuleName, banktypes.StoreKey, banktypes.ParamsKey,
+			app.BankKeeper, app.BankKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.BankKeeper.UpdateParams(ctx, authority, *ps.(*banktypes.Params))
+			},
+			&bankParams, nil,
+		},
+		{
+			stakingtypes.ModuleName, stakingtypes.StoreKey, stakingtypes.ParamsKey,
+			app.StakingKeeper, app.StakingKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.StakingKeeper.UpdateParams(ctx, authority, *ps.(*stakingtypes.Params))
+			},
+			&stakingParams, &stakingInvalid,
+		},
+		{
+			slashingtypes.ModuleName, slashingtypes.StoreKey, slashingtypes.ParamsKey,
+			app.SlashingKeeper, app.SlashingKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.SlashingKeeper.UpdateParams(ctx, authority, *ps.(*slashingtypes.Params))
+			},
+			&slashingParams, &slashingInvalid,
+		},
+		{
+			distrtypes.ModuleName, distrtypes.StoreKey, distrtypes.ParamsKey,
+			app.DistrKeeper, app.DistrKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.DistrKeeper.UpdateParams(ctx, authority, *ps.(*distrtypes.Params))
+			},
+			&distrParams, &distrInvalid,
+		},
+		{
+			minttypes.ModuleName, minttypes.StoreKey, minttypes.ParamsKey,
+			app.MintKeeper, app.MintKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.MintKeeper.UpdateParams(ctx, authority, *ps.(*minttypes.Params))
+			},
+			&mintParams, &mintInvalid,
+		},
+		{
+			crisistypes.ModuleName, crisistypes.StoreKey, crisistypes.ParamsKey,
+			app.CrisisKeeper, app.CrisisKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.CrisisKeeper.UpdateParams(ctx, authority, *ps.(*crisistypes.Params))
+			},
+			&crisisParams, &crisisInvalid,
+		},
+		{
+			evidencetypes.ModuleName, evidencetypes.StoreKey, evidencetypes.ParamsKey,
+			app.EvidenceKeeper, app.EvidenceKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.EvidenceKeeper.UpdateParams(ctx, authority, *ps.(*evidencetypes.Params))
+			},
+			&evidenceParams, &evidenceInvalid,
+		},
+		{
+			govtypes.ModuleName, govtypes.StoreKey, govtypes.ParamsKey,
+			app.GovKeeper, app.GovKeeper.GetAuthority(),
+			func(ctx sdk.Context, authority sdk.AccAddress, ps paramtypes.ParamSet) error {
+				return app.GovKeeper.UpdateParams(ctx, authority, *ps.(*govtypes.Params))
+			},
+			&govParams, &govInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.module, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			store := ctx.KVStore(app.GetKey(tc.storeKey))
+			defaults := tc.keeper.GetParamSet(ctx)
+
+			// simulate a chain whose params are still stored in the x/params subspace
+			app.GetSubspace(tc.module).SetParamSet(ctx, tc.params)
+			store.Delete(tc.paramsKey)
+			requireParamsEqual(t, tc.params, tc.keeper.GetParamSet(ctx))
+
+			require.NoError(t, tc.keeper.MigrateParams(ctx))
+			require.True(t, store.Has(tc.paramsKey))
+			requireParamsEqual(t, tc.params, tc.keeper.GetParamSet(ctx))
+
+			// the migration is a no-op once the params have been migrated
+			require.NoError(t, tc.keeper.SetParamSet(ctx, defaults))
+			require.NoError(t, tc.keeper.MigrateParams(ctx))
+			requireParamsEqual(t, defaults, tc.keeper.GetParamSet(ctx))
+
+			require.Error(t, tc.keeper.SetParamSet(ctx, &otherParams{}))
+			if tc.invalid != nil {
+				require.Error(t, tc.keeper.SetParamSet(ctx, tc.invalid))
+				require.Error(t, tc.update(ctx, tc.authority, tc.invalid))
+				requireParamsEqual(t, defaults, tc.keeper.GetParamSet(ctx))
+			}
+
+			err := tc.update(ctx, sdk.AccAddress([]byte("addr1_______________")), tc.params)
+			require.True(t, errors.Is(err, sdkerrors.ErrUnauthorized))
+			requireParamsEqual(t, defaults, tc.keeper.GetParamSet(ctx))
+
+			require.NoError(t, tc.update(ctx, tc.authority, tc.params))
+			requireParamsEqual(t, tc.params, tc.keeper.GetParamSet(ctx))
+		})
+	}
+}
@@ -46,9 +46,12 @@ type StoreUpgrades struct {
 
 // UpgradeInfo defines height and name of the upgrade
 // to ensure multistore upgrades happen only at matching height.
+// Info holds the upgrade plan info, which process supervisors
+// may use to locate the upgraded binary.
 type UpgradeInfo struct {
 	Name   string `json:"name"`
 	Height int64  `json:"height"`
+	Info   string `json:"info,omitempty"`
 }
 
 // StoreRename defines a name change of a sub-store.
@@ -9,6 +9,7 @@ import (
 	context "github.com/cosmos/cosmos-sdk/client/context"
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	types "github.com/cosmos/cosmos-sdk/types"
+	module "github.com/cosmos/cosmos-sdk/types/module"
 	gomock "github.com/golang/mock/gomock"
 	mux "github.com/gorilla/mux"
 	cobra "github.com/spf13/cobra"
@@ -516,3 +517,52 @@ func (mr *MockAppModuleMockRecorder) EndBlock(arg0, arg1 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndBlock", reflect.TypeOf((*MockAppModule)(nil).EndBlock), arg0, arg1)
 }
+
+// ConsensusVersion mocks base method
+func (m *MockAppModule) ConsensusVersion() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsensusVersion")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// ConsensusVersion indicates an expected call of ConsensusVersion
+func (mr *MockAppModuleMockRecorder) ConsensusVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsensusVersion", reflect.TypeOf((*MockAppModule)(nil).ConsensusVersion))
+}
+
+// MockHasMigrations is a mock of HasMigrations interface
+type MockHasMigrations struct {
+	ctrl     *gomock.Controller
+	recorder *MockHasMigrationsMockRecorder
+}
+
+// MockHasMigrationsMockRecorder is the mock recorder for MockHasMigrations
+type MockHasMigrationsMockRecorder struct {
+	mock *MockHasMigrations
+}
+
+// NewMockHasMigrations creates a new mock instance
+func NewMockHasMigrations(ctrl *gomock.Controller) *MockHasMigrations {
+	mock := &MockHasMigrations{ctrl: ctrl}
+	mock.recorder = &MockHasMigrationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockHasMigrations) EXPECT() *MockHasMigrationsMockRecorder {
+	return m.recorder
+}
+
+// RegisterMigrations mocks base method
+func (m *MockHasMigrations) RegisterMigrations(arg0 module.Configurator) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterMigrations", arg0)
+}
+
+// RegisterMigrations indicates an expected call of RegisterMigrations
+func (mr *MockHasMigrationsMockRecorder) RegisterMigrations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMigrations", reflect.TypeOf((*MockHasMigrations)(nil).RegisterMigrations), arg0)
+}
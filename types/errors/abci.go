@@ -43,12 +43,15 @@ func ABCIInfo(err error, debug bool) (codespace string, code uint32, log string)
 }
 
 // ResponseCheckTx returns an ABCI ResponseCheckTx object with fields filled in
-// from the given error and gas values.
+// from the given error and gas values. The data of the response is the ABCI
+// data of the error, if any, which gives the details of a rejected transaction
+// to its sender.
 func ResponseCheckTx(err error, gw, gu uint64) abci.ResponseCheckTx {
 	space, code, log := ABCIInfo(err, false)
 	return abci.ResponseCheckTx{
 		Codespace: space,
 		Code:      code,
+		Data:      abciData(err),
 		Log:       log,
 		GasWanted: int64(gw),
 		GasUsed:   int64(gu),
@@ -114,6 +117,28 @@ func abciCode(err error) uint32 {
 	}
 }
 
+type dataer interface {
+	ABCIData() []byte
+}
+
+// abciData returns the ABCI data of the first error of the causer chain of the
+// given error which provides it.
+func abciData(err error) []byte {
+	for !errIsNil(err) {
+		if d, ok := err.(dataer); ok {
+			return d.ABCIData()
+		}
+
+		c, ok := err.(causer)
+		if !ok {
+			return nil
+		}
+		err = c.Cause()
+	}
+
+	return nil
+}
+
 type codespacer interface {
 	Codespace() string
 }
@@ -7,6 +7,10 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// MsgFilter rejects messages before they are routed to their handler, e.g.
+// messages of modules disabled by governance, by returning an error.
+type MsgFilter func(ctx Context, msg Msg) error
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
@@ -64,3 +68,21 @@ type Terminator struct{}
 func (t Terminator) AnteHandle(ctx Context, _ Tx, _ bool, _ AnteHandler) (Context, error) {
 	return ctx, nil
 }
+
+// ChainMsgFilters chains MsgFilters together into a single MsgFilter which
+// rejects a message as soon as one of the filters rejects it. Nil filters are
+// skipped.
+func ChainMsgFilters(filters ...MsgFilter) MsgFilter {
+	return func(ctx Context, msg Msg) error {
+		for _, filter := range filters {
+			if filter == nil {
+				continue
+			}
+			if err := filter(ctx, msg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -30,6 +30,7 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -57,6 +58,14 @@ type AppModuleBasic interface {
 	GetQueryCmd(*codec.Codec) *cobra.Command
 }
 
+// AppModuleBasicVerifiedQueries is implemented by the AppModuleBasics whose
+// custom queries can be answered by store reads verified by the light client.
+type AppModuleBasicVerifiedQueries interface {
+	// VerifiedQueriers returns the verified queriers of the custom queries of
+	// the module by their paths, e.g. custom/bank/balance.
+	VerifiedQueriers() map[string]context.VerifiedQuerier
+}
+
 // BasicManager is a collection of AppModuleBasic
 type BasicManager map[string]AppModuleBasic
 
@@ -97,8 +106,22 @@ func (bm BasicManager) ValidateGenesis(cdc codec.JSONMarshaler, genesis map[stri
 	return nil
 }
 
+// RegisterVerifiedQueriers registers the verified queriers of all modules with
+// the client context. It is called when the commands and rest routes of the
+// modules are added.
+func (bm BasicManager) RegisterVerifiedQueriers() {
+	for _, b := range bm {
+		if vq, ok := b.(AppModuleBasicVerifiedQueries); ok {
+			for path, querier := range vq.VerifiedQueriers() {
+				context.RegisterVerifiedQuerier(path, querier)
+			}
+		}
+	}
+}
+
 // RegisterRESTRoutes registers all module rest routes
 func (bm BasicManager) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	bm.RegisterVerifiedQueriers()
 	for _, b := range bm {
 		b.RegisterRESTRoutes(ctx, rtr)
 	}
@@ -106,6 +129,7 @@ func (bm BasicManager) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Route
 
 // AddTxCommands adds all tx commands to the rootTxCmd
 func (bm BasicManager) AddTxCommands(rootTxCmd *cobra.Command, cdc *codec.Codec) {
+	bm.RegisterVerifiedQueriers()
 	for _, b := range bm {
 		if cmd := b.GetTxCmd(cdc); cmd != nil {
 			rootTxCmd.AddCommand(cmd)
@@ -115,6 +139,7 @@ func (bm BasicManager) AddTxCommands(rootTxCmd *cobra.Command, cdc *codec.Codec)
 
 // AddQueryCommands adds all query commands to the rootQueryCmd
 func (bm BasicManager) AddQueryCommands(rootQueryCmd *cobra.Command, cdc *codec.Codec) {
+	bm.RegisterVerifiedQueriers()
 	for _, b := range bm {
 		if cmd := b.GetQueryCmd(cdc); cmd != nil {
 			rootQueryCmd.AddCommand(cmd)
@@ -148,6 +173,17 @@ type AppModule interface {
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
+
+	// ConsensusVersion is a sequence number for state-breaking changes of the
+	// module. It must be incremented on each consensus-breaking change
+	// introduced by the module, starting from 1.
+	ConsensusVersion() uint64
+}
+
+// HasMigrations is the interface for modules that register in-place store
+// migrations between their consensus versions.
+type HasMigrations interface {
+	RegisterMigrations(Configurator)
 }
 
 //___________________________
@@ -187,6 +223,9 @@ func (GenesisOnlyAppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []ab
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion returns the initial consensus version
+func (GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
+
 //____________________________________________________________________________
 
 // Manager defines a module manager that provides the high level utility for managing and executing
@@ -197,6 +236,7 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	OrderMigrations    []string
 }
 
 // NewManager creates a new Manager object
@@ -215,6 +255,7 @@ func NewManager(modules ...AppModule) *Manager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		OrderMigrations:    modulesStr,
 	}
 }
 
@@ -238,6 +279,11 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// SetOrderMigrations sets the order of in-place store migrations
+func (m *Manager) SetOrderMigrations(moduleNames ...string) {
+	m.OrderMigrations = moduleNames
+}
+
 // RegisterInvariants registers all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -257,6 +303,85 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
+// RegisterMigrations registers the in-place store migrations of all modules
+// implementing HasMigrations
+func (m *Manager) RegisterMigrations(cfg Configurator) {
+	for _, module := range m.Modules {
+		if module, ok := module.(HasMigrations); ok {
+			module.RegisterMigrations(cfg)
+		}
+	}
+}
+
+// GetVersionMap gets the consensus version of all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vm := make(VersionMap)
+	for name, module := range m.Modules {
+		vm[name] = module.ConsensusVersion()
+	}
+
+	return vm
+}
+
+// RunMigrations performs the in-place store migrations of all modules, in the
+// order set by SetOrderMigrations. It is meant to be called from an upgrade
+// handler with the version map stored by x/upgrade and returns the version map
+// to store once the upgrade is applied.
+//
+// Each module is migrated from its version in fromVM to its ConsensusVersion by
+// running the migrations it registered through the Configurator. Modules
+// missing from fromVM are considered new: their default genesis state is
+// initialized instead. Modules already running on chain must therefore be
+// present in fromVM, which is the case for chains whose version map was stored
+// at genesis. Chains upgrading from a binary that didn't store it must build
+// the initial version map themselves in their upgrade handler.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, fmt.Errorf("expected the configurator to be created by NewConfigurator, got %T", cfg)
+	}
+
+	ordered := make(map[string]bool, len(m.OrderMigrations))
+	for _, moduleName := range m.OrderMigrations {
+		ordered[moduleName] = true
+	}
+	for moduleName := range m.Modules {
+		if !ordered[moduleName] {
+			return nil, fmt.Errorf("module %s is missing from the migrations order", moduleName)
+		}
+	}
+
+	updatedVM := make(VersionMap)
+	for _, moduleName := range m.OrderMigrations {
+		module := m.Modules[moduleName]
+		toVersion := module.ConsensusVersion()
+
+		fromVersion, exists := fromVM[moduleName]
+		switch {
+		case !exists:
+			// the module is new, initialize its default genesis state
+			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+			if len(moduleValUpdates) > 0 {
+				return nil, fmt.Errorf("module %s returned validator updates when added by an upgrade", moduleName)
+			}
+
+		case fromVersion > toVersion:
+			return nil, fmt.Errorf(
+				"module %s cannot be downgraded from version %d to version %d", moduleName, fromVersion, toVersion,
+			)
+
+		default:
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
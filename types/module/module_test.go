@@ -118,6 +118,10 @@ func TestManagerOrderSetters(t *testing.T) {
 	require.Equal(t, []string{"module1", "module2"}, mm.OrderEndBlockers)
 	mm.SetOrderEndBlockers("module2", "module1")
 	require.Equal(t, []string{"module2", "module1"}, mm.OrderEndBlockers)
+
+	require.Equal(t, []string{"module1", "module2"}, mm.OrderMigrations)
+	mm.SetOrderMigrations("module2", "module1")
+	require.Equal(t, []string{"module2", "module1"}, mm.OrderMigrations)
 }
 
 func TestManager_RegisterInvariants(t *testing.T) {
@@ -265,3 +269,77 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{abci.ValidatorUpdate{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	cfg := module.NewConfigurator(codec.New())
+	noop := func(sdk.Context) error { return nil }
+
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cdc, ctx := codec.New(), sdk.Context{}
+	cfg := module.NewConfigurator(cdc)
+
+	var migrated []uint64
+	for _, version := range []uint64{1, 2} {
+		version := version
+		require.NoError(t, cfg.RegisterMigration("module1", version, func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}))
+	}
+
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, mm.GetVersionMap())
+
+	// module1 is migrated in order, module2 is new and gets its default genesis
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// up to date modules are not migrated
+	migrated = nil
+	vm, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Empty(t, migrated)
+
+	// modules cannot be downgraded
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 4, "module2": 1})
+	require.Error(t, err)
+
+	// migrations must be registered for every version
+	_, err = mm.RunMigrations(ctx, module.NewConfigurator(cdc), module.VersionMap{"module1": 1, "module2": 1})
+	require.Error(t, err)
+
+	// failing migrations fail the run
+	failing := module.NewConfigurator(cdc)
+	require.NoError(t, failing.RegisterMigration("module1", 2, func(sdk.Context) error { return errFoo }))
+	_, err = mm.RunMigrations(ctx, failing, module.VersionMap{"module1": 2, "module2": 1})
+	require.True(t, errors.Is(err, errFoo))
+
+	// every module must be in the migrations order
+	mm.SetOrderMigrations("module1")
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 3, "module2": 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"sync"
+
+	"github.com/gorilla/mux"
+)
+
+// RouteDoc documents a REST route. The OpenAPI document of the REST server is
+// generated from the documentation of its routes. The types of the request and
+// response bodies are given by values of these types, e.g. sdk.Coins{}.
+type RouteDoc struct {
+	// Summary is a short description of the route.
+	Summary string
+	// Description is an optional longer description of the route.
+	Description string
+	// QueryParams documents the query parameters of the route. The parameters of
+	// the path are documented from the path template of the route.
+	QueryParams []QueryParam
+	// Request is a value of the type of the JSON body of the requests.
+	Request interface{}
+	// Result is a value of the type of the result of the responses, which are
+	// wrapped with their height by PostProcessResponse.
+	Result interface{}
+	// Response is a value of the type of the bare JSON body of the responses.
+	Response interface{}
+}
+
+// QueryParam documents a query parameter of a REST route.
+type QueryParam struct {
+	Name        string
+	Description string
+	// Type is the JSON schema type of the parameter, a string by default.
+	Type     string
+	Required bool
+}
+
+// Query parameters shared by REST routes.
+var (
+	HeightParam = QueryParam{Name: "height", Description: "height of the state to query", Type: "integer"}
+	PageParam   = QueryParam{Name: "page", Description: "page of the results, from 1", Type: "integer"}
+	LimitParam  = QueryParam{Name: "limit", Description: "maximum number of results of a page", Type: "integer"}
+)
+
+var (
+	routeDocsMtx sync.RWMutex
+	routeDocs    = make(map[*mux.Route]RouteDoc)
+)
+
+// Document attaches the documentation of a REST route to it and returns the
+// route, e.g.
+//
+//	rest.Document(r.HandleFunc("/bank/balances/{address}", handler).Methods("GET"), rest.RouteDoc{
+//		Summary: "Get the balances of an account",
+//		Result:  sdk.Coins{},
+//	})
+func Document(route *mux.Route, doc RouteDoc) *mux.Route {
+	routeDocsMtx.Lock()
+	defer routeDocsMtx.Unlock()
+
+	routeDocs[route] = doc
+	return route
+}
+
+// GetRouteDoc returns the documentation attached to a REST route.
+func GetRouteDoc(route *mux.Route) (RouteDoc, bool) {
+	routeDocsMtx.RLock()
+	defer routeDocsMtx.RUnlock()
+
+	doc, ok := routeDocs[route]
+	return doc, ok
+}
@@ -28,6 +28,30 @@ type Msg interface {
 	GetSigners() []AccAddress
 }
 
+// MsgWithNestedMsgs must be fulfilled by the messages which execute other
+// messages, for the nested messages to be subject to the same checks, e.g. by
+// the message filters, as the messages of the transaction.
+type MsgWithNestedMsgs interface {
+	Msg
+
+	// Returns the messages executed by the message.
+	GetNestedMsgs() []Msg
+}
+
+// FlattenMsgs returns the given messages along with the messages nested in
+// them, recursively, in depth-first order.
+func FlattenMsgs(msgs []Msg) []Msg {
+	flattened := make([]Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		flattened = append(flattened, msg)
+		if nested, ok := msg.(MsgWithNestedMsgs); ok {
+			flattened = append(flattened, FlattenMsgs(nested.GetNestedMsgs())...)
+		}
+	}
+
+	return flattened
+}
+
 //__________________________________________________________
 
 // Transactions objects must fulfill the Tx
@@ -14,12 +14,16 @@ const (
 	StoreKey                      = types.StoreKey
 	FeeCollectorName              = types.FeeCollectorName
 	QuerierRoute                  = types.QuerierRoute
+	RouterKey                     = types.RouterKey
+	AttributeValueCategory        = types.AttributeValueCategory
+	TypeMsgUpdateParams           = types.TypeMsgUpdateParams
 	DefaultParamspace             = types.DefaultParamspace
 	DefaultMaxMemoCharacters      = types.DefaultMaxMemoCharacters
 	DefaultTxSigLimit             = types.DefaultTxSigLimit
 	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
+	DefaultSigVerifyCostSecp256r1 = types.DefaultSigVerifyCostSecp256r1
 	QueryAccount                  = types.QueryAccount
 	QueryParams                   = types.QueryParams
 )
@@ -32,6 +36,7 @@ var (
 	DeductFees                        = ante.DeductFees
 	SetGasMeter                       = ante.SetGasMeter
 	NewAccountKeeper                  = keeper.NewAccountKeeper
+	NewMsgUpdateParams                = types.NewMsgUpdateParams
 	NewQuerier                        = keeper.NewQuerier
 	NewBaseAccount                    = types.NewBaseAccount
 	ProtoBaseAccount                  = types.ProtoBaseAccount
@@ -63,11 +68,13 @@ var (
 	ModuleCdc                 = types.ModuleCdc
 	AddressStoreKeyPrefix     = types.AddressStoreKeyPrefix
 	GlobalAccountNumberKey    = types.GlobalAccountNumberKey
+	ParamsKey                 = types.ParamsKey
 	KeyMaxMemoCharacters      = types.KeyMaxMemoCharacters
 	KeyTxSigLimit             = types.KeyTxSigLimit
 	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
 	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
 	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	KeySigVerifyCostSecp256r1 = types.KeySigVerifyCostSecp256r1
 )
 
 type (
@@ -78,6 +85,7 @@ type (
 	AccountRetriever                 = types.AccountRetriever
 	GenesisState                     = types.GenesisState
 	Params                           = types.Params
+	MsgUpdateParams                  = types.MsgUpdateParams
 	QueryAccountParams               = types.QueryAccountParams
 	StdSignMsg                       = types.StdSignMsg
 	StdTx                            = types.StdTx
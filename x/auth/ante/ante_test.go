@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -323,6 +325,68 @@ func TestAnteHandlerSequences(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+// Test that wrong sequences of new txs are reported with the expected sequence.
+func TestAnteHandlerWrongSequence(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetAccountNumber(0))
+	require.NoError(t, acc1.SetSequence(3))
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+	privs, accnums := []crypto.PrivKey{priv1}, []uint64{0}
+
+	// sequences are incremented on CheckTx, so that the txs following the
+	// ones in the mempool pass CheckTx
+	tx := types.NewTestTx(ctx, msgs, privs, accnums, []uint64{3}, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, uint64(4), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, []uint64{4}, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, uint64(5), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+
+	// a new tx signed with a wrong sequence reports the expected one
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, []uint64{1}, fee)
+	_, err := anteHandler(ctx, tx, false)
+	require.True(t, errors.Is(sdkerrors.ErrUnauthorized, err))
+	require.True(t, errors.Is(err, types.ErrWrongSequence))
+
+	res := sdkerrors.ResponseCheckTx(err, 0, 0)
+	require.Equal(t, types.ErrWrongSequence.Codespace(), res.Codespace)
+	require.Equal(t, types.ErrWrongSequence.ABCICode(), res.Code)
+
+	wrongSeq, ok := types.WrongSequenceFromResponse(sdk.TxResponse{
+		Codespace: res.Codespace, Code: res.Code, Data: strings.ToUpper(hex.EncodeToString(res.Data)),
+	})
+	require.True(t, ok)
+	require.Equal(t, types.NewWrongSequenceError(addr1, 5), wrongSeq)
+
+	// other invalid signatures report the expected sequence as well, which is
+	// the one their signer used
+	tx = types.NewTestTx(ctx.WithChainID("other-chain"), msgs, privs, accnums, []uint64{5}, fee)
+	_, err = anteHandler(ctx, tx, false)
+	require.True(t, errors.Is(sdkerrors.ErrUnauthorized, err))
+	require.Equal(t, types.NewWrongSequenceError(addr1, 5), err)
+
+	// wrong sequences are unauthorized in DeliverTx
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, []uint64{1}, fee)
+	_, err = anteHandler(ctx.WithIsCheckTx(false), tx, false)
+	require.True(t, errors.Is(sdkerrors.ErrUnauthorized, err))
+	require.False(t, errors.Is(err, types.ErrWrongSequence))
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), sdkerrors.ResponseDeliverTx(err, 0, 0).Code)
+}
+
 // Test logic around fee deduction.
 func TestAnteHandlerFees(t *testing.T) {
 	// setup
@@ -689,6 +753,30 @@ func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 }
 
 // Test custom SignatureVerificationGasConsumer
+func TestAnteHandlerSignatureAlgos(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+
+	// verify that ed25519 and secp256r1 accounts get accepted and their public keys set
+	for i, priv := range []crypto.PrivKey{ed25519.GenPrivKey(), secp256r1.GenPrivKey()} {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)))
+		app.AccountKeeper.SetAccount(ctx, acc)
+		require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, types.NewTestCoins()))
+
+		msgs := []sdk.Msg{types.NewTestMsg(addr)}
+		privs, accnums, seqs := []crypto.PrivKey{priv}, []uint64{uint64(i)}, []uint64{0}
+		tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, types.NewTestStdFee())
+		checkValidTx(t, anteHandler, ctx, tx, false)
+
+		acc = app.AccountKeeper.GetAccount(ctx, addr)
+		require.True(t, priv.PubKey().Equals(acc.GetPubKey()))
+	}
+}
+
 func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -781,9 +869,9 @@ func TestAnteHandlerReCheck(t *testing.T) {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostSecp256r1)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultSigVerifyCostSecp256r1)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
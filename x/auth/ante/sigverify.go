@@ -10,6 +10,9 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	sdkmultisig "github.com/cosmos/cosmos-sdk/crypto/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/pubkeys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -209,6 +212,12 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// verify signature
 		if !simulate && !pubKey.VerifyBytes(signBytes, sig) {
+			// report the sequence of the check state to the signers of a new
+			// tx, so that they may sign again if they signed another one
+			if ctx.IsCheckTx() {
+				return ctx, types.NewWrongSequenceError(signerAddrs[i], signerAccs[i].GetSequence())
+			}
+
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; verify correct account sequence and chain-id")
 		}
 	}
@@ -217,12 +226,10 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 }
 
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
-// Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
-// there is no need to execute IncrementSequenceDecorator on CheckTx or RecheckTX
-// since it is merely updating the nonce. As a result, this has the side effect
-// that subsequent and sequential txs orginating from the same account cannot be
-// handled correctly in a reliable way. To send sequential txs orginating from the
-// same account, it is recommended to instead use multiple messages in a tx.
+// Use the IncrementSequenceDecorator decorator to prevent replay attacks. The
+// sequences are also incremented on CheckTx and ReCheckTx, so that the check
+// state expects the sequence following the txs of an account in the mempool and
+// sequential txs orginating from the same account pass CheckTx.
 //
 // CONTRACT: The tx must implement the SigVerifiableTx interface.
 type IncrementSequenceDecorator struct {
@@ -236,11 +243,6 @@ func NewIncrementSequenceDecorator(ak keeper.AccountKeeper) IncrementSequenceDec
 }
 
 func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	// no need to increment sequence on CheckTx or RecheckTx
-	if ctx.IsCheckTx() && !simulate {
-		return next(ctx, tx, simulate)
-	}
-
 	sigTx, ok := tx.(SigVerifiableTx)
 	if !ok {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
@@ -295,7 +297,8 @@ func (vscd ValidateSigCountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 
 // DefaultSigVerificationGasConsumer is the default implementation of SignatureVerificationGasConsumer. It consumes gas
 // for signature verification based upon the public key type. The cost is fetched from the given params and is matched
-// by the concrete type.
+// by the concrete type. Public keys of any other signing algorithm registered in crypto/pubkeys consume the cost of the
+// algorithm in the SigVerifyCosts param, and are rejected if it has none.
 func DefaultSigVerificationGasConsumer(
 	meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params,
 ) error {
@@ -303,13 +306,17 @@ func DefaultSigVerificationGasConsumer(
 	switch pubkey := pubkey.(type) {
 	case ed25519.PubKeyEd25519:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "ED25519 public keys are unsupported")
+		return nil
 
 	case secp256k1.PubKeySecp256k1:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
-	case multisig.PubKeyMultisigThreshold:
+	case secp256r1.PubKeySecp256r1:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
+		return nil
+
+	case multisig.PubKeyMultisigThreshold, sdkmultisig.PubKeyMultisigThreshold:
 		var multisignature multisig.Multisignature
 		codec.Cdc.MustUnmarshalBinaryBare(sig, &multisignature)
 
@@ -317,21 +324,33 @@ func DefaultSigVerificationGasConsumer(
 		return nil
 
 	default:
+		// the public keys of the other registered signing algorithms are only
+		// accepted if the params set their gas cost
+		if pkType, ok := pubkeys.ByPubKey(pubkey); ok {
+			if cost, ok := params.GetSigVerifyCost(pkType.Name); ok {
+				meter.ConsumeGas(cost, "ante verify: "+pkType.Name)
+				return nil
+			}
+
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "no signature verification cost of %s public keys", pkType.Name)
+		}
+
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
 	}
 }
 
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
 func ConsumeMultisignatureVerificationGas(
-	meter sdk.GasMeter, sig multisig.Multisignature, pubkey multisig.PubKeyMultisigThreshold, params types.Params,
+	meter sdk.GasMeter, sig multisig.Multisignature, pubkey crypto.PubKey, params types.Params,
 ) {
 
+	_, pubKeys, _ := sdkmultisig.Threshold(pubkey)
 	size := sig.BitArray.Size()
 	sigIndex := 0
 
 	for i := 0; i < size; i++ {
 		if sig.BitArray.GetIndex(i) {
-			DefaultSigVerificationGasConsumer(meter, sig.Sigs[sigIndex], pubkey.PubKeys[i], params)
+			DefaultSigVerificationGasConsumer(meter, sig.Sigs[sigIndex], pubKeys[i], params)
 			sigIndex++
 		}
 	}
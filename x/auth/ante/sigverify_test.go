@@ -9,12 +9,27 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	sdkmultisig "github.com/cosmos/cosmos-sdk/crypto/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/pubkeys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+func init() {
+	// sr25519 keys are registered as the keys of a plugin signing algorithm
+	pubkeys.Register(pubkeys.PubKeyType{
+		Name: "sr25519",
+		IsPubKey: func(pubKey crypto.PubKey) bool {
+			_, ok := pubKey.(sr25519.PubKeySr25519)
+			return ok
+		},
+	})
+}
+
 func TestSetPubKey(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -67,6 +82,24 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		multisignature1.AddSignatureFromPubKey(sigSet1[i], pkSet1[i], pkSet1)
 	}
 
+	// multisig keys holding secp256r1 keys are SDK multisig keys
+	r1Priv := secp256r1.GenPrivKey()
+	r1Sig, err := r1Priv.Sign(msg)
+	require.NoError(t, err)
+	pkSet2 := append([]crypto.PubKey{r1Priv.PubKey()}, pkSet1[:2]...)
+	multisigKey2 := sdkmultisig.NewPubKeyMultisigThreshold(2, pkSet2)
+	require.IsType(t, sdkmultisig.PubKeyMultisigThreshold{}, multisigKey2)
+	multisignature2 := multisig.NewMultisig(len(pkSet2))
+	require.NoError(t, multisignature2.AddSignatureFromPubKey(r1Sig, pkSet2[0], pkSet2))
+	require.NoError(t, multisignature2.AddSignatureFromPubKey(sigSet1[0], pkSet2[1], pkSet2))
+	expectedCost2 := types.DefaultSigVerifyCostSecp256r1 + expectedGasCostByKeys(pkSet1[:1])
+
+	r1Params := types.DefaultParams()
+	r1Params.SigVerifyCostSecp256r1 = 1500
+
+	srParams := types.DefaultParams()
+	srParams.SigVerifyCosts = []types.SigVerifyCost{{Algo: "sr25519", Cost: 700}}
+
 	type args struct {
 		meter  sdk.GasMeter
 		sig    []byte
@@ -79,9 +112,14 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
+		{"PubKeySecp256r1 custom cost", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), r1Params}, 1500, false},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
+		{"Multisig with secp256r1", args{sdk.NewInfiniteGasMeter(), multisignature2.Marshal(), multisigKey2, params}, expectedCost2, false},
+		{"PubKeySr25519", args{sdk.NewInfiniteGasMeter(), nil, sr25519.GenPrivKey().PubKey(), srParams}, 700, false},
+		{"PubKeySr25519 without cost", args{sdk.NewInfiniteGasMeter(), nil, sr25519.GenPrivKey().PubKey(), params}, 0, true},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
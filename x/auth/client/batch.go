@@ -0,0 +1,349 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BatchConfig defines how BroadcastBatch packs messages into transactions and
+// broadcasts them.
+type BatchConfig struct {
+	// MsgsPerTx is the maximum number of messages of a transaction.
+	MsgsPerTx int
+	// SimulateGas estimates the gas of each transaction instead of using the
+	// gas of the TxBuilder.
+	SimulateGas bool
+	// MaxRetries is the number of times a transaction is broadcast again after
+	// a transient failure.
+	MaxRetries int
+	// RetryInterval is the time to wait before broadcasting again.
+	RetryInterval time.Duration
+	// MinBroadcastInterval is the minimum time between two broadcasts.
+	MinBroadcastInterval time.Duration
+}
+
+// BatchResult records the outcome of a batch transaction. Results are written
+// as JSON lines to the results file of a batch, which is used to resume the
+// batch without sending any message twice.
+type BatchResult struct {
+	Tx        int    `json:"tx"`
+	Msgs      []int  `json:"msgs"`
+	Sequence  uint64 `json:"sequence"`
+	GasWanted uint64 `json:"gas_wanted,omitempty"`
+	TxHash    string `json:"txhash,omitempty"`
+	Height    int64  `json:"height,omitempty"`
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Attempts  int    `json:"attempts"`
+}
+
+// Succeeded returns true if the transaction was accepted by the node.
+func (r BatchResult) Succeeded() bool {
+	return r.Error == "" && r.TxHash != ""
+}
+
+// ReadBatchResults reads the results of a batch from r.
+func ReadBatchResults(r io.Reader) ([]BatchResult, error) {
+	var results []BatchResult
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var res BatchResult
+		if err := json.Unmarshal(scanner.Bytes(), &res); err != nil {
+			return nil, fmt.Errorf("invalid batch result %d: %w", len(results), err)
+		}
+		results = append(results, res)
+	}
+
+	return results, scanner.Err()
+}
+
+// ReadBatchResultsFile reads the results of a batch from the given file. A
+// missing file holds no results.
+func ReadBatchResultsFile(filename string) ([]BatchResult, error) {
+	fp, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+
+	return ReadBatchResults(fp)
+}
+
+// PendingBatchMsgs returns the indexes of the messages which were not sent by
+// a successful transaction of the given results.
+func PendingBatchMsgs(numMsgs int, results []BatchResult) ([]int, error) {
+	sent := make([]bool, numMsgs)
+	for _, res := range results {
+		if !res.Succeeded() {
+			continue
+		}
+
+		for _, i := range res.Msgs {
+			if i < 0 || i >= numMsgs {
+				return nil, fmt.Errorf("transaction %d sent message %d of a batch of %d messages", res.Tx, i, numMsgs)
+			}
+			sent[i] = true
+		}
+	}
+
+	var pending []int
+	for i, ok := range sent {
+		if !ok {
+			pending = append(pending, i)
+		}
+	}
+
+	return pending, nil
+}
+
+// batchNode is the node a batch is broadcast to.
+type batchNode interface {
+	AccountNumberSequence(addr sdk.AccAddress) (uint64, uint64, error)
+	SimulateGas(txBldr authtypes.TxBuilder, msgs []sdk.Msg) (uint64, error)
+	BroadcastTx(txBytes []byte) (sdk.TxResponse, error)
+}
+
+type cliBatchNode struct {
+	cliCtx context.CLIContext
+}
+
+func (n cliBatchNode) AccountNumberSequence(addr sdk.AccAddress) (uint64, uint64, error) {
+	return authtypes.NewAccountRetriever(Codec, n.cliCtx).GetAccountNumberSequence(addr)
+}
+
+func (n cliBatchNode) SimulateGas(txBldr authtypes.TxBuilder, msgs []sdk.Msg) (uint64, error) {
+	_, adjusted, err := simulateMsgs(txBldr, n.cliCtx, msgs)
+	return adjusted, err
+}
+
+func (n cliBatchNode) BroadcastTx(txBytes []byte) (sdk.TxResponse, error) {
+	return n.cliCtx.BroadcastTx(txBytes)
+}
+
+// BroadcastBatch signs the given messages with the key of the CLI context in
+// transactions of at most cfg.MsgsPerTx messages and broadcasts them, skipping
+// the messages of the indexes which are not pending. The sequences are
+// allocated by the SequenceManager of the chain if the context manages
+// sequences, and by a SequenceManager of the batch otherwise. The result of
+// each transaction is passed to onResult, which may abort the batch by
+// returning an error.
+//
+// Transactions failing with a transport error or a full mempool are broadcast
+// again up to cfg.MaxRetries times, as are transactions rejected in CheckTx
+// for another sequence than the one expected by the node, which are signed
+// again with the next sequence of the SequenceManager. Other rejected
+// transactions, and transactions which failed once committed, are reported as
+// failed and the batch continues.
+func BroadcastBatch(
+	cliCtx context.CLIContext, txBldr authtypes.TxBuilder, msgs []sdk.Msg, pending []int,
+	firstTx int, cfg BatchConfig, onResult func(BatchResult) error,
+) error {
+
+	node := cliBatchNode{cliCtx}
+
+	var seqs sequences = NewSequenceManager(dbm.NewMemDB(), node.AccountNumberSequence)
+	if cliCtx.ManageSequences {
+		seqs = cliSequences{cliCtx}
+	}
+
+	return broadcastBatch(
+		node, seqs, txBldr, cliCtx.GetFromName(), cliCtx.GetFromAddress(),
+		msgs, pending, firstTx, cfg, onResult,
+	)
+}
+
+func broadcastBatch(
+	node batchNode, seqs sequences, txBldr authtypes.TxBuilder, fromName string, from sdk.AccAddress,
+	msgs []sdk.Msg, pending []int, firstTx int, cfg BatchConfig, onResult func(BatchResult) error,
+) error {
+
+	if cfg.MsgsPerTx < 1 {
+		return fmt.Errorf("invalid number of messages per transaction %d", cfg.MsgsPerTx)
+	}
+
+	for _, i := range pending {
+		if err := msgs[i].ValidateBasic(); err != nil {
+			return fmt.Errorf("message %d: %w", i, err)
+		}
+
+		signers := msgs[i].GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(from) {
+			return fmt.Errorf("message %d: %s must be the only signer", i, from)
+		}
+	}
+
+	b := batchBroadcaster{node: node, seqs: seqs, cfg: cfg, fromName: fromName, from: from}
+	for tx := firstTx; len(pending) > 0; tx++ {
+		n := cfg.MsgsPerTx
+		if n > len(pending) {
+			n = len(pending)
+		}
+
+		txMsgs := make([]sdk.Msg, n)
+		for j, i := range pending[:n] {
+			txMsgs[j] = msgs[i]
+		}
+
+		res := b.broadcast(txBldr, txMsgs)
+		res.Tx = tx
+		res.Msgs = pending[:n]
+		pending = pending[n:]
+
+		if err := onResult(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type batchBroadcaster struct {
+	node     batchNode
+	seqs     sequences
+	cfg      BatchConfig
+	fromName string
+	from     sdk.AccAddress
+
+	lastBroadcast time.Time
+}
+
+// broadcast signs a transaction of the given messages with the next sequence
+// of the account and broadcasts it.
+func (b *batchBroadcaster) broadcast(txBldr authtypes.TxBuilder, msgs []sdk.Msg) (res BatchResult) {
+	var txBytes []byte
+
+	for res.Attempts = 1; ; res.Attempts++ {
+		if txBytes == nil {
+			accNum, seq, err := b.seqs.Next(b.from)
+			if err != nil {
+				res.Error = err.Error()
+				return res
+			}
+
+			res.Sequence = seq
+			txBytes, res.GasWanted, err = b.sign(txBldr.WithAccountNumber(accNum).WithSequence(seq), msgs)
+			if err != nil {
+				res.Error = err.Error()
+				b.release(&res)
+				return res
+			}
+		}
+
+		b.waitBroadcast()
+		txRes, err := b.node.BroadcastTx(txBytes)
+
+		var transient bool
+		switch {
+		case err != nil:
+			res.Codespace, res.Code, res.Error = "", 0, err.Error()
+
+			// The queried sequence only includes committed transactions. A
+			// sequence past the one of the transaction means that it reached
+			// the node before the connection failed and has been committed.
+			if _, seq, qErr := b.node.AccountNumberSequence(b.from); qErr == nil && seq > res.Sequence {
+				res.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+				res.Codespace, res.Code, res.Error = "", 0, ""
+				return res
+			}
+			transient = true
+
+		case txRes.Code == 0 || isBatchErr(txRes, sdkerrors.ErrTxInMempoolCache):
+			res.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+			res.Height = txRes.Height
+			res.Codespace, res.Code, res.Error = "", 0, ""
+			return res
+
+		default:
+			res.Codespace, res.Code, res.Error = txRes.Codespace, txRes.Code, txRes.RawLog
+			res.Height = txRes.Height
+			if res.Error == "" {
+				res.Error = fmt.Sprintf("transaction rejected with code %d", txRes.Code)
+			}
+
+			wrongSeq, ok := authtypes.WrongSequenceFromResponse(txRes)
+			if ok && wrongSeq.Signer.Equals(b.from) && wrongSeq.Expected != res.Sequence {
+				// sign again with the next sequence, which the tracking moved
+				// to or past the expected one
+				b.track(&res, txRes)
+				transient = true
+				txBytes = nil
+			} else if transient = isBatchErr(txRes, sdkerrors.ErrMempoolIsFull); !transient {
+				// rejected in CheckTx, or failed once committed
+				b.track(&res, txRes)
+				return res
+			}
+		}
+
+		if res.Attempts > b.cfg.MaxRetries {
+			if txBytes != nil {
+				b.release(&res)
+			}
+			return res
+		}
+
+		time.Sleep(b.cfg.RetryInterval)
+	}
+}
+
+// track reports the rejection of a transaction to the sequences of the account.
+func (b *batchBroadcaster) track(res *BatchResult, txRes sdk.TxResponse) {
+	if err := b.seqs.Track(b.from, res.Sequence, txRes); err != nil {
+		res.Error = fmt.Sprintf("%s; failed to track sequence: %s", res.Error, err)
+	}
+}
+
+// release releases the sequence of a transaction which was given up.
+func (b *batchBroadcaster) release(res *BatchResult) {
+	if err := b.seqs.Release(b.from, res.Sequence); err != nil {
+		res.Error = fmt.Sprintf("%s; failed to release sequence: %s", res.Error, err)
+	}
+}
+
+// sign returns the signed transaction of the given messages and its gas.
+func (b *batchBroadcaster) sign(txBldr authtypes.TxBuilder, msgs []sdk.Msg) ([]byte, uint64, error) {
+	if b.cfg.SimulateGas {
+		gas, err := b.node.SimulateGas(txBldr, msgs)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to simulate gas: %w", err)
+		}
+		txBldr = txBldr.WithGas(gas)
+	}
+
+	txBytes, err := txBldr.BuildAndSign(b.fromName, keys.DefaultKeyPass, msgs)
+	return txBytes, txBldr.Gas(), err
+}
+
+// waitBroadcast waits until the minimum time between broadcasts has passed.
+func (b *batchBroadcaster) waitBroadcast() {
+	if wait := b.cfg.MinBroadcastInterval - time.Since(b.lastBroadcast); wait > 0 {
+		time.Sleep(wait)
+	}
+	b.lastBroadcast = time.Now()
+}
+
+func isBatchErr(res sdk.TxResponse, err *sdkerrors.Error) bool {
+	return res.Codespace == err.Codespace() && res.Code == err.ABCICode()
+}
@@ -0,0 +1,287 @@
+package client
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// batchProtoTypeField holds the protobuf message name of messages in the
+	// protobuf JSON form.
+	batchProtoTypeField = "@type"
+	// batchCSVTypeColumn is the CSV column holding the message type.
+	batchCSVTypeColumn = "type"
+)
+
+var (
+	coinsType    = reflect.TypeOf(sdk.Coins{})
+	coinType     = reflect.TypeOf(sdk.Coin{})
+	decCoinsType = reflect.TypeOf(sdk.DecCoins{})
+	decCoinType  = reflect.TypeOf(sdk.DecCoin{})
+)
+
+// ReadBatchMsgs reads the messages of a batch file. Files with the .csv
+// extension are read as CSV files, any other file as a JSON array of messages.
+//
+// Messages of a JSON array are given either in the Amino JSON form, e.g.
+// {"type": "cosmos-sdk/MsgSend", "value": {...}}, or in the protobuf JSON form
+// with the protobuf message name in the "@type" field, e.g.
+// {"@type": "cosmos_sdk.x.bank.v1.MsgSend", "from_address": ...}.
+//
+// The header row of a CSV file names the message fields of its columns and a
+// "type" column holding the Amino or protobuf name of the message of each row.
+// Coins are given in their text form, e.g. 10stake, objects and lists in their
+// JSON form and empty cells are ignored.
+func ReadBatchMsgs(cdc *codec.Codec, filename string) ([]sdk.Msg, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(filename), ".csv") {
+		return ParseBatchCSV(cdc, bz)
+	}
+
+	return ParseBatchJSON(cdc, bz)
+}
+
+// ParseBatchJSON parses a JSON array of messages in the Amino or protobuf JSON
+// form.
+func ParseBatchJSON(cdc *codec.Codec, bz []byte) ([]sdk.Msg, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(bz, &raws); err != nil {
+		return nil, fmt.Errorf("failed to parse the messages: %w", err)
+	}
+
+	msgs := make([]sdk.Msg, len(raws))
+	for i, raw := range raws {
+		msg, err := decodeBatchMsg(cdc, raw)
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ParseBatchCSV parses the messages of a CSV file.
+func ParseBatchCSV(cdc *codec.Codec, bz []byte) ([]sdk.Msg, error) {
+	records, err := csv.NewReader(bytes.NewReader(bz)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing CSV header")
+	}
+
+	header := records[0]
+	typeCol := -1
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		if header[i] == batchCSVTypeColumn {
+			typeCol = i
+		}
+	}
+	if typeCol < 0 {
+		return nil, fmt.Errorf("missing %q column in CSV header", batchCSVTypeColumn)
+	}
+
+	msgs := make([]sdk.Msg, 0, len(records)-1)
+	for i, record := range records[1:] {
+		msg, err := decodeBatchCSVRecord(cdc, header, typeCol, record)
+		if err != nil {
+			// rows are numbered from 1 including the header
+			return nil, fmt.Errorf("row %d: %w", i+2, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func decodeBatchMsg(cdc *codec.Codec, raw json.RawMessage) (sdk.Msg, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	if protoType, ok := fields[batchProtoTypeField]; ok {
+		var name string
+		if err := json.Unmarshal(protoType, &name); err != nil {
+			return nil, err
+		}
+
+		delete(fields, batchProtoTypeField)
+		return decodeProtoMsg(name, fields)
+	}
+
+	var msg sdk.Msg
+	if err := cdc.UnmarshalJSON(raw, &msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func decodeBatchCSVRecord(cdc *codec.Codec, header []string, typeCol int, record []string) (sdk.Msg, error) {
+	name := strings.TrimSpace(record[typeCol])
+	if name == "" {
+		return nil, fmt.Errorf("missing message type")
+	}
+
+	// the zero message of the type gives the types of its fields
+	zero, isProto, err := zeroBatchMsg(cdc, name)
+	if err != nil {
+		return nil, err
+	}
+
+	msgType := reflect.TypeOf(zero)
+	if msgType.Kind() == reflect.Ptr {
+		msgType = msgType.Elem()
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for i, cell := range record {
+		cell = strings.TrimSpace(cell)
+		if i == typeCol || cell == "" {
+			continue
+		}
+
+		field, ok := fieldByJSONName(msgType, header[i])
+		if !ok {
+			return nil, fmt.Errorf("%s has no field %q", name, header[i])
+		}
+
+		value, err := csvCellJSON(field.Type, cell)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", header[i], err)
+		}
+		fields[header[i]] = value
+	}
+
+	if isProto {
+		return decodeProtoMsg(name, fields)
+	}
+
+	value, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	typeName, err := json.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	err = cdc.UnmarshalJSON([]byte(fmt.Sprintf(`{"type":%s,"value":%s}`, typeName, value)), &msg)
+	return msg, err
+}
+
+// zeroBatchMsg returns the zero message registered with the given Amino or
+// protobuf name.
+func zeroBatchMsg(cdc *codec.Codec, name string) (msg sdk.Msg, isProto bool, err error) {
+	if typ := proto.MessageType(name); typ != nil {
+		msg, err = protoToMsg(reflect.New(typ.Elem()).Interface().(proto.Message))
+		return msg, true, err
+	}
+
+	typeName, err := json.Marshal(name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = cdc.UnmarshalJSON([]byte(fmt.Sprintf(`{"type":%s,"value":{}}`, typeName)), &msg)
+	if err != nil {
+		return nil, false, fmt.Errorf("unknown message type %s: %w", name, err)
+	}
+	return msg, false, nil
+}
+
+func decodeProtoMsg(name string, fields map[string]json.RawMessage) (sdk.Msg, error) {
+	typ := proto.MessageType(name)
+	if typ == nil {
+		return nil, fmt.Errorf("unknown protobuf message %s", name)
+	}
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), pb); err != nil {
+		return nil, err
+	}
+
+	return protoToMsg(pb)
+}
+
+// protoToMsg returns the sdk.Msg of a protobuf message, which implements the
+// interface either with value or with pointer receivers.
+func protoToMsg(pb proto.Message) (sdk.Msg, error) {
+	if msg, ok := reflect.ValueOf(pb).Elem().Interface().(sdk.Msg); ok {
+		return msg, nil
+	}
+	if msg, ok := pb.(sdk.Msg); ok {
+		return msg, nil
+	}
+	return nil, fmt.Errorf("%s is not a message", proto.MessageName(pb))
+}
+
+func fieldByJSONName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if strings.Split(field.Tag.Get("json"), ",")[0] == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// csvCellJSON returns the JSON value of a CSV cell for a field of the given type.
+func csvCellJSON(typ reflect.Type, cell string) (json.RawMessage, error) {
+	var (
+		value interface{}
+		err   error
+	)
+
+	switch {
+	case typ == coinsType:
+		value, err = sdk.ParseCoins(cell)
+	case typ == coinType:
+		value, err = sdk.ParseCoin(cell)
+	case typ == decCoinsType:
+		value, err = sdk.ParseDecCoins(cell)
+	case typ == decCoinType:
+		value, err = sdk.ParseDecCoin(cell)
+	case strings.HasPrefix(cell, "{") || strings.HasPrefix(cell, "["):
+		return json.RawMessage(cell), nil
+	default:
+		switch typ.Kind() {
+		case reflect.Bool, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16,
+			reflect.Uint32, reflect.Float32, reflect.Float64:
+			return json.RawMessage(cell), nil
+		default:
+			// addresses, 64 bit integers and big numbers are given as strings
+			value = cell
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
@@ -0,0 +1,251 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func makeBatchCodec() *codec.Codec {
+	cdc := makeCodec()
+	banktypes.RegisterCodec(cdc)
+	stakingtypes.RegisterCodec(cdc)
+	return cdc
+}
+
+func TestParseBatchMsgs(t *testing.T) {
+	cdc := makeBatchCodec()
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	val := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 5)))
+	delegate := stakingtypes.NewMsgDelegate(from, val, sdk.NewInt64Coin("stake", 7))
+
+	amino := fmt.Sprintf(`[%s, {"@type": "cosmos_sdk.x.staking.v1.MsgDelegate", "delegator_address": %q,
+		"validator_address": %q, "amount": {"denom": "stake", "amount": "7"}}]`,
+		cdc.MustMarshalJSON(send), from.String(), val.String())
+	msgs, err := ParseBatchJSON(cdc, []byte(amino))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send, delegate}, msgs)
+
+	csv := fmt.Sprintf(`type,from_address,to_address,delegator_address,validator_address,amount
+cosmos-sdk/MsgSend,%[1]s,%[2]s,,,"10atom,5stake"
+cosmos_sdk.x.bank.v1.MsgSend,%[1]s,%[2]s,,,"10atom,5stake"
+cosmos-sdk/MsgDelegate,,,%[1]s,%[3]s,7stake
+`, from, to, val)
+	msgs, err = ParseBatchCSV(cdc, []byte(csv))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send, send, delegate}, msgs)
+
+	_, err = ParseBatchJSON(cdc, []byte(`[{"type": "cosmos-sdk/Unknown", "value": {}}]`))
+	require.Error(t, err)
+	_, err = ParseBatchCSV(cdc, []byte("from_address\ncosmos1"))
+	require.Error(t, err)
+	_, err = ParseBatchCSV(cdc, []byte("type,memo\ncosmos-sdk/MsgSend,hello"))
+	require.Error(t, err)
+	_, err = ParseBatchCSV(cdc, []byte("type,amount\ncosmos-sdk/MsgSend,ten"))
+	require.Error(t, err)
+}
+
+func TestPendingBatchMsgs(t *testing.T) {
+	results, err := ReadBatchResults(strings.NewReader(`{"tx":0,"msgs":[0,1],"sequence":3,"txhash":"AB","attempts":1}
+{"tx":1,"msgs":[2,3],"sequence":4,"error":"out of gas","attempts":1}
+
+{"tx":2,"msgs":[4],"sequence":4,"txhash":"CD","attempts":2}
+`))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	pending, err := PendingBatchMsgs(6, results)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3, 5}, pending)
+
+	_, err = PendingBatchMsgs(4, results)
+	require.Error(t, err)
+}
+
+// fakeBatchNode accepts transactions until it fails them with the queued
+// responses and errors.
+type fakeBatchNode struct {
+	seq       uint64
+	responses []sdk.TxResponse
+	errs      []error
+	// committed advances the account sequence when a broadcast fails
+	committed bool
+	txs       [][]byte
+	queries   int
+}
+
+func (n *fakeBatchNode) AccountNumberSequence(sdk.AccAddress) (uint64, uint64, error) {
+	n.queries++
+	return 1, n.seq, nil
+}
+
+func (n *fakeBatchNode) SimulateGas(_ authtypes.TxBuilder, msgs []sdk.Msg) (uint64, error) {
+	return uint64(10000 * len(msgs)), nil
+}
+
+func (n *fakeBatchNode) BroadcastTx(txBytes []byte) (sdk.TxResponse, error) {
+	n.txs = append(n.txs, txBytes)
+
+	if len(n.errs) > 0 {
+		err := n.errs[0]
+		n.errs = n.errs[1:]
+		if err != nil {
+			if n.committed {
+				n.seq++
+			}
+			return sdk.TxResponse{}, err
+		}
+	}
+
+	if len(n.responses) > 0 {
+		res := n.responses[0]
+		n.responses = n.responses[1:]
+		return res, nil
+	}
+
+	n.seq++
+	return sdk.TxResponse{}, nil
+}
+
+func TestBroadcastBatch(t *testing.T) {
+	cdc := makeBatchCodec()
+	kb := crkeys.NewInMemory()
+	info, _, err := kb.CreateMnemonic("sender", crkeys.English, keys.DefaultKeyPass, crkeys.Secp256k1)
+	require.NoError(t, err)
+	from := info.GetAddress()
+
+	msgs := make([]sdk.Msg, 5)
+	for i := range msgs {
+		to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		msgs[i] = banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1))))
+	}
+
+	txBldr := authtypes.NewTxBuilder(
+		authtypes.DefaultTxEncoder(cdc), 0, 0, 200000, 1, false, "test-chain", "", nil, nil,
+	).WithKeybase(kb)
+	cfg := BatchConfig{MsgsPerTx: 2, SimulateGas: true, MaxRetries: 1}
+
+	run := func(node *fakeBatchNode, pending []int) []BatchResult {
+		var results []BatchResult
+		seqs := NewSequenceManager(dbm.NewMemDB(), node.AccountNumberSequence)
+		err := broadcastBatch(node, seqs, txBldr, "sender", from, msgs, pending, 0, cfg, func(res BatchResult) error {
+			results = append(results, res)
+			return nil
+		})
+		require.NoError(t, err)
+		return results
+	}
+
+	// the sequence is tracked locally and the gas simulated per tx
+	node := &fakeBatchNode{seq: 7}
+	results := run(node, []int{0, 1, 2, 3, 4})
+	require.Len(t, results, 3)
+	require.Equal(t, 1, node.queries)
+	for i, res := range results {
+		require.True(t, res.Succeeded())
+		require.Equal(t, i, res.Tx)
+		require.Equal(t, uint64(7+i), res.Sequence)
+	}
+	require.Equal(t, []int{4}, results[2].Msgs)
+	require.Equal(t, uint64(20000), results[0].GasWanted)
+	require.Equal(t, uint64(10000), results[2].GasWanted)
+
+	// rejected transactions are reported and do not consume a sequence
+	rejected := sdkerrors.ErrInsufficientFunds
+	node = &fakeBatchNode{seq: 7, responses: []sdk.TxResponse{
+		{Codespace: rejected.Codespace(), Code: rejected.ABCICode(), RawLog: "insufficient funds"},
+	}}
+	results = run(node, []int{0, 1, 2})
+	require.False(t, results[0].Succeeded())
+	require.Equal(t, "insufficient funds", results[0].Error)
+	require.Equal(t, 1, results[0].Attempts)
+	require.True(t, results[1].Succeeded())
+	require.Equal(t, uint64(7), results[1].Sequence)
+
+	// transactions which failed once committed are final and consume their
+	// sequence
+	node = &fakeBatchNode{seq: 7, responses: []sdk.TxResponse{
+		{Codespace: rejected.Codespace(), Code: rejected.ABCICode(), Height: 10, RawLog: "insufficient funds"},
+	}}
+	results = run(node, []int{0, 1, 2})
+	require.False(t, results[0].Succeeded())
+	require.Equal(t, int64(10), results[0].Height)
+	require.Equal(t, 1, results[0].Attempts)
+	require.Len(t, node.txs, 2)
+	require.True(t, results[1].Succeeded())
+	require.Equal(t, uint64(8), results[1].Sequence)
+
+	// transactions of another sequence than the expected one are signed again
+	// with it, whether they are ahead of it or their sequence was used by
+	// other transactions
+	node = &fakeBatchNode{seq: 7, responses: []sdk.TxResponse{
+		wrongSequenceResponse(from, 6), {}, wrongSequenceResponse(from, 10),
+	}}
+	results = run(node, []int{0, 1, 2, 3})
+	require.True(t, results[0].Succeeded())
+	require.Equal(t, 2, results[0].Attempts)
+	require.Equal(t, uint64(6), results[0].Sequence)
+	require.NotEqual(t, node.txs[0], node.txs[1])
+	require.True(t, results[1].Succeeded())
+	require.Equal(t, 2, results[1].Attempts)
+	require.Equal(t, uint64(10), results[1].Sequence)
+
+	// invalid signatures of the expected sequence are rejected
+	node = &fakeBatchNode{seq: 7, responses: []sdk.TxResponse{wrongSequenceResponse(from, 7)}}
+	results = run(node, []int{0, 1, 2})
+	require.False(t, results[0].Succeeded())
+	require.Equal(t, 1, results[0].Attempts)
+	require.True(t, results[1].Succeeded())
+	require.Equal(t, uint64(7), results[1].Sequence)
+
+	// transient failures are retried with the same transaction
+	full := sdkerrors.ErrMempoolIsFull
+	node = &fakeBatchNode{seq: 7, errs: []error{errors.New("connection refused")}, responses: []sdk.TxResponse{
+		{Codespace: full.Codespace(), Code: full.ABCICode()},
+	}}
+	cfg.MaxRetries = 2
+	results = run(node, []int{0})
+	require.True(t, results[0].Succeeded())
+	require.Equal(t, 3, results[0].Attempts)
+	require.Equal(t, node.txs[0], node.txs[2])
+
+	// retries are bounded
+	node = &fakeBatchNode{seq: 7, errs: []error{errors.New("timeout"), errors.New("timeout"), errors.New("timeout")}}
+	results = run(node, []int{0, 1})
+	require.False(t, results[0].Succeeded())
+	require.Equal(t, 3, results[0].Attempts)
+	require.Equal(t, "timeout", results[0].Error)
+
+	// a transaction committed before its connection failed is not sent again
+	node = &fakeBatchNode{seq: 7, committed: true, errs: []error{errors.New("EOF")}}
+	results = run(node, []int{0, 1, 2})
+	require.Len(t, node.txs, 2)
+	require.True(t, results[0].Succeeded())
+	require.Equal(t, uint64(8), results[1].Sequence)
+
+	// messages of other signers are rejected before anything is broadcast
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgs[1] = banktypes.NewMsgSend(other, from, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	node = &fakeBatchNode{seq: 7}
+	seqs := NewSequenceManager(dbm.NewMemDB(), node.AccountNumberSequence)
+	err = broadcastBatch(node, seqs, txBldr, "sender", from, msgs, []int{0, 1}, 0, cfg, func(BatchResult) error { return nil })
+	require.Error(t, err)
+	require.Empty(t, node.txs)
+}
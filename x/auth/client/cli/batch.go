@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	flagMsgsPerTx     = "msgs-per-tx"
+	flagResults       = "results"
+	flagMaxRetries    = "max-retries"
+	flagRetryInterval = "retry-interval"
+	flagRateLimit     = "rate-limit"
+)
+
+// GetBatchCommand returns the tx batch command.
+func GetBatchCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [file]",
+		Short: "Sign and broadcast the messages of a JSON or CSV file in transactions of a configurable size",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Read messages from [file], pack them into transactions of at most --msgs-per-tx
+messages, then sign the transactions with the --from key and broadcast them. All messages
+must be signed by the --from key only.
+
+A JSON file holds an array of messages in the Amino JSON form, e.g.
+  {"type": "cosmos-sdk/MsgSend", "value": {"from_address": ..., "to_address": ..., "amount": [...]}}
+or in the protobuf JSON form with the message name in the "@type" field, e.g.
+  {"@type": "cosmos_sdk.x.bank.v1.MsgSend", "from_address": ..., "to_address": ..., "amount": [...]}
+
+A CSV file (.csv extension) starts with a header naming the message fields of its columns
+and a "type" column holding the Amino or protobuf message name of each row. Coins are given
+in their text form:
+  type,from_address,to_address,amount
+  cosmos-sdk/MsgSend,cosmos1...,cosmos1...,100stake
+
+The account sequence is queried once and tracked locally. Unless --gas is set to a number,
+the gas of each transaction is simulated. Transactions failing with a connection error or a
+full mempool are broadcast again up to --max-retries times, and --rate-limit bounds the number
+of transactions broadcast per second.
+
+The result of each transaction, including its hash or its failure, is appended as a JSON line
+to the --results file. Running the command again with the same results file resumes the batch
+and only sends the messages whose transactions have not succeeded.
+
+Example:
+$ %s tx batch payroll.csv --from treasury --chain-id mychain --results payroll.results.jsonl
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: makeBatchCmd(cdc),
+	}
+
+	cmd.Flags().Int(flagMsgsPerTx, 10, "Maximum number of messages per transaction")
+	cmd.Flags().String(flagResults, "", "File the transaction results are appended to (default [file].results.jsonl)")
+	cmd.Flags().Int(flagMaxRetries, 3, "Number of times a transaction is broadcast again after a transient failure")
+	cmd.Flags().Duration(flagRetryInterval, 5*time.Second, "Time to wait before broadcasting a transaction again")
+	cmd.Flags().Float64(flagRateLimit, 0, "Maximum number of transactions broadcast per second (0 for no limit)")
+
+	cmd = flags.PostCommands(cmd)[0]
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func makeBatchCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		msgs, err := client.ReadBatchMsgs(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		resultsFile := viper.GetString(flagResults)
+		if resultsFile == "" {
+			resultsFile = args[0] + ".results.jsonl"
+		}
+
+		results, err := client.ReadBatchResultsFile(resultsFile)
+		if err != nil {
+			return err
+		}
+
+		pending, err := client.PendingBatchMsgs(len(msgs), results)
+		if err != nil {
+			return err
+		}
+
+		firstTx := 0
+		for _, res := range results {
+			if res.Tx >= firstTx {
+				firstTx = res.Tx + 1
+			}
+		}
+
+		cfg := client.BatchConfig{
+			MsgsPerTx:     viper.GetInt(flagMsgsPerTx),
+			MaxRetries:    viper.GetInt(flagMaxRetries),
+			RetryInterval: viper.GetDuration(flagRetryInterval),
+		}
+		if rate := viper.GetFloat64(flagRateLimit); rate > 0 {
+			cfg.MinBroadcastInterval = time.Duration(float64(time.Second) / rate)
+		}
+
+		inBuf := bufio.NewReader(cmd.InOrStdin())
+		txBldr := types.NewTxBuilderFromCLI(inBuf).WithTxEncoder(client.GetTxEncoder(cdc))
+		cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+		// simulate unless the gas is set to a number
+		cfg.SimulateGas = txBldr.SimulateAndExecute() || !cmd.Flags().Changed("gas")
+
+		fmt.Fprintf(os.Stderr, "%d of %d messages pending, results are written to %q\n",
+			len(pending), len(msgs), resultsFile)
+		if len(pending) == 0 {
+			return nil
+		}
+
+		fp, err := os.OpenFile(resultsFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		defer fp.Close()
+
+		var succeeded, failed int
+		err = client.BroadcastBatch(cliCtx, txBldr, msgs, pending, firstTx, cfg, func(res client.BatchResult) error {
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(fp, "%s\n", bz); err != nil {
+				return err
+			}
+
+			if res.Succeeded() {
+				succeeded++
+				fmt.Fprintf(os.Stderr, "tx %d: %d messages sent in %s\n", res.Tx, len(res.Msgs), res.TxHash)
+			} else {
+				failed++
+				fmt.Fprintf(os.Stderr, "tx %d: failed: %s\n", res.Tx, res.Error)
+			}
+
+			return fp.Sync()
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "%d transactions succeeded, %d failed\n", succeeded, failed)
+		if failed > 0 {
+			return fmt.Errorf("%d transactions failed, run the command again to retry their messages", failed)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetSequencesCommand returns the tx sequences command, which manages the
+// sequences persisted by the transaction commands with --manage-sequences.
+func GetSequencesCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sequences",
+		Short: "Manage the account sequences persisted with --manage-sequences",
+	}
+
+	cmd.AddCommand(getSequencesResetCommand(cdc))
+	return cmd
+}
+
+func getSequencesResetCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [key_or_address]",
+		Short: "Drop the sequences persisted for an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Drop the sequences persisted for the account of the given key or address on the
+--chain-id chain, so that the next transaction broadcast with --manage-sequences
+queries the sequence of the account again, e.g. after other clients signed
+transactions of the account.
+
+Example:
+$ %s tx sequences reset treasury --chain-id mychain
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				addr, _, err = context.GetFromFields(bufio.NewReader(cmd.InOrStdin()), args[0], false)
+				if err != nil {
+					return err
+				}
+			}
+
+			m, err := client.OpenSequenceManager(context.NewCLIContext().WithCodec(cdc))
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			return m.Reset(addr)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+
+	return cmd
+}
@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const flagAppendToSession = "append-to-session"
+
+// GetMultiSignSessionCommand returns the multisig signing session commands.
+func GetMultiSignSessionCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "Coordinate the signatures of a multisig account through a signing session file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`A signing session file records an unsigned transaction, the chain ID, account
+number and sequence it is signed with, the multisig public key and its threshold, and the
+signatures collected so far. It is passed between the members of the multisig account,
+who append their signatures until the threshold is met.
+
+Example:
+$ %[1]s tx multisign session create transaction.json k1k2k3 session.json
+$ %[1]s tx sign session.json --append-to-session --from k1
+$ %[1]s tx sign session.json --append-to-session --from k2
+$ %[1]s tx multisign session show session.json
+$ %[1]s tx multisign session finalize session.json > signed.json
+`,
+				version.ClientName,
+			),
+		),
+	}
+
+	cmd.AddCommand(flags.PostCommands(
+		getMultiSignSessionCreateCommand(cdc),
+		getMultiSignSessionFinalizeCommand(cdc),
+	)...)
+	cmd.AddCommand(getMultiSignSessionShowCommand(cdc))
+
+	return cmd
+}
+
+func getMultiSignSessionCreateCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [name] [session]",
+		Short: "Start a signing session for a transaction generated offline",
+		Long: `Start a signing session for the transaction read from [file] on behalf of the
+multisig key [name] and write it to [session]. The account number and sequence of the
+multisig account are queried from a full node unless --offline is set, in which case
+--account-number and --sequence are required.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeMultiSignSessionCreateCmd(cdc),
+		Args:   cobra.ExactArgs(3),
+	}
+
+	cmd.Flags().Bool(flagOffline, false, "Offline mode. Do not query a full node")
+	return cmd
+}
+
+func makeMultiSignSessionCreateCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		stdTx, err := client.ReadStdTxFromFile(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		inBuf := bufio.NewReader(cmd.InOrStdin())
+		kb, err := flags.NewKeyringFromFlags(viper.GetString(flags.FlagHome), inBuf)
+		if err != nil {
+			return err
+		}
+
+		multisigInfo, err := kb.Get(args[1])
+		if err != nil {
+			return err
+		}
+		if multisigInfo.GetType() != keys.TypeMulti {
+			return fmt.Errorf("%q must be of type %s: %s", args[1], keys.TypeMulti, multisigInfo.GetType())
+		}
+
+		cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+		txBldr := types.NewTxBuilderFromCLI(inBuf)
+
+		if !viper.GetBool(flagOffline) {
+			accnum, seq, err := types.NewAccountRetriever(client.Codec, cliCtx).GetAccountNumberSequence(multisigInfo.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
+		}
+
+		session, err := client.NewMultisigSession(
+			txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(), stdTx, multisigInfo.GetPubKey(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := client.WriteMultisigSession(cdc, args[2], session, false); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "Signing session for %s written to %q: %d of %d signatures required\n",
+			session.Address(), args[2], session.Threshold, len(session.Members()))
+		return nil
+	}
+}
+
+func getMultiSignSessionShowCommand(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [session]",
+		Short: "Print the signers and the remaining signers of a signing session",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := client.ReadMultisigSession(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Multisig account: %s [threshold: %d/%d]\n",
+				session.Address(), session.Threshold, len(session.Members()))
+			cmd.Printf("Chain ID: %s, account number: %d, sequence: %d\n",
+				session.ChainID, session.AccountNumber, session.Sequence)
+
+			cmd.Println("\nSigned:")
+			for _, sig := range session.Signatures {
+				cmd.Printf("  %s\n", sdk.AccAddress(sig.PubKey.Address()))
+			}
+
+			cmd.Println("\nRemaining:")
+			for _, pubKey := range session.Remaining() {
+				cmd.Printf("  %s\n", sdk.AccAddress(pubKey.Address()))
+			}
+
+			if session.ThresholdMet() {
+				cmd.Println("\nThreshold met, the transaction can be finalized")
+			} else {
+				cmd.Printf("\n%d more signatures required\n", session.Threshold-uint64(len(session.Signatures)))
+			}
+
+			return nil
+		},
+	}
+}
+
+func getMultiSignSessionFinalizeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session]",
+		Short: "Produce the multisig signed transaction of a signing session",
+		Long: `Produce the broadcast-ready transaction of a signing session whose threshold
+is met. Unless --offline is set, the account number and sequence of the session are
+checked against those of the multisig account.
+`,
+		RunE: makeMultiSignSessionFinalizeCmd(cdc),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool(flagOffline, false, "Offline mode. Do not query a full node")
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+	return cmd
+}
+
+func makeMultiSignSessionFinalizeCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		session, err := client.ReadMultisigSession(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		cliCtx := context.NewCLIContextWithInput(bufio.NewReader(cmd.InOrStdin())).WithCodec(cdc)
+		if !viper.GetBool(flagOffline) {
+			if err := validateSessionAccount(cliCtx, session); err != nil {
+				return err
+			}
+		}
+
+		newTx, err := session.MultisignedTx(cdc)
+		if err != nil {
+			return err
+		}
+
+		json, err := getSignatureJSON(cdc, newTx, cliCtx.Indent, false)
+		if err != nil {
+			return err
+		}
+
+		if viper.GetString(flagOutfile) == "" {
+			fmt.Printf("%s\n", json)
+			return nil
+		}
+
+		fp, err := os.OpenFile(
+			viper.GetString(flagOutfile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+		)
+		if err != nil {
+			return err
+		}
+		defer fp.Close()
+
+		fmt.Fprintf(fp, "%s\n", json)
+		return nil
+	}
+}
+
+// appendToSession signs the transaction of the signing session read from
+// filename with the key of name and appends the signature to the session.
+func appendToSession(
+	cdc *codec.Codec, cliCtx context.CLIContext, txBldr types.TxBuilder, filename, name string, offline bool,
+) error {
+
+	session, err := client.ReadMultisigSession(cdc, filename)
+	if err != nil {
+		return err
+	}
+
+	if chainID := txBldr.ChainID(); chainID != "" && chainID != session.ChainID {
+		return fmt.Errorf("chain ID mismatch: session has %s, got %s", session.ChainID, chainID)
+	}
+	if !offline {
+		if err := validateSessionAccount(cliCtx, session); err != nil {
+			return err
+		}
+	}
+
+	if err := session.Sign(txBldr, name); err != nil {
+		return err
+	}
+
+	if err := client.WriteMultisigSession(cdc, filename, session, true); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Signature of %s appended to %q: %d of %d required signatures collected\n",
+		name, filename, len(session.Signatures), session.Threshold)
+	return nil
+}
+
+// validateSessionAccount checks the account number and sequence of a signing
+// session against the state of the multisig account.
+func validateSessionAccount(cliCtx context.CLIContext, session client.MultisigSession) error {
+	accnum, seq, err := types.NewAccountRetriever(client.Codec, cliCtx).GetAccountNumberSequence(session.Address())
+	if err != nil {
+		return err
+	}
+
+	return session.ValidateAccount(accnum, seq)
+}
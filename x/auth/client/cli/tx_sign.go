@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkmultisig "github.com/cosmos/cosmos-sdk/crypto/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -52,6 +53,11 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --append-to-session flag reads a multisig signing session created with the
+'multisign session create' command from [file] instead of a transaction. The signature
+of --from is checked against the members of the multisig key and appended to the
+session, which is updated in place.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(codec),
@@ -71,6 +77,10 @@ be generated via the 'multisign' command.
 		"Print the addresses that must sign the transaction, those who have already signed it, and make sure that signatures are in the correct order",
 	)
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
+	cmd.Flags().Bool(
+		flagAppendToSession, false,
+		"Read a multisig signing session from [file] and append the signature to it",
+	)
 	cmd.Flags().Bool(
 		flagOffline, false,
 		"Offline mode; Do not query a full node. --account and --sequence options would be required if offline is set",
@@ -85,8 +95,8 @@ be generated via the 'multisign' command.
 
 func preSignCmd(cmd *cobra.Command, _ []string) {
 	// Conditionally mark the account and sequence numbers required as no RPC
-	// query will be done.
-	if viper.GetBool(flagOffline) {
+	// query will be done. Signing sessions record both numbers.
+	if viper.GetBool(flagOffline) && !viper.GetBool(flagAppendToSession) {
 		cmd.MarkFlagRequired(flags.FlagAccountNumber)
 		cmd.MarkFlagRequired(flags.FlagSequence)
 	}
@@ -94,16 +104,20 @@ func preSignCmd(cmd *cobra.Command, _ []string) {
 
 func makeSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		stdTx, err := client.ReadStdTxFromFile(cdc, args[0])
-		if err != nil {
-			return err
-		}
-
 		inBuf := bufio.NewReader(cmd.InOrStdin())
 		offline := viper.GetBool(flagOffline)
 		cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 		txBldr := types.NewTxBuilderFromCLI(inBuf)
 
+		if viper.GetBool(flagAppendToSession) {
+			return appendToSession(cdc, cliCtx, txBldr, args[0], cliCtx.GetFromName(), offline)
+		}
+
+		stdTx, err := client.ReadStdTxFromFile(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
 		if viper.GetBool(flagValidateSigs) {
 			if !printAndValidateSigs(cliCtx, txBldr.ChainID(), stdTx, offline) {
 				return fmt.Errorf("signatures validation failed")
@@ -241,7 +255,7 @@ func printAndValidateSigs(
 			}
 		}
 
-		multiPK, ok := sig.PubKey.(multisig.PubKeyMultisigThreshold)
+		threshold, multiPubKeys, ok := sdkmultisig.Threshold(sig.PubKey)
 		if ok {
 			var multiSig multisig.Multisignature
 			cliCtx.Codec.MustUnmarshalBinaryBare(sig.Signature, &multiSig)
@@ -251,12 +265,12 @@ func printAndValidateSigs(
 
 			for i := 0; i < multiSig.BitArray.Size(); i++ {
 				if multiSig.BitArray.GetIndex(i) {
-					addr := sdk.AccAddress(multiPK.PubKeys[i].Address().Bytes())
+					addr := sdk.AccAddress(multiPubKeys[i].Address().Bytes())
 					b.WriteString(fmt.Sprintf("    %d: %s (weight: %d)\n", i, addr, 1))
 				}
 			}
 
-			multiSigHeader = fmt.Sprintf(" [multisig threshold: %d/%d]", multiPK.K, len(multiPK.PubKeys))
+			multiSigHeader = fmt.Sprintf(" [multisig threshold: %d/%d]", threshold, len(multiPubKeys))
 			multiSigMsg = b.String()
 		}
 
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"bytes"
+	gocontext "context"
+	"fmt"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txsSearchLimit is the number of transactions searched at once while
+// catching up with the chain.
+const txsSearchLimit = 100
+
+// FormatTxEvent returns the TxResponse of the transaction of a Tendermint tx
+// event. The inclusion of the transaction in its block is verified unless the
+// node is trusted.
+func FormatTxEvent(cliCtx context.CLIContext, event tmtypes.EventDataTx) (sdk.TxResponse, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	resBlock, err := node.Block(&event.Height)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	txs := resBlock.Block.Data.Txs
+	if int(event.Index) >= len(txs) || !bytes.Equal(txs[event.Index], event.Tx) {
+		return sdk.TxResponse{}, fmt.Errorf("transaction %X is not in block %d", event.Tx.Hash(), event.Height)
+	}
+
+	resTx := &ctypes.ResultTx{
+		Hash:     event.Tx.Hash(),
+		Height:   event.Height,
+		Index:    event.Index,
+		TxResult: event.Result,
+		Tx:       event.Tx,
+	}
+
+	if !cliCtx.TrustNode {
+		resTx.Proof = txs.Proof(int(event.Index))
+		if err := ValidateTxResult(cliCtx, resTx); err != nil {
+			return sdk.TxResponse{}, err
+		}
+	}
+
+	return formatTxResult(cliCtx.Codec, resTx, resBlock)
+}
+
+// StreamTxs sends the TxResponse of the transactions matching the given
+// Tendermint event query, e.g. "message.action='send'", until the context is
+// done. An empty query matches all transactions. When the given height is
+// positive, the transactions indexed from this height are searched and sent
+// first, so that a stream resumes from the height of the last transaction
+// received. Transactions received again are sent again. The transactions
+// missed while the subscription to the node is renewed are searched from the
+// height of the last transaction sent.
+func StreamTxs(
+	ctx gocontext.Context, cliCtx context.CLIContext, query string, fromHeight int64, send func(sdk.TxResponse) error,
+) error {
+
+	eventsQuery := tmtypes.EventQueryTx.String()
+	if query != "" {
+		eventsQuery = fmt.Sprintf("%s AND %s", eventsQuery, query)
+	}
+
+	sub, err := rpc.SubscribeEvents(ctx, cliCtx, eventsQuery)
+	if err != nil {
+		return err
+	}
+
+	// the transactions sent at the height of the last transaction sent, which
+	// are not sent again when they are searched or their event is received
+	var (
+		sentHeight int64
+		sent       = make(map[string]bool)
+	)
+	sendTx := func(tx sdk.TxResponse) error {
+		if tx.Height < sentHeight || (tx.Height == sentHeight && sent[tx.TxHash]) {
+			return nil
+		}
+		if tx.Height > sentHeight {
+			sentHeight = tx.Height
+			sent = make(map[string]bool)
+		}
+		sent[tx.TxHash] = true
+
+		return send(tx)
+	}
+
+	searchFrom := func(height int64) error {
+		searchEvents := []string{fmt.Sprintf("tx.height>=%d", height)}
+		if query != "" {
+			searchEvents = append(searchEvents, query)
+		}
+
+		for page := 1; ; page++ {
+			res, err := QueryTxsByEvents(cliCtx, searchEvents, page, txsSearchLimit, "asc")
+			if err != nil {
+				return err
+			}
+
+			for _, tx := range res.Txs {
+				if err := sendTx(tx); err != nil {
+					return err
+				}
+			}
+
+			if page >= res.PageTotal {
+				return nil
+			}
+		}
+	}
+
+	if fromHeight > 0 {
+		if err := searchFrom(fromHeight); err != nil {
+			return err
+		}
+	} else {
+		height, err := rpc.GetChainHeight(cliCtx)
+		if err != nil {
+			return err
+		}
+		fromHeight = height + 1
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-sub.Missed:
+			height := sentHeight
+			if height < fromHeight {
+				height = fromHeight
+			}
+			if err := searchFrom(height); err != nil {
+				return err
+			}
+
+		case event, ok := <-sub.Events:
+			if !ok {
+				return rpc.ErrSubscriptionClosed
+			}
+
+			data, ok := event.Data.(tmtypes.EventDataTx)
+			if !ok || data.Height < fromHeight {
+				continue
+			}
+			if data.Height < sentHeight || (data.Height == sentHeight && sent[fmt.Sprintf("%X", data.Tx.Hash())]) {
+				continue
+			}
+
+			tx, err := FormatTxEvent(cliCtx, data)
+			if err != nil {
+				return err
+			}
+
+			if err := sendTx(tx); err != nil {
+				return err
+			}
+		}
+	}
+}
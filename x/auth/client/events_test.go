@@ -0,0 +1,189 @@
+package client
+
+import (
+	gocontext "context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// eventsNode is an in-process node whose indexed transactions and events are
+// set by the test.
+type eventsNode struct {
+	mock.Client
+
+	blocks        map[int64]*ctypes.ResultBlock
+	indexed       []*ctypes.ResultTx
+	subscriptions chan chan ctypes.ResultEvent
+	unsubscribed  chan string
+}
+
+func (n *eventsNode) Subscribe(
+	ctx gocontext.Context, subscriber, query string, outCapacity ...int,
+) (<-chan ctypes.ResultEvent, error) {
+
+	events := make(chan ctypes.ResultEvent, 2)
+	n.subscriptions <- events
+	return events, nil
+}
+
+func (n *eventsNode) Unsubscribe(ctx gocontext.Context, subscriber, query string) error {
+	n.unsubscribed <- query
+	return nil
+}
+
+func (n *eventsNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	return n.blocks[*height], nil
+}
+
+func (n *eventsNode) TxSearch(query string, prove bool, page, perPage int, orderBy string) (*ctypes.ResultTxSearch, error) {
+	return &ctypes.ResultTxSearch{Txs: n.indexed, TotalCount: len(n.indexed)}, nil
+}
+
+func newEventsNode(cdc *codec.Codec, heights int64) (*eventsNode, []tmtypes.Tx) {
+	node := &eventsNode{
+		blocks:        make(map[int64]*ctypes.ResultBlock),
+		subscriptions: make(chan chan ctypes.ResultEvent, 1),
+		unsubscribed:  make(chan string, 1),
+	}
+
+	var txs []tmtypes.Tx
+	for height := int64(1); height <= heights; height++ {
+		stdTx := authtypes.NewStdTx([]sdk.Msg{sdk.NewTestMsg(addr)}, authtypes.NewStdFee(50000, nil), nil, "")
+		tx := tmtypes.Tx(cdc.MustMarshalBinaryLengthPrefixed(stdTx))
+		txs = append(txs, tx)
+
+		node.blocks[height] = &ctypes.ResultBlock{Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: height, Time: time.Unix(height, 0).UTC()},
+			Data:   tmtypes.Data{Txs: tmtypes.Txs{tx}},
+		}}
+	}
+
+	return node, txs
+}
+
+func newEventsCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+	return cdc
+}
+
+func txEvent(tx tmtypes.Tx, height int64) ctypes.ResultEvent {
+	return ctypes.ResultEvent{Data: tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+		Height: height, Tx: tx, Result: abci.ResponseDeliverTx{Log: "[]"},
+	}}}
+}
+
+func indexedTx(tx tmtypes.Tx, height int64) *ctypes.ResultTx {
+	return &ctypes.ResultTx{Hash: tx.Hash(), Height: height, Tx: tx}
+}
+
+// dialTxsStream serves the transactions stream of the node from the given
+// height and connects to it.
+func dialTxsStream(
+	t *testing.T, cdc *codec.Codec, node *eventsNode, fromHeight int64,
+) (*httptest.Server, *websocket.Conn) {
+
+	cliCtx := context.CLIContext{}.WithCodec(cdc).WithClient(node).WithTrustNode(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rpc.ServeEvents(w, r, func(ctx gocontext.Context, send func(msg []byte) error) error {
+			return StreamTxs(ctx, cliCtx, "message.action='test'", fromHeight, func(tx sdk.TxResponse) error {
+				return send(cdc.MustMarshalJSON(tx))
+			})
+		})
+	}))
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	return server, conn
+}
+
+func requireNextTx(t *testing.T, cdc *codec.Codec, conn *websocket.Conn, tx tmtypes.Tx, height int64) {
+	_, bz, err := conn.ReadMessage()
+	require.NoError(t, err)
+
+	var res sdk.TxResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, height, res.Height)
+	require.Equal(t, fmt.Sprintf("%X", tx.Hash()), res.TxHash)
+	require.Equal(t, time.Unix(height, 0).UTC().Format(time.RFC3339), res.Timestamp)
+}
+
+func TestStreamTxs(t *testing.T) {
+	cdc := newEventsCodec()
+	node, txs := newEventsNode(cdc, 3)
+
+	// the first two transactions are indexed, the last two are received
+	node.indexed = []*ctypes.ResultTx{indexedTx(txs[0], 1), indexedTx(txs[1], 2)}
+
+	server, conn := dialTxsStream(t, cdc, node, 1)
+	defer server.Close()
+
+	events := <-node.subscriptions
+	events <- txEvent(txs[1], 2)
+	events <- txEvent(txs[2], 3)
+
+	// each transaction is received once, in order
+	for height := int64(1); height <= 3; height++ {
+		requireNextTx(t, cdc, conn, txs[height-1], height)
+	}
+
+	// the subscription ends with the connection
+	require.NoError(t, conn.Close())
+	select {
+	case query := <-node.unsubscribed:
+		require.Equal(t, "tm.event='Tx' AND message.action='test'", query)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscription was not closed")
+	}
+}
+
+func TestStreamTxsMissed(t *testing.T) {
+	cdc := newEventsCodec()
+	node, txs := newEventsNode(cdc, 4)
+	node.indexed = []*ctypes.ResultTx{indexedTx(txs[0], 1)}
+
+	server, conn := dialTxsStream(t, cdc, node, 1)
+	defer server.Close()
+	defer conn.Close()
+
+	events := <-node.subscriptions
+	events <- txEvent(txs[1], 2)
+	requireNextTx(t, cdc, conn, txs[0], 1)
+	requireNextTx(t, cdc, conn, txs[1], 2)
+
+	// the subscription is lost while the third transaction is committed
+	node.indexed = append(node.indexed, indexedTx(txs[1], 2), indexedTx(txs[2], 3))
+	close(events)
+
+	// the missed transaction is searched once the subscription is renewed
+	select {
+	case events = <-node.subscriptions:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscription was not renewed")
+	}
+	requireNextTx(t, cdc, conn, txs[2], 3)
+
+	events <- txEvent(txs[2], 3)
+	events <- txEvent(txs[3], 4)
+	requireNextTx(t, cdc, conn, txs[3], 4)
+}
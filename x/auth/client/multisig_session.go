@@ -0,0 +1,256 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkmultisig "github.com/cosmos/cosmos-sdk/crypto/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MultisigSession collects the signatures of the members of a multisig account
+// over an unsigned transaction until its threshold is met. Sessions are stored
+// as JSON files which are passed between the members of the multisig account.
+type MultisigSession struct {
+	ChainID       string                   `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64                   `json:"account_number" yaml:"account_number"`
+	Sequence      uint64                   `json:"sequence" yaml:"sequence"`
+	Tx            authtypes.StdTx          `json:"tx" yaml:"tx"`
+	MultisigKey   crypto.PubKey            `json:"multisig_key" yaml:"multisig_key"`
+	Threshold     uint64                   `json:"threshold" yaml:"threshold"`
+	Signatures    []authtypes.StdSignature `json:"signatures" yaml:"signatures"`
+}
+
+// NewMultisigSession returns a session collecting signatures over the given
+// unsigned transaction on behalf of the multisig account of multisigKey, which
+// must be the only signer of the transaction.
+func NewMultisigSession(
+	chainID string, accNum, seq uint64, stdTx authtypes.StdTx, multisigKey crypto.PubKey,
+) (MultisigSession, error) {
+
+	threshold, _, ok := sdkmultisig.Threshold(multisigKey)
+	if !ok {
+		return MultisigSession{}, fmt.Errorf("%T is not a multisig public key", multisigKey)
+	}
+
+	session := MultisigSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		Tx:            authtypes.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, nil, stdTx.GetMemo()),
+		MultisigKey:   multisigKey,
+		Threshold:     uint64(threshold),
+	}
+
+	return session, session.Validate()
+}
+
+// Address returns the address of the multisig account.
+func (s MultisigSession) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.MultisigKey.Address())
+}
+
+// SignBytes returns the bytes each member of the multisig account signs.
+func (s MultisigSession) SignBytes() []byte {
+	return authtypes.StdSignBytes(
+		s.ChainID, s.AccountNumber, s.Sequence, s.Tx.Fee, s.Tx.GetMsgs(), s.Tx.GetMemo(),
+	)
+}
+
+// Members returns the public keys of the members of the multisig account.
+func (s MultisigSession) Members() []crypto.PubKey {
+	_, pubKeys, _ := sdkmultisig.Threshold(s.MultisigKey)
+	return pubKeys
+}
+
+// HasSigned returns true if the session holds a signature of the given member.
+func (s MultisigSession) HasSigned(pubKey crypto.PubKey) bool {
+	for _, sig := range s.Signatures {
+		if sig.PubKey.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remaining returns the public keys of the members who have not signed yet.
+func (s MultisigSession) Remaining() []crypto.PubKey {
+	var remaining []crypto.PubKey
+	for _, pubKey := range s.Members() {
+		if !s.HasSigned(pubKey) {
+			remaining = append(remaining, pubKey)
+		}
+	}
+	return remaining
+}
+
+// ThresholdMet returns true if enough members have signed to produce the
+// multisig signature.
+func (s MultisigSession) ThresholdMet() bool {
+	return uint64(len(s.Signatures)) >= s.Threshold
+}
+
+// AddSignature adds the signature of a member of the multisig account after
+// verifying it over the sign bytes of the session.
+func (s *MultisigSession) AddSignature(sig authtypes.StdSignature) error {
+	if sig.PubKey == nil {
+		return fmt.Errorf("signature without public key")
+	}
+
+	signer := sdk.AccAddress(sig.PubKey.Address())
+	if !isMember(sig.PubKey, s.Members()) {
+		return fmt.Errorf("%s: %s is not a member of the multisig account %s",
+			authtypes.ErrorInvalidSigner, signer, s.Address())
+	}
+	if s.HasSigned(sig.PubKey) {
+		return fmt.Errorf("%s has already signed", signer)
+	}
+	if !sig.PubKey.VerifyBytes(s.SignBytes(), sig.Signature) {
+		return fmt.Errorf("couldn't verify signature of %s", signer)
+	}
+
+	s.Signatures = append(s.Signatures, sig)
+	return nil
+}
+
+// Sign signs the transaction of the session with the key of name, which must
+// belong to a member of the multisig account, and adds the signature.
+func (s *MultisigSession) Sign(txBldr authtypes.TxBuilder, name string) error {
+	info, err := txBldr.Keybase().Get(name)
+	if err != nil {
+		return err
+	}
+	if s.HasSigned(info.GetPubKey()) {
+		return fmt.Errorf("%s has already signed", name)
+	}
+
+	signedTx, err := txBldr.
+		WithChainID(s.ChainID).
+		WithAccountNumber(s.AccountNumber).
+		WithSequence(s.Sequence).
+		SignStdTx(name, keys.DefaultKeyPass, s.Tx, false)
+	if err != nil {
+		return err
+	}
+
+	return s.AddSignature(signedTx.Signatures[0])
+}
+
+// Validate checks the consistency of the session and verifies the signatures
+// collected so far.
+func (s MultisigSession) Validate() error {
+	if s.ChainID == "" {
+		return fmt.Errorf("chain ID required but not specified")
+	}
+
+	threshold, _, ok := sdkmultisig.Threshold(s.MultisigKey)
+	if !ok {
+		return fmt.Errorf("%T is not a multisig public key", s.MultisigKey)
+	}
+	if s.Threshold != uint64(threshold) {
+		return fmt.Errorf("threshold %d does not match the threshold %d of the multisig key", s.Threshold, threshold)
+	}
+
+	signers := s.Tx.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(s.Address()) {
+		return fmt.Errorf("%s: the multisig account %s must be the only signer of the transaction",
+			authtypes.ErrorInvalidSigner, s.Address())
+	}
+
+	sigs := s.Signatures
+	s.Signatures = nil
+	for _, sig := range sigs {
+		if err := s.AddSignature(sig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateAccount checks the account number and sequence of the session
+// against those of the multisig account.
+func (s MultisigSession) ValidateAccount(accNum, seq uint64) error {
+	if s.AccountNumber != accNum {
+		return fmt.Errorf("account number mismatch: session has %d, account %s has %d",
+			s.AccountNumber, s.Address(), accNum)
+	}
+	if s.Sequence != seq {
+		return fmt.Errorf("sequence mismatch: session has %d, account %s has %d",
+			s.Sequence, s.Address(), seq)
+	}
+	return nil
+}
+
+// MultisignedTx returns the transaction signed with the multisig signature
+// built from the collected signatures.
+func (s MultisigSession) MultisignedTx(cdc *codec.Codec) (authtypes.StdTx, error) {
+	if !s.ThresholdMet() {
+		return authtypes.StdTx{}, fmt.Errorf("%d of %d required signatures collected", len(s.Signatures), s.Threshold)
+	}
+
+	pubKeys := s.Members()
+	multisigSig := multisig.NewMultisig(len(pubKeys))
+	for _, sig := range s.Signatures {
+		if err := multisigSig.AddSignatureFromPubKey(sig.Signature, sig.PubKey, pubKeys); err != nil {
+			return authtypes.StdTx{}, err
+		}
+	}
+
+	newStdSig := authtypes.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: s.MultisigKey}
+	return authtypes.NewStdTx(s.Tx.GetMsgs(), s.Tx.Fee, []authtypes.StdSignature{newStdSig}, s.Tx.GetMemo()), nil
+}
+
+// ReadMultisigSession reads, decodes and validates a session from the given file.
+func ReadMultisigSession(cdc *codec.Codec, filename string) (session MultisigSession, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+
+	if err = cdc.UnmarshalJSON(bz, &session); err != nil {
+		return
+	}
+
+	err = session.Validate()
+	return
+}
+
+// WriteMultisigSession writes a session to the given file. It fails if the file
+// exists and overwrite is false.
+func WriteMultisigSession(cdc *codec.Codec, filename string, session MultisigSession, overwrite bool) error {
+	bz, err := cdc.MarshalJSONIndent(session, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flag |= os.O_EXCL
+	}
+
+	fp, err := os.OpenFile(filename, flag, 0644)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	_, err = fmt.Fprintf(fp, "%s\n", bz)
+	return err
+}
+
+func isMember(pubKey crypto.PubKey, members []crypto.PubKey) bool {
+	for _, member := range members {
+		if member.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMultisigSession(t *testing.T) {
+	cdc := makeCodec()
+	banktypes.RegisterCodec(cdc)
+
+	kb := crkeys.NewInMemory()
+
+	pubKeys := make([]crypto.PubKey, 3)
+	for i := range pubKeys {
+		info, _, err := kb.CreateMnemonic(fmt.Sprintf("key%d", i), crkeys.English, keys.DefaultKeyPass, crkeys.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i] = info.GetPubKey()
+	}
+	_, _, err := kb.CreateMnemonic("other", crkeys.English, keys.DefaultKeyPass, crkeys.Secp256k1)
+	require.NoError(t, err)
+
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigKey.Address())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+	fee := authtypes.NewStdFee(50000, coins)
+	stdTx := authtypes.NewStdTx([]sdk.Msg{banktypes.NewMsgSend(multisigAddr, addr, coins)}, fee, nil, "memo")
+
+	// the multisig account must be the only signer
+	otherTx := authtypes.NewStdTx([]sdk.Msg{banktypes.NewMsgSend(addr, multisigAddr, coins)}, fee, nil, "memo")
+	_, err = NewMultisigSession("test-chain", 1, 5, otherTx, multisigKey)
+	require.Error(t, err)
+	_, err = NewMultisigSession("test-chain", 1, 5, stdTx, pubKeys[0])
+	require.Error(t, err)
+
+	session, err := NewMultisigSession("test-chain", 1, 5, stdTx, multisigKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), session.Threshold)
+	require.Len(t, session.Remaining(), 3)
+
+	txBldr := authtypes.NewTxBuilder(
+		nil, 0, 0, 200000, 1, false, "", "", nil, nil,
+	).WithKeybase(kb)
+
+	require.NoError(t, session.Sign(txBldr, "key0"))
+	require.Error(t, session.Sign(txBldr, "key0"))
+	require.Error(t, session.Sign(txBldr, "other"))
+	require.False(t, session.ThresholdMet())
+
+	_, err = session.MultisignedTx(cdc)
+	require.Error(t, err)
+
+	// signatures over different sign bytes are rejected
+	wrongSig, err := txBldr.WithChainID("test-chain").WithAccountNumber(1).WithSequence(6).
+		SignStdTx("key1", keys.DefaultKeyPass, session.Tx, false)
+	require.NoError(t, err)
+	require.Error(t, session.AddSignature(wrongSig.Signatures[0]))
+
+	// sessions round trip through their file
+	dir, cleanup := tests.NewTestCaseDir(t)
+	t.Cleanup(cleanup)
+	filename := filepath.Join(dir, "session.json")
+	require.NoError(t, WriteMultisigSession(cdc, filename, session, false))
+	require.Error(t, WriteMultisigSession(cdc, filename, session, false))
+
+	session, err = ReadMultisigSession(cdc, filename)
+	require.NoError(t, err)
+	require.True(t, session.HasSigned(pubKeys[0]))
+	require.Equal(t, []crypto.PubKey{pubKeys[1], pubKeys[2]}, session.Remaining())
+
+	require.NoError(t, session.Sign(txBldr, "key2"))
+	require.True(t, session.ThresholdMet())
+
+	require.NoError(t, session.ValidateAccount(1, 5))
+	require.Error(t, session.ValidateAccount(2, 5))
+	require.Error(t, session.ValidateAccount(1, 6))
+
+	signedTx, err := session.MultisignedTx(cdc)
+	require.NoError(t, err)
+	require.Len(t, signedTx.Signatures, 1)
+	require.True(t, multisigKey.VerifyBytes(session.SignBytes(), signedTx.Signatures[0].Signature))
+
+	// tampered sessions fail validation
+	session.Threshold = 1
+	require.Error(t, session.Validate())
+}
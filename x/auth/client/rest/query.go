@@ -1,14 +1,17 @@
 package rest
 
 import (
+	gocontext "context"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client"
@@ -157,3 +160,36 @@ func queryParamsHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// SubscribeTxsRequestHandlerFn implements a REST handler streaming through a
+// websocket the transactions matching the Tendermint event query of the query
+// parameter, e.g. "message.action='send' AND transfer.recipient='X'", in the
+// JSON format of /txs/{hash}. The from_height query parameter resumes the
+// stream from a given height.
+func SubscribeTxsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.FormValue("query")
+		if query != "" {
+			if _, err := tmquery.New(query); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid event query: %s", err))
+				return
+			}
+		}
+
+		fromHeight, ok := rpc.ParseFromHeightOrReturnBadRequest(w, r)
+		if !ok {
+			return
+		}
+
+		rpc.ServeEvents(w, r, func(ctx gocontext.Context, send func(msg []byte) error) error {
+			return client.StreamTxs(ctx, cliCtx, query, fromHeight, func(tx sdk.TxResponse) error {
+				bz, err := cliCtx.Codec.MarshalJSON(tx)
+				if err != nil {
+					return err
+				}
+
+				return send(bz)
+			})
+		})
+	}
+}
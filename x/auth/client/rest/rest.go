@@ -4,6 +4,10 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // REST query and parameter values
@@ -13,21 +17,65 @@ const (
 
 // RegisterRoutes registers the auth module REST routes.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/auth/accounts/{address}", QueryAccountRequestHandlerFn(storeName, cliCtx),
-	).Methods(MethodGet)
+	).Methods(MethodGet), rest.RouteDoc{
+		Summary:     "Get the account of an address",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      (*exported.Account)(nil),
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/auth/params",
 		queryParamsHandler(cliCtx),
-	).Methods(MethodGet)
+	).Methods(MethodGet), rest.RouteDoc{
+		Summary:     "Get the parameters of the auth module",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Params{},
+	})
 }
 
 // RegisterTxRoutes registers all transaction routes on the provided router.
 func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/txs/{hash}", QueryTxRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/txs", QueryTxsRequestHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST")
-	r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
-	r.HandleFunc("/txs/decode", DecodeTxRequestHandlerFn(cliCtx)).Methods("POST")
+	rest.Document(r.HandleFunc("/txs/subscribe", SubscribeTxsRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary: "Stream the transactions matching an event query through a websocket",
+		Description: "Each message of the websocket is a transaction. The transactions indexed from from_height " +
+			"are sent before the new transactions.",
+		QueryParams: []rest.QueryParam{
+			{Name: "query", Description: "Tendermint event query, e.g. message.action='send'"},
+			{Name: "from_height", Description: "height of the first transactions to send", Type: "integer"},
+		},
+		Response: sdk.TxResponse{},
+	})
+	rest.Document(r.HandleFunc("/txs/{hash}", QueryTxRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:  "Get a transaction by its hash",
+		Response: sdk.TxResponse{},
+	})
+	rest.Document(r.HandleFunc("/txs", QueryTxsRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary: "Search transactions by events",
+		Description: "The other query parameters are events, e.g. message.action=send, which the transactions " +
+			"must all match. The genesis transactions are returned when height is zero.",
+		QueryParams: []rest.QueryParam{
+			rest.PageParam,
+			rest.LimitParam,
+			{Name: "tx.minheight", Description: "minimum height of the transactions", Type: "integer"},
+			{Name: "tx.maxheight", Description: "maximum height of the transactions", Type: "integer"},
+		},
+		Response: sdk.SearchTxsResult{},
+	})
+	rest.Document(r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Broadcast a signed transaction with the sync, async or block mode",
+		Request:  BroadcastReq{},
+		Response: sdk.TxResponse{},
+	})
+	rest.Document(r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Encode a JSON transaction to its Amino wire format",
+		Request:  types.StdTx{},
+		Response: EncodeResp{},
+	})
+	rest.Document(r.HandleFunc("/txs/decode", DecodeTxRequestHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary: "Decode a transaction from its Amino wire format",
+		Request: DecodeReq{},
+		Result:  DecodeResp{},
+	})
 }
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const sequenceDB = "sequences"
+
+// SequenceQuerier returns the account number and sequence of an account.
+type SequenceQuerier func(addr sdk.AccAddress) (accNum, seq uint64, err error)
+
+// SequenceManager allocates the sequences of the transactions of accounts
+// signing transactions faster than they are committed. The chain is queried
+// for the sequence of an account once, further sequences are allocated locally
+// and persisted, so that transactions in the mempool are accounted for.
+//
+// Nodes expect the sequence following the transactions of the account in their
+// mempool in CheckTx, and report it in the wrong sequence error of a
+// transaction signed with another one. Transactions rejected by the node are
+// reported with Track: the sequence of a transaction rejected in CheckTx is
+// released, the allocation skips the sequences used by other transactions of
+// the account, and it goes back to the expected sequence when a transaction is
+// ahead of it, as the sequences in between were allocated to transactions
+// which never reached the mempool. Reset drops the sequences of an account,
+// e.g. after they were used by another client.
+//
+// A SequenceManager is safe for concurrent use.
+type SequenceManager struct {
+	mtx     sync.Mutex
+	db      dbm.DB
+	query   SequenceQuerier
+	accNums map[string]uint64
+}
+
+// NewSequenceManager returns a SequenceManager persisting the sequences in db
+// and querying the account sequences with query.
+func NewSequenceManager(db dbm.DB, query SequenceQuerier) *SequenceManager {
+	return &SequenceManager{db: db, query: query, accNums: make(map[string]uint64)}
+}
+
+// OpenSequenceManager returns the SequenceManager of the chain of the given
+// context, whose sequences are persisted under the chain directory of the home
+// directory. The sequences of a chain are opened by one process at a time, so
+// the SequenceManager should be closed as soon as possible.
+func OpenSequenceManager(cliCtx context.CLIContext) (*SequenceManager, error) {
+	switch {
+	case cliCtx.ChainID == "":
+		return nil, errors.New("must provide a valid chain ID to manage sequences")
+
+	case cliCtx.HomeDir == "":
+		return nil, errors.New("must provide a valid home directory to manage sequences")
+	}
+
+	db, err := context.OpenDB(sequenceDB, filepath.Join(cliCtx.HomeDir, cliCtx.ChainID))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSequenceManager(db, authtypes.NewAccountRetriever(Codec, cliCtx).GetAccountNumberSequence), nil
+}
+
+// Close closes the database of the sequences.
+func (m *SequenceManager) Close() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.db.Close()
+}
+
+// Next allocates the next sequence of the given account and returns it with
+// the account number.
+func (m *SequenceManager) Next(addr sdk.AccAddress) (accNum, seq uint64, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	seq, persisted, err := m.load(addr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	accNum, ok := m.accNums[string(addr)]
+	if !ok {
+		var chainSeq uint64
+		accNum, chainSeq, err = m.query(addr)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// the persisted sequence is ahead of the chain while transactions
+		// are in the mempool
+		if !persisted || chainSeq > seq {
+			seq = chainSeq
+		}
+		m.accNums[string(addr)] = accNum
+	}
+
+	if err := m.store(addr, seq+1); err != nil {
+		return 0, 0, err
+	}
+
+	return accNum, seq, nil
+}
+
+// Release releases a sequence allocated to a transaction which has not been
+// broadcast. Only the last sequence allocated is released, as the following
+// ones are in use.
+func (m *SequenceManager) Release(addr sdk.AccAddress, seq uint64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.release(addr, seq)
+}
+
+// Track updates the sequences of an account with the response of the node to
+// a transaction signed with the given sequence.
+func (m *SequenceManager) Track(addr sdk.AccAddress, seq uint64, res sdk.TxResponse) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	switch {
+	case res.Code == 0:
+		return nil
+
+	case res.Codespace == sdkerrors.ErrTxInMempoolCache.Codespace() && res.Code == sdkerrors.ErrTxInMempoolCache.ABCICode():
+		// the transaction was broadcast before
+		return nil
+	}
+
+	wrongSeq, ok := authtypes.WrongSequenceFromResponse(res)
+	switch {
+	case ok && wrongSeq.Signer.Equals(addr) && wrongSeq.Expected > seq:
+		return m.skip(addr, wrongSeq.Expected)
+
+	case ok && wrongSeq.Signer.Equals(addr) && wrongSeq.Expected < seq:
+		return m.rewind(addr, wrongSeq.Expected)
+
+	case res.Height == 0:
+		// the transaction was rejected in CheckTx and did not use its sequence
+		return m.release(addr, seq)
+
+	default:
+		// the transaction failed in DeliverTx, which increments the sequence
+		return nil
+	}
+}
+
+// Reset drops the sequences allocated to an account, whose next sequence is
+// queried again.
+func (m *SequenceManager) Reset(addr sdk.AccAddress) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.accNums, string(addr))
+	return m.db.DeleteSync(addr)
+}
+
+// skip skips the allocated sequences of an account below the sequence expected
+// by the node, which were used by other transactions of the account.
+func (m *SequenceManager) skip(addr sdk.AccAddress, expected uint64) error {
+	next, _, err := m.load(addr)
+	if err != nil || expected <= next {
+		return err
+	}
+
+	return m.store(addr, expected)
+}
+
+// rewind allocates the sequences of an account again from the sequence expected
+// by the node, as the transactions allocated the sequences from it never
+// reached the mempool. A transaction signed with one of these sequences which
+// reaches the mempool in the meantime makes the next one skip it.
+func (m *SequenceManager) rewind(addr sdk.AccAddress, expected uint64) error {
+	next, _, err := m.load(addr)
+	if err != nil || expected >= next {
+		return err
+	}
+
+	return m.store(addr, expected)
+}
+
+func (m *SequenceManager) release(addr sdk.AccAddress, seq uint64) error {
+	next, persisted, err := m.load(addr)
+	if err != nil || !persisted || next != seq+1 {
+		return err
+	}
+
+	return m.store(addr, seq)
+}
+
+// load returns the next sequence of an account and whether it is persisted.
+func (m *SequenceManager) load(addr sdk.AccAddress) (uint64, bool, error) {
+	bz, err := m.db.Get(addr)
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+	if len(bz) != 8 {
+		return 0, false, fmt.Errorf("invalid persisted sequence of %s", addr)
+	}
+
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+func (m *SequenceManager) store(addr sdk.AccAddress, next uint64) error {
+	return m.db.SetSync(addr, sdk.Uint64ToBigEndian(next))
+}
+
+// sequences allocates the sequences of the transactions of accounts, see
+// SequenceManager.
+type sequences interface {
+	Next(addr sdk.AccAddress) (accNum, seq uint64, err error)
+	Release(addr sdk.AccAddress, seq uint64) error
+	Track(addr sdk.AccAddress, seq uint64, res sdk.TxResponse) error
+}
+
+var (
+	_ sequences = (*SequenceManager)(nil)
+	_ sequences = cliSequences{}
+)
+
+// cliSequences allocates sequences with the SequenceManager of the chain of a
+// CLI context, which is opened for every operation so that other processes can
+// use it in between.
+type cliSequences struct {
+	cliCtx context.CLIContext
+}
+
+func (s cliSequences) Next(addr sdk.AccAddress) (accNum, seq uint64, err error) {
+	err = s.with(func(m *SequenceManager) (err error) {
+		accNum, seq, err = m.Next(addr)
+		return err
+	})
+	return accNum, seq, err
+}
+
+func (s cliSequences) Release(addr sdk.AccAddress, seq uint64) error {
+	return s.with(func(m *SequenceManager) error { return m.Release(addr, seq) })
+}
+
+func (s cliSequences) Track(addr sdk.AccAddress, seq uint64, res sdk.TxResponse) error {
+	return s.with(func(m *SequenceManager) error { return m.Track(addr, seq, res) })
+}
+
+func (s cliSequences) with(f func(m *SequenceManager) error) error {
+	m, err := OpenSequenceManager(s.cliCtx)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	return f(m)
+}
+
+// managesSequence returns whether the sequence of a transaction is allocated
+// by the SequenceManager of the chain of the given context.
+func managesSequence(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) bool {
+	return cliCtx.ManageSequences && txBldr.Sequence() == 0 && !cliCtx.GenerateOnly && !cliCtx.Simulate
+}
+
+// nextManagedSequence allocates the next sequence of the account of the given
+// context.
+func nextManagedSequence(cliCtx context.CLIContext) (accNum, seq uint64, err error) {
+	return cliSequences{cliCtx}.Next(cliCtx.GetFromAddress())
+}
+
+// trackManagedSequence tracks the response of the node to a transaction signed
+// with an allocated sequence, and releases the sequence if the transaction has
+// not been broadcast.
+func trackManagedSequence(cliCtx context.CLIContext, seq uint64, res *sdk.TxResponse) error {
+	if res == nil {
+		return cliSequences{cliCtx}.Release(cliCtx.GetFromAddress(), seq)
+	}
+
+	return cliSequences{cliCtx}.Track(cliCtx.GetFromAddress(), seq, *res)
+}
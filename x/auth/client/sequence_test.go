@@ -0,0 +1,177 @@
+package client
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// wrongSequenceResponse returns the response of the node to a transaction of
+// addr whose signature does not verify with the expected sequence.
+func wrongSequenceResponse(addr sdk.AccAddress, expected uint64) sdk.TxResponse {
+	res := sdkerrors.ResponseCheckTx(authtypes.NewWrongSequenceError(addr, expected), 0, 0)
+	return sdk.TxResponse{Codespace: res.Codespace, Code: res.Code, Data: hex.EncodeToString(res.Data), RawLog: res.Log}
+}
+
+func TestSequenceManager(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	db := dbm.NewMemDB()
+
+	queries := 0
+	query := func(sdk.AccAddress) (uint64, uint64, error) {
+		queries++
+		return 7, 5, nil
+	}
+
+	m := NewSequenceManager(db, query)
+
+	// sequences are allocated locally after the first query
+	for i := uint64(0); i < 3; i++ {
+		accNum, seq, err := m.Next(addr)
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), accNum)
+		require.Equal(t, 5+i, seq)
+	}
+	require.Equal(t, 1, queries)
+
+	// only the last allocated sequence is released
+	require.NoError(t, m.Release(addr, 6))
+	require.NoError(t, m.Release(addr, 7))
+	_, seq, err := m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), seq)
+
+	// successful and committed transactions keep their sequence
+	require.NoError(t, m.Track(addr, 7, sdk.TxResponse{}))
+	require.NoError(t, m.Track(addr, 7, sdk.TxResponse{Code: 5, Height: 10}))
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), seq)
+
+	// transactions rejected in CheckTx release their sequence
+	require.NoError(t, m.Track(addr, 8, sdk.TxResponse{Codespace: "sdk", Code: 5}))
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), seq)
+
+	// the sequences used by other transactions are skipped
+	require.NoError(t, m.Track(addr, 8, wrongSequenceResponse(addr, 12)))
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), seq)
+
+	// a transaction signed with the expected sequence has another invalid
+	// signature and releases its sequence
+	require.NoError(t, m.Track(addr, 12, wrongSequenceResponse(addr, 12)))
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), seq)
+
+	// a wrong sequence error of another signer releases the sequence
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, m.Track(addr, 12, wrongSequenceResponse(other, 30)))
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), seq)
+
+	// the persisted sequences are used by a new manager unless the chain is
+	// ahead of them
+	m = NewSequenceManager(db, query)
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), seq)
+
+	m = NewSequenceManager(db, func(sdk.AccAddress) (uint64, uint64, error) { return 7, 20, nil })
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), seq)
+
+	// a transaction ahead of the expected sequence allocates the sequences
+	// again from it, as the ones in between never reached the mempool
+	for i := uint64(21); i <= 23; i++ {
+		_, seq, err = m.Next(addr)
+		require.NoError(t, err)
+		require.Equal(t, i, seq)
+	}
+	require.NoError(t, m.Track(addr, 23, wrongSequenceResponse(addr, 21)))
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21), seq)
+
+	// reset sequences are queried again
+	require.NoError(t, m.Reset(addr))
+	m.query = query
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), seq)
+}
+
+func TestSequenceManagerConcurrentSigners(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	m := NewSequenceManager(dbm.NewMemDB(), func(sdk.AccAddress) (uint64, uint64, error) {
+		return 0, 0, nil
+	})
+
+	const signers = 20
+	seqs := make(chan uint64, signers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < signers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, seq, err := m.Next(addr)
+			require.NoError(t, err)
+			seqs <- seq
+		}()
+	}
+	wg.Wait()
+	close(seqs)
+
+	allocated := make(map[uint64]bool)
+	for seq := range seqs {
+		require.False(t, allocated[seq], "sequence %d allocated twice", seq)
+		allocated[seq] = true
+	}
+	require.Len(t, allocated, signers)
+}
+
+func TestOpenSequenceManager(t *testing.T) {
+	_, err := OpenSequenceManager(context.CLIContext{})
+	require.Error(t, err)
+
+	home, err := ioutil.TempDir("", "sequences")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	cliCtx := context.CLIContext{HomeDir: home}.WithChainID("test-chain")
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	m, err := OpenSequenceManager(cliCtx)
+	require.NoError(t, err)
+	m.query = func(sdk.AccAddress) (uint64, uint64, error) { return 0, 3, nil }
+	_, seq, err := m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), seq)
+	require.NoError(t, m.Close())
+
+	// the sequences persist between runs
+	m, err = OpenSequenceManager(cliCtx)
+	require.NoError(t, err)
+	defer m.Close()
+	m.query = func(sdk.AccAddress) (uint64, uint64, error) { return 0, 0, nil }
+	_, seq, err = m.Next(addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), seq)
+}
@@ -54,12 +54,24 @@ func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr authtypes.TxBuild
 // QueryContext. It ensures that the account exists, has a proper number and
 // sequence set. In addition, it builds and signs a transaction with the
 // supplied messages. Finally, it broadcasts the signed transaction to a node.
-func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
-	txBldr, err := PrepareTxBuilder(txBldr, cliCtx)
+func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) (err error) {
+	managed := managesSequence(txBldr, cliCtx)
+	txBldr, err = PrepareTxBuilder(txBldr, cliCtx)
 	if err != nil {
 		return err
 	}
 
+	// the response updates the allocated sequences, which are released if the
+	// transaction is not broadcast
+	var broadcastRes *sdk.TxResponse
+	if managed {
+		defer func() {
+			if trackErr := trackManagedSequence(cliCtx, txBldr.Sequence(), broadcastRes); trackErr != nil && err == nil {
+				err = trackErr
+			}
+		}()
+	}
+
 	fromName := cliCtx.GetFromName()
 
 	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
@@ -113,6 +125,7 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 	if err != nil {
 		return err
 	}
+	broadcastRes = &res
 
 	return cliCtx.PrintOutput(res)
 }
@@ -297,6 +310,8 @@ func parseQueryResponse(cdc *codec.Codec, rawRes []byte) (uint64, error) {
 }
 
 // PrepareTxBuilder populates a TxBuilder in preparation for the build of a Tx.
+// The sequence is allocated by the SequenceManager of the chain if the context
+// manages sequences, and the caller must track the response to the transaction.
 func PrepareTxBuilder(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) (authtypes.TxBuilder, error) {
 	from := cliCtx.GetFromAddress()
 
@@ -305,6 +320,18 @@ func PrepareTxBuilder(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) (au
 		return txBldr, err
 	}
 
+	if managesSequence(txBldr, cliCtx) {
+		num, seq, err := nextManagedSequence(cliCtx)
+		if err != nil {
+			return txBldr, err
+		}
+
+		if txBldr.AccountNumber() == 0 {
+			txBldr = txBldr.WithAccountNumber(num)
+		}
+		return txBldr.WithSequence(seq), nil
+	}
+
 	txbldrAccNum, txbldrAccSeq := txBldr.AccountNumber(), txBldr.Sequence()
 	// TODO: (ref #1903) Allow for user supplied account number without
 	// automatically doing a manual lookup.
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// auth module with verified store reads.
+func VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return map[string]context.VerifiedQuerier{
+		fmt.Sprintf("custom/%s/%s", authtypes.QuerierRoute, authtypes.QueryAccount): QueryAccountVerified,
+	}
+}
+
+// QueryAccountVerified answers the account query with a verified read of the
+// account in the account store. Accounts are decoded with the account Codec.
+func QueryAccountVerified(cliCtx context.CLIContext, data []byte) ([]byte, error) {
+	if Codec == nil {
+		return nil, errors.New("the account codec is not set")
+	}
+
+	var params authtypes.QueryAccountParams
+	if err := Codec.UnmarshalJSON(data, &params); err != nil {
+		return nil, err
+	}
+
+	bz, _, err := cliCtx.QueryStore(authtypes.AddressStoreKey(params.Address), authtypes.StoreKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", params.Address)
+	}
+
+	account, err := Codec.UnmarshalAccount(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSONIndent(Codec, account)
+}
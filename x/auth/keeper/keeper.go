@@ -23,12 +23,17 @@ type AccountKeeper struct {
 
 	// The prototypical Account constructor.
 	proto func() exported.Account
+
+	// the address allowed to update the module parameters
+	authority sdk.AccAddress
 }
 
 // NewAccountKeeper returns a new sdk.AccountKeeper that uses go-amino to
-// (binary) encode and decode concrete sdk.Accounts.
+// (binary) encode and decode concrete sdk.Accounts. The authority is the only
+// address allowed to update the module parameters through MsgUpdateParams.
 func NewAccountKeeper(
 	cdc types.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace, proto func() exported.Account,
+	authority sdk.AccAddress,
 ) AccountKeeper {
 
 	return AccountKeeper{
@@ -36,9 +41,15 @@ func NewAccountKeeper(
 		proto:         proto,
 		cdc:           cdc,
 		paramSubspace: paramstore.WithKeyTable(types.ParamKeyTable()),
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (ak AccountKeeper) GetAuthority() sdk.AccAddress {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
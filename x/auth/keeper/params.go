@@ -2,16 +2,57 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// SetParams sets the auth module's parameters.
+// SetParams sets the auth module's parameters in the module store.
 func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	ak.paramSubspace.SetParamSet(ctx, &params)
+	ak.paramStore().Set(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// paramStore returns the store of the auth module parameters.
+func (ak AccountKeeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(ak.cdc, ak.key, types.ParamsKey, ak.paramSubspace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams gets the auth module's parameters. Parameters that have not been
+// migrated to the module store yet are read from the legacy param space.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
-	return
+	ak.paramStore().Get(ctx, &params)
+	return params
+}
+
+// UpdateParams validates and sets the auth module's parameters on behalf of
+// the given authority, which must be the authority of the module.
+func (ak AccountKeeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(ak.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", ak.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	ak.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the auth module parameters from the legacy x/params
+// subspace to the module store.
+func (ak AccountKeeper) MigrateParams(ctx sdk.Context) error {
+	return ak.paramStore().Migrate(ctx)
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (ak AccountKeeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return ak.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (ak AccountKeeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return ak.paramStore().SetParamSet(ctx, ps)
 }
@@ -0,0 +1,22 @@
+package v039
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MigrateSigVerifyCostSecp256r1 sets the secp256r1 signature verification cost
+// param to its default value, which is the cost charged for secp256r1
+// signatures before the param existed.
+func MigrateSigVerifyCostSecp256r1(ctx sdk.Context, k keeper.AccountKeeper) error {
+	params := k.GetParams(ctx)
+	params.SigVerifyCostSecp256r1 = types.DefaultSigVerifyCostSecp256r1
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
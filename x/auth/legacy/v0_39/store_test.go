@@ -0,0 +1,30 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	v039auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrateSigVerifyCostSecp256r1(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// params stored before the secp256r1 cost param existed
+	params := types.DefaultParams()
+	params.TxSigLimit = 3
+	params.SigVerifyCostSecp256r1 = 0
+	app.AccountKeeper.SetParams(ctx, params)
+
+	require.NoError(t, v039auth.MigrateSigVerifyCostSecp256r1(ctx, app.AccountKeeper))
+
+	migrated := app.AccountKeeper.GetParams(ctx)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, uint64(3), migrated.TxSigLimit)
+	require.Equal(t, types.DefaultSigVerifyCostSecp256r1, migrated.SigVerifyCostSecp256r1)
+}
@@ -13,17 +13,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	v039 "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                     = AppModule{}
+	_ module.AppModuleBasic                = AppModuleBasic{}
+	_ module.AppModuleSimulation           = AppModule{}
+	_ module.AppModuleBasicVerifiedQueries = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -70,6 +73,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(cdc)
 }
 
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// auth module with verified store reads.
+func (AppModuleBasic) VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return authclient.VerifiedQueriers()
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the auth module.
@@ -98,10 +107,10 @@ func (AppModule) Name() string {
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the auth module.
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return types.RouterKey }
 
 // NewHandler returns an sdk.Handler for the auth module.
-func (AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.accountKeeper) }
 
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
@@ -138,6 +147,27 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterMigrations registers the in-place store migrations of the auth
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.accountKeeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 adds the secp256r1 signature verification cost param
+	err = cfg.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return v039.MigrateSigVerifyCostSecp256r1(ctx, am.accountKeeper)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -26,6 +26,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &globalAccNumberB)
 		return fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumberA, globalAccNumberB)
 
+	case bytes.Equal(kvA.Key, types.ParamsKey):
+		var paramsA, paramsB types.Params
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &paramsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &paramsB)
+		return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
 	default:
 		panic(fmt.Sprintf("invalid account key %X", kvA.Key))
 	}
@@ -1,10 +1,87 @@
 package types
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
 	ErrorInvalidSigner        = sdkerrors.Register(ModuleName, 2, "tx intended signer does not match the given signer")
 	ErrorInvalidGasAdjustment = sdkerrors.Register(ModuleName, 3, "invalid gas adjustment")
+	ErrWrongSequence          = sdkerrors.Register(ModuleName, 4, "account sequence mismatch")
 )
+
+// WrongSequenceError is the error of a new tx whose signature does not verify
+// with the Expected sequence of its signer, i.e. the sequence of the account in
+// the check state. The sequence a tx is signed with is only part of its sign
+// bytes, so that a signature for another chain ID or account number fails the
+// same way: the signer knows the sequence it signed, and only signs again if it
+// differs from the expected one.
+//
+// The error is an ErrUnauthorized whose ABCI code is the one of
+// ErrWrongSequence, and it is the data of the CheckTx response, from which
+// clients read it with WrongSequenceFromResponse.
+type WrongSequenceError struct {
+	Signer   sdk.AccAddress `json:"signer"`
+	Expected uint64         `json:"expected,string"`
+}
+
+// NewWrongSequenceError returns the error of a signature which does not verify
+// with the given sequence of its signer.
+func NewWrongSequenceError(signer sdk.AccAddress, expected uint64) *WrongSequenceError {
+	return &WrongSequenceError{Signer: signer, Expected: expected}
+}
+
+func (e *WrongSequenceError) Error() string {
+	return fmt.Sprintf(
+		"signature verification failed; verify correct account sequence and chain-id: %s of %s, expected %d",
+		ErrWrongSequence, e.Signer, e.Expected,
+	)
+}
+
+// ABCICode implements the ABCI code of the error, the one of ErrWrongSequence.
+func (e *WrongSequenceError) ABCICode() uint32 { return ErrWrongSequence.ABCICode() }
+
+// Codespace implements the ABCI codespace of the error.
+func (e *WrongSequenceError) Codespace() string { return ErrWrongSequence.Codespace() }
+
+// ABCIData implements the ABCI data of the error, its JSON encoding.
+func (e *WrongSequenceError) ABCIData() []byte {
+	bz, err := json.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Cause returns ErrUnauthorized, the error of invalid signatures.
+func (e *WrongSequenceError) Cause() error { return sdkerrors.ErrUnauthorized }
+
+// Is returns whether the target is ErrWrongSequence.
+func (e *WrongSequenceError) Is(target error) bool { return target == ErrWrongSequence }
+
+// WrongSequenceFromResponse returns the WrongSequenceError of a tx response,
+// if any. Responses of the sync broadcast mode have no codespace, so that the
+// error is identified by its code and data.
+func WrongSequenceFromResponse(res sdk.TxResponse) (*WrongSequenceError, bool) {
+	if res.Code != ErrWrongSequence.ABCICode() || (res.Codespace != "" && res.Codespace != ErrWrongSequence.Codespace()) {
+		return nil, false
+	}
+
+	bz, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, false
+	}
+
+	var wrongSeq WrongSequenceError
+	if err := json.Unmarshal(bz, &wrongSeq); err != nil || wrongSeq.Signer.Empty() {
+		return nil, false
+	}
+
+	return &wrongSeq, true
+}
@@ -16,6 +16,12 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
+
+	// AttributeValueCategory is the event attribute value of the auth module
+	AttributeValueCategory = ModuleName
 )
 
 var (
@@ -24,6 +30,9 @@ var (
 
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
+
+	// ParamsKey is the key of the auth module parameters
+	ParamsKey = []byte{0x02}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+
+	invalidParams := DefaultParams()
+	invalidParams.TxSigLimit = 0
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		params     Params
+		expectPass bool
+	}{
+		{authority, DefaultParams(), true},
+		{nil, DefaultParams(), false},
+		{authority, invalidParams, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgUpdateParams(tc.authority, tc.params)
+		require.Equal(t, RouterKey, msg.Route())
+		require.Equal(t, TypeMsgUpdateParams, msg.Type())
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -18,6 +18,7 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultSigVerifyCostSecp256r1 uint64 = 1000
 )
 
 // Parameter keys
@@ -27,13 +28,16 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeySigVerifyCostSecp256r1 = []byte("SigVerifyCostSecp256r1")
+	KeySigVerifyCosts         = []byte("SigVerifyCosts")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
 // NewParams creates a new Params object
 func NewParams(
-	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1,
+	sigVerifyCostSecp256r1 uint64,
 ) Params {
 
 	return Params{
@@ -42,6 +46,7 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		SigVerifyCostSecp256r1: sigVerifyCostSecp256r1,
 	}
 }
 
@@ -60,6 +65,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256r1, &p.SigVerifyCostSecp256r1, validateSigVerifyCostSecp256r1),
+		paramtypes.NewParamSetPair(KeySigVerifyCosts, &p.SigVerifyCosts, validateSigVerifyCosts),
 	}
 }
 
@@ -71,6 +78,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		SigVerifyCostSecp256r1: DefaultSigVerifyCostSecp256r1,
 	}
 }
 
@@ -80,6 +88,18 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// GetSigVerifyCost returns the gas consumed to verify a signature of the given
+// signing algorithm, registered besides the built-in ones.
+func (p Params) GetSigVerifyCost(algo string) (uint64, bool) {
+	for _, c := range p.SigVerifyCosts {
+		if c.Algo == algo {
+			return c.Cost, true
+		}
+	}
+
+	return 0, false
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -119,6 +139,43 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 	return nil
 }
 
+func validateSigVerifyCostSecp256r1(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid secp256r1 signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
+func validateSigVerifyCosts(i interface{}) error {
+	v, ok := i.([]SigVerifyCost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	algos := make(map[string]bool, len(v))
+	for _, c := range v {
+		if c.Algo == "" {
+			return fmt.Errorf("signature verification cost without signing algorithm")
+		}
+		if algos[c.Algo] {
+			return fmt.Errorf("duplicate signature verification cost of %s", c.Algo)
+		}
+		if c.Cost == 0 {
+			return fmt.Errorf("invalid %s signature verification cost: %d", c.Algo, c.Cost)
+		}
+
+		algos[c.Algo] = true
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -156,6 +213,12 @@ func (p Params) Validate() error {
 	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
 		return err
 	}
+	if err := validateSigVerifyCostSecp256r1(p.SigVerifyCostSecp256r1); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCosts(p.SigVerifyCosts); err != nil {
+		return err
+	}
 	if err := validateSigVerifyCostSecp256k1(p.MaxMemoCharacters); err != nil {
 		return err
 	}
@@ -14,3 +14,25 @@ func TestParamsEqual(t *testing.T) {
 	p1.TxSigLimit += 10
 	require.NotEqual(t, p1, p2)
 }
+
+func TestSigVerifyCosts(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+	_, ok := p.GetSigVerifyCost("sr25519")
+	require.False(t, ok)
+
+	p.SigVerifyCosts = []SigVerifyCost{{Algo: "sr25519", Cost: 700}, {Algo: "plugin", Cost: 900}}
+	require.NoError(t, p.Validate())
+	cost, ok := p.GetSigVerifyCost("plugin")
+	require.True(t, ok)
+	require.Equal(t, uint64(900), cost)
+
+	for _, costs := range [][]SigVerifyCost{
+		{{Algo: "", Cost: 700}},
+		{{Algo: "sr25519", Cost: 0}},
+		{{Algo: "sr25519", Cost: 700}, {Algo: "sr25519", Cost: 900}},
+	} {
+		p.SigVerifyCosts = costs
+		require.Error(t, p.Validate())
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -122,19 +123,75 @@ func (m *StdFee) GetGas() uint64 {
 	return 0
 }
 
+// MsgUpdateParams defines an SDK message for replacing the parameters of the
+// auth module. It must be signed by the module authority.
+type MsgUpdateParams struct {
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+	Params    Params                                        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
-	TxSigLimit             uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	MaxMemoCharacters      uint64          `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
+	TxSigLimit             uint64          `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
+	TxSizeCostPerByte      uint64          `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519   uint64          `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1 uint64          `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	SigVerifyCostSecp256r1 uint64          `protobuf:"varint,6,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
+	SigVerifyCosts         []SigVerifyCost `protobuf:"bytes,7,rep,name=sig_verify_costs,json=sigVerifyCosts,proto3" json:"sig_verify_costs" yaml:"sig_verify_costs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d526fa662daab74, []int{2}
+	return fileDescriptor_2d526fa662daab74, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,56 +255,135 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCosts() []SigVerifyCost {
+	if m != nil {
+		return m.SigVerifyCosts
+	}
+	return nil
+}
+
+// SigVerifyCost defines the gas consumed to verify a signature of the public
+// keys of a signing algorithm registered besides the built-in ones.
+type SigVerifyCost struct {
+	Algo string `protobuf:"bytes,1,opt,name=algo,proto3" json:"algo,omitempty" yaml:"algo"`
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty" yaml:"cost"`
+}
+
+func (m *SigVerifyCost) Reset()         { *m = SigVerifyCost{} }
+func (m *SigVerifyCost) String() string { return proto.CompactTextString(m) }
+func (*SigVerifyCost) ProtoMessage()    {}
+func (*SigVerifyCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{4}
+}
+func (m *SigVerifyCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigVerifyCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigVerifyCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigVerifyCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigVerifyCost.Merge(m, src)
+}
+func (m *SigVerifyCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigVerifyCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigVerifyCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigVerifyCost proto.InternalMessageInfo
+
+func (m *SigVerifyCost) GetAlgo() string {
+	if m != nil {
+		return m.Algo
+	}
+	return ""
+}
+
+func (m *SigVerifyCost) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos_sdk.x.auth.v1.BaseAccount")
 	proto.RegisterType((*StdFee)(nil), "cosmos_sdk.x.auth.v1.StdFee")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos_sdk.x.auth.v1.MsgUpdateParams")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.auth.v1.Params")
+	proto.RegisterType((*SigVerifyCost)(nil), "cosmos_sdk.x.auth.v1.SigVerifyCost")
 }
 
 func init() { proto.RegisterFile("x/auth/types/types.proto", fileDescriptor_2d526fa662daab74) }
 
 var fileDescriptor_2d526fa662daab74 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8f, 0x9b, 0x7c, 0xd3, 0xea, 0xda, 0x2f, 0x22, 0x6e, 0xda, 0xba, 0x11, 0xf2, 0x45, 0x1e,
-	0x50, 0x90, 0xa8, 0x43, 0x8a, 0x8a, 0xd4, 0x0c, 0x88, 0x3a, 0xd0, 0xa5, 0x50, 0x55, 0x8e, 0xc4,
-	0x80, 0x84, 0xac, 0xb3, 0x7d, 0x38, 0x56, 0x7a, 0x39, 0xd7, 0x77, 0xae, 0xec, 0x2e, 0xac, 0x88,
-	0x89, 0x91, 0xb1, 0x33, 0x7f, 0x49, 0xc7, 0x8e, 0x4c, 0x2e, 0x4a, 0x17, 0xc4, 0xe8, 0x91, 0x09,
-	0xd9, 0x97, 0xb6, 0x69, 0x29, 0x88, 0xc5, 0xbe, 0xf7, 0xde, 0xe7, 0xc7, 0xf9, 0xbd, 0xf3, 0x01,
-	0x25, 0x6e, 0xa3, 0x88, 0x0f, 0xda, 0x3c, 0x09, 0x30, 0x13, 0x4f, 0x3d, 0x08, 0x29, 0xa7, 0x72,
-	0xdd, 0xa1, 0x8c, 0x50, 0x66, 0x31, 0x77, 0xa8, 0xc7, 0x7a, 0x0e, 0xd2, 0x0f, 0x3b, 0x8d, 0xfb,
-	0x7c, 0xe0, 0x87, 0xae, 0x15, 0xa0, 0x90, 0x27, 0xed, 0x02, 0xd8, 0xf6, 0xa8, 0x47, 0xaf, 0x56,
-	0x82, 0xdd, 0xa8, 0xfd, 0x26, 0xa8, 0x7d, 0x9c, 0x01, 0xf3, 0x06, 0x62, 0x78, 0xcb, 0x71, 0x68,
-	0x34, 0xe2, 0xf2, 0x0e, 0x98, 0x45, 0xae, 0x1b, 0x62, 0xc6, 0x14, 0xa9, 0x29, 0xb5, 0x16, 0x8c,
-	0xce, 0xcf, 0x14, 0xae, 0x79, 0x3e, 0x1f, 0x44, 0xb6, 0xee, 0x50, 0xd2, 0x16, 0x1b, 0x98, 0xbc,
-	0xd6, 0x98, 0x3b, 0x9c, 0xc8, 0x6d, 0x39, 0xce, 0x96, 0x20, 0x9a, 0x17, 0x0a, 0xf2, 0x36, 0x98,
-	0x0d, 0x22, 0xdb, 0x1a, 0xe2, 0x44, 0x99, 0x29, 0xc4, 0xd6, 0x7e, 0xa4, 0xb0, 0x1e, 0x44, 0xf6,
-	0xbe, 0xef, 0xe4, 0xd9, 0x87, 0x94, 0xf8, 0x1c, 0x93, 0x80, 0x27, 0x59, 0x0a, 0x6b, 0x09, 0x22,
-	0xfb, 0x5d, 0xed, 0xaa, 0xaa, 0x99, 0xd5, 0x20, 0xb2, 0x77, 0x70, 0x22, 0x3f, 0x03, 0x77, 0x90,
-	0xd8, 0x9f, 0x35, 0x8a, 0x88, 0x8d, 0x43, 0xa5, 0xdc, 0x94, 0x5a, 0x15, 0x63, 0x35, 0x4b, 0xe1,
-	0x92, 0xa0, 0x5d, 0xaf, 0x6b, 0xe6, 0xff, 0x93, 0xc4, 0x6e, 0x11, 0xcb, 0x0d, 0x30, 0xc7, 0xf0,
-	0x41, 0x84, 0x47, 0x0e, 0x56, 0x2a, 0x39, 0xd7, 0xbc, 0x8c, 0xbb, 0x73, 0x1f, 0x8e, 0x61, 0xe9,
-	0xf3, 0x31, 0x2c, 0x69, 0xef, 0x41, 0xb5, 0xcf, 0xdd, 0x6d, 0x8c, 0xe5, 0xb7, 0xa0, 0x8a, 0x48,
-	0xce, 0x57, 0xa4, 0x66, 0xb9, 0x35, 0xbf, 0xbe, 0xa8, 0x4f, 0x35, 0xfe, 0xb0, 0xa3, 0xf7, 0xa8,
-	0x3f, 0x32, 0x1e, 0x9d, 0xa4, 0xb0, 0xf4, 0xe5, 0x0c, 0xb6, 0xfe, 0xa1, 0x3d, 0x39, 0x81, 0x99,
-	0x13, 0x51, 0xf9, 0x2e, 0x28, 0x7b, 0x88, 0x15, 0x4d, 0xa9, 0x98, 0xf9, 0xb2, 0x5b, 0xf9, 0x7e,
-	0x0c, 0x25, 0xed, 0xac, 0x0c, 0xaa, 0x7b, 0x28, 0x44, 0x84, 0xc9, 0xbb, 0x60, 0x91, 0xa0, 0xd8,
-	0x22, 0x98, 0x50, 0xcb, 0x19, 0xa0, 0x10, 0x39, 0x1c, 0x87, 0x62, 0x28, 0x15, 0x43, 0xcd, 0x52,
-	0xd8, 0x10, 0x1f, 0x7e, 0x0b, 0x48, 0x33, 0x6b, 0x04, 0xc5, 0xaf, 0x30, 0xa1, 0xbd, 0xcb, 0x9c,
-	0xbc, 0x09, 0x16, 0x78, 0x6c, 0x31, 0xdf, 0xb3, 0xf6, 0x7d, 0xe2, 0x73, 0xe1, 0x6d, 0xac, 0x64,
-	0x29, 0x5c, 0x14, 0x42, 0xd3, 0x55, 0xcd, 0x04, 0x3c, 0xee, 0xfb, 0xde, 0xcb, 0x3c, 0x90, 0x4d,
-	0xb0, 0x54, 0x14, 0x8f, 0xb0, 0xe5, 0x50, 0xc6, 0xad, 0x00, 0x87, 0x96, 0x9d, 0x70, 0x3c, 0x99,
-	0x42, 0x33, 0x4b, 0xe1, 0xbd, 0x29, 0x8d, 0x9b, 0x30, 0xcd, 0xac, 0xe5, 0x62, 0x47, 0xb8, 0x47,
-	0x19, 0xdf, 0xc3, 0xa1, 0x91, 0x70, 0x2c, 0x1f, 0x80, 0x95, 0xdc, 0xed, 0x10, 0x87, 0xfe, 0xbb,
-	0x44, 0xe0, 0xb1, 0xbb, 0xbe, 0xb1, 0xd1, 0xd9, 0x14, 0xf3, 0x31, 0xba, 0xe3, 0x14, 0xd6, 0xfb,
-	0xbe, 0xf7, 0xba, 0x40, 0xe4, 0xd4, 0x17, 0xcf, 0x8b, 0x7a, 0x96, 0x42, 0x55, 0xb8, 0xfd, 0x41,
-	0x40, 0x33, 0xeb, 0xec, 0x1a, 0x4f, 0xa4, 0xe5, 0x04, 0xac, 0xde, 0x64, 0x30, 0xec, 0x04, 0xeb,
-	0x1b, 0x4f, 0x86, 0x1d, 0xe5, 0xbf, 0xc2, 0xf4, 0xe9, 0x38, 0x85, 0xcb, 0xd7, 0x4c, 0xfb, 0x17,
-	0x88, 0x2c, 0x85, 0xcd, 0xdb, 0x6d, 0x2f, 0x45, 0x34, 0x73, 0x99, 0xdd, 0xca, 0xed, 0xce, 0xe5,
-	0xc7, 0x2b, 0x9f, 0xb0, 0xd1, 0x3b, 0x19, 0xab, 0xd2, 0xe9, 0x58, 0x95, 0xbe, 0x8d, 0x55, 0xe9,
-	0xd3, 0xb9, 0x5a, 0x3a, 0x3d, 0x57, 0x4b, 0x5f, 0xcf, 0xd5, 0xd2, 0x9b, 0x07, 0x7f, 0x3d, 0x45,
-	0xd3, 0x37, 0x82, 0x5d, 0x2d, 0xfe, 0xdd, 0xc7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xce, 0x6d,
-	0xf3, 0x2e, 0x28, 0x04, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbd, 0x6f, 0xdb, 0x46,
+	0x14, 0x17, 0x63, 0x45, 0x76, 0xce, 0xf9, 0x32, 0xed, 0xc4, 0x8c, 0x10, 0xf0, 0x04, 0x06, 0x28,
+	0x54, 0xa0, 0xa6, 0x2a, 0x15, 0x2e, 0x10, 0x0d, 0x45, 0x4d, 0xb5, 0x59, 0xd2, 0xa4, 0xc1, 0x09,
+	0xed, 0x50, 0xa0, 0x20, 0x4e, 0xe4, 0x95, 0x22, 0x24, 0xea, 0x98, 0xbb, 0xa3, 0x21, 0x66, 0xe9,
+	0x5a, 0x74, 0xea, 0xd8, 0xd1, 0x43, 0xa7, 0xfe, 0x25, 0x41, 0xa7, 0x8c, 0x9d, 0xd8, 0x42, 0x5e,
+	0x8a, 0x8e, 0x1c, 0x3b, 0x15, 0x77, 0xc7, 0xd8, 0x92, 0x2b, 0xbb, 0x45, 0x17, 0x89, 0xf7, 0xde,
+	0xef, 0xe3, 0xe9, 0xee, 0x77, 0x14, 0xb0, 0xe6, 0x1d, 0x9c, 0x89, 0x71, 0x47, 0xe4, 0x29, 0xe1,
+	0xfa, 0xd3, 0x4d, 0x19, 0x15, 0xd4, 0xdc, 0x0b, 0x28, 0x4f, 0x28, 0xf7, 0x79, 0x38, 0x71, 0xe7,
+	0xae, 0x04, 0xb9, 0xc7, 0xdd, 0xe6, 0x3b, 0x62, 0x1c, 0xb3, 0xd0, 0x4f, 0x31, 0x13, 0x79, 0x47,
+	0x01, 0x3b, 0x11, 0x8d, 0xe8, 0xf9, 0x93, 0x66, 0x37, 0x77, 0xfe, 0x21, 0xe8, 0x7c, 0x7f, 0x0d,
+	0x6c, 0x7b, 0x98, 0x93, 0xa3, 0x20, 0xa0, 0xd9, 0x4c, 0x98, 0x4f, 0xc1, 0x26, 0x0e, 0x43, 0x46,
+	0x38, 0xb7, 0x8c, 0x96, 0xd1, 0xbe, 0xe9, 0x75, 0xff, 0x2a, 0xe0, 0x41, 0x14, 0x8b, 0x71, 0x36,
+	0x72, 0x03, 0x9a, 0x74, 0xf4, 0x00, 0xd5, 0xd7, 0x01, 0x0f, 0x27, 0x95, 0xdc, 0x51, 0x10, 0x1c,
+	0x69, 0x22, 0x7a, 0xab, 0x60, 0x3e, 0x01, 0x9b, 0x69, 0x36, 0xf2, 0x27, 0x24, 0xb7, 0xae, 0x29,
+	0xb1, 0x83, 0x3f, 0x0b, 0xb8, 0x97, 0x66, 0xa3, 0x69, 0x1c, 0xc8, 0xea, 0x7b, 0x34, 0x89, 0x05,
+	0x49, 0x52, 0x91, 0x97, 0x05, 0xdc, 0xc9, 0x71, 0x32, 0xed, 0x3b, 0xe7, 0x5d, 0x07, 0x35, 0xd2,
+	0x6c, 0xf4, 0x94, 0xe4, 0xe6, 0xc7, 0xe0, 0x36, 0xd6, 0xf3, 0xf9, 0xb3, 0x2c, 0x19, 0x11, 0x66,
+	0x6d, 0xb4, 0x8c, 0x76, 0xdd, 0x7b, 0x50, 0x16, 0xf0, 0x9e, 0xa6, 0xad, 0xf6, 0x1d, 0x74, 0xab,
+	0x2a, 0x3c, 0x57, 0x6b, 0xb3, 0x09, 0xb6, 0x38, 0x79, 0x99, 0x91, 0x59, 0x40, 0xac, 0xba, 0xe4,
+	0xa2, 0xb3, 0x75, 0x7f, 0xeb, 0xbb, 0x13, 0x58, 0xfb, 0xf1, 0x04, 0xd6, 0x9c, 0x6f, 0x41, 0x63,
+	0x28, 0xc2, 0x27, 0x84, 0x98, 0x5f, 0x83, 0x06, 0x4e, 0x24, 0xdf, 0x32, 0x5a, 0x1b, 0xed, 0xed,
+	0xde, 0xae, 0xbb, 0xb4, 0xf1, 0xc7, 0x5d, 0x77, 0x40, 0xe3, 0x99, 0xf7, 0xfe, 0xeb, 0x02, 0xd6,
+	0x7e, 0xfe, 0x0d, 0xb6, 0xff, 0xc3, 0xf6, 0x48, 0x02, 0x47, 0x95, 0xa8, 0x79, 0x17, 0x6c, 0x44,
+	0x98, 0xab, 0x4d, 0xa9, 0x23, 0xf9, 0xd8, 0xaf, 0xff, 0x71, 0x02, 0x0d, 0xe7, 0x27, 0x03, 0xdc,
+	0x79, 0xc6, 0xa3, 0x2f, 0xd2, 0x10, 0x0b, 0xf2, 0x02, 0x33, 0x9c, 0x70, 0xf3, 0x73, 0x70, 0x43,
+	0x9e, 0x33, 0x65, 0xb1, 0xc8, 0xff, 0xff, 0x99, 0x9c, 0x6b, 0x98, 0x7d, 0xd0, 0x48, 0x95, 0xb4,
+	0xf2, 0xdf, 0xee, 0x3d, 0x74, 0xd7, 0x85, 0xca, 0xd5, 0xf6, 0x5e, 0x5d, 0xfe, 0x48, 0x54, 0x31,
+	0xaa, 0x31, 0x7f, 0xb9, 0x0e, 0x1a, 0xd5, 0x74, 0xcf, 0xc1, 0x6e, 0x82, 0xe7, 0x7e, 0x42, 0x12,
+	0xea, 0x07, 0x63, 0xcc, 0x70, 0x20, 0x08, 0xd3, 0xd9, 0xa9, 0x7b, 0x76, 0x59, 0xc0, 0xa6, 0x3e,
+	0x9f, 0x35, 0x20, 0x07, 0xed, 0x24, 0x78, 0xfe, 0x8c, 0x24, 0x74, 0x70, 0x56, 0x33, 0x1f, 0x83,
+	0x9b, 0x62, 0xee, 0xf3, 0x38, 0xf2, 0xa7, 0x71, 0x12, 0x0b, 0xbd, 0x45, 0xde, 0x7e, 0x59, 0xc0,
+	0x5d, 0x2d, 0xb4, 0xdc, 0x75, 0x10, 0x10, 0xf3, 0x61, 0x1c, 0x7d, 0x26, 0x17, 0x26, 0x02, 0xf7,
+	0x54, 0xf3, 0x15, 0xf1, 0x03, 0xca, 0x85, 0x9f, 0x12, 0xe6, 0x8f, 0x72, 0x41, 0xaa, 0xb0, 0xb4,
+	0xca, 0x02, 0x3e, 0x5c, 0xd2, 0xb8, 0x08, 0x73, 0xd0, 0x8e, 0x14, 0x7b, 0x45, 0x06, 0x94, 0x8b,
+	0x17, 0x84, 0x79, 0xb9, 0x20, 0xe6, 0x4b, 0xb0, 0x2f, 0xdd, 0x8e, 0x09, 0x8b, 0xbf, 0xc9, 0x35,
+	0x9e, 0x84, 0xbd, 0xc3, 0xc3, 0xee, 0x63, 0x1d, 0x23, 0xaf, 0xbf, 0x28, 0xe0, 0xde, 0x30, 0x8e,
+	0xbe, 0x54, 0x08, 0x49, 0xfd, 0xf4, 0x13, 0xd5, 0x2f, 0x0b, 0x68, 0x6b, 0xb7, 0x4b, 0x04, 0x1c,
+	0xb4, 0xc7, 0x57, 0x78, 0xba, 0x6c, 0xe6, 0xe0, 0xc1, 0x45, 0x06, 0x27, 0x41, 0xda, 0x3b, 0xfc,
+	0x70, 0xd2, 0xb5, 0xae, 0x2b, 0xd3, 0x8f, 0x16, 0x05, 0xbc, 0xbf, 0x62, 0x3a, 0x7c, 0x8b, 0x28,
+	0x0b, 0xd8, 0x5a, 0x6f, 0x7b, 0x26, 0xe2, 0xa0, 0xfb, 0x7c, 0x2d, 0xf7, 0x0a, 0x6b, 0xd6, 0xb5,
+	0x1a, 0x57, 0x5b, 0xb3, 0x7f, 0xb7, 0x66, 0x97, 0x59, 0xb3, 0xae, 0x39, 0x03, 0x77, 0x2f, 0xb0,
+	0xb8, 0xb5, 0xa9, 0xae, 0xde, 0xa3, 0xf5, 0xf1, 0x5c, 0x99, 0xc1, 0x83, 0x32, 0xa5, 0x65, 0x01,
+	0xf7, 0xd7, 0x0e, 0xc0, 0x1d, 0x74, 0x7b, 0xc5, 0x97, 0xf7, 0xb7, 0xe4, 0x85, 0x57, 0x61, 0xf6,
+	0xc1, 0xad, 0x15, 0x2d, 0xf3, 0x11, 0xa8, 0xe3, 0x69, 0x44, 0x55, 0x86, 0x6f, 0x78, 0x77, 0xca,
+	0x02, 0x6e, 0x57, 0xef, 0x98, 0x69, 0x44, 0x1d, 0xa4, 0x9a, 0x12, 0x24, 0x95, 0xab, 0x7c, 0x2e,
+	0x81, 0x64, 0xd5, 0x41, 0xaa, 0xa9, 0x6f, 0x8b, 0x37, 0x78, 0xbd, 0xb0, 0x8d, 0x37, 0x0b, 0xdb,
+	0xf8, 0x7d, 0x61, 0x1b, 0x3f, 0x9c, 0xda, 0xb5, 0x37, 0xa7, 0x76, 0xed, 0xd7, 0x53, 0xbb, 0xf6,
+	0xd5, 0xbb, 0x57, 0xde, 0xe1, 0xe5, 0x3f, 0x81, 0x51, 0x43, 0xbd, 0xae, 0x3f, 0xf8, 0x7b, 0x00,
+	0xa4, 0x7c, 0xdd, 0x92, 0x1b, 0x06, 0x00, 0x00,
 }
 
 func (this *StdFee) Equal(that interface{}) bool {
@@ -282,6 +418,33 @@ func (this *StdFee) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Authority, that1.Authority) {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -316,6 +479,44 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	if len(this.SigVerifyCosts) != len(that1.SigVerifyCosts) {
+		return false
+	}
+	for i := range this.SigVerifyCosts {
+		if !this.SigVerifyCosts[i].Equal(&that1.SigVerifyCosts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SigVerifyCost) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigVerifyCost)
+	if !ok {
+		that2, ok := that.(SigVerifyCost)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Algo != that1.Algo {
+		return false
+	}
+	if this.Cost != that1.Cost {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -407,6 +608,46 @@ func (m *StdFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -427,6 +668,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SigVerifyCosts) > 0 {
+		for iNdEx := len(m.SigVerifyCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigVerifyCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -455,6 +715,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigVerifyCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigVerifyCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigVerifyCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Algo) > 0 {
+		i -= len(m.Algo)
+		copy(dAtA[i:], m.Algo)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Algo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -507,6 +802,21 @@ func (m *StdFee) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -528,6 +838,31 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256r1))
+	}
+	if len(m.SigVerifyCosts) > 0 {
+		for _, e := range m.SigVerifyCosts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SigVerifyCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Algo)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovTypes(uint64(m.Cost))
+	}
 	return n
 }
 
@@ -802,6 +1137,126 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -926,6 +1381,163 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigVerifyCosts = append(m.SigVerifyCosts, SigVerifyCost{})
+			if err := m.SigVerifyCosts[len(m.SigVerifyCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigVerifyCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigVerifyCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigVerifyCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
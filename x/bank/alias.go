@@ -42,15 +42,19 @@ var (
 	GetGenesisStateFromAppState = types.GetGenesisStateFromAppState
 	NewMsgSend                  = types.NewMsgSend
 	NewMsgMultiSend             = types.NewMsgMultiSend
+	NewMsgUpdateParams          = types.NewMsgUpdateParams
 	NewInput                    = types.NewInput
 	NewOutput                   = types.NewOutput
 	ValidateInputsOutputs       = types.ValidateInputsOutputs
 	ParamKeyTable               = types.ParamKeyTable
+	NewParams                   = types.NewParams
+	DefaultParams               = types.DefaultParams
 	NewQueryBalanceParams       = types.NewQueryBalanceParams
 	NewQueryAllBalancesParams   = types.NewQueryAllBalancesParams
 	ModuleCdc                   = types.ModuleCdc
 	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
 	BalancesPrefix              = types.BalancesPrefix
+	ParamsKey                   = types.ParamsKey
 	AddressFromBalancesStore    = types.AddressFromBalancesStore
 )
 
@@ -65,6 +69,8 @@ type (
 	Balance                 = types.Balance
 	MsgSend                 = types.MsgSend
 	MsgMultiSend            = types.MsgMultiSend
+	MsgUpdateParams         = types.MsgUpdateParams
+	Params                  = types.Params
 	Input                   = types.Input
 	Output                  = types.Output
 	QueryBalanceParams      = types.QueryBalanceParams
@@ -4,10 +4,25 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
-	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	rest.Document(r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction sending coins from the base request sender to an address",
+		Request:  SendReq{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the balances of an account",
+		Description: "The result is the balance of the given denomination, as a single coin, when denom is set.",
+		QueryParams: []rest.QueryParam{
+			rest.HeightParam,
+			{Name: "denom", Description: "denomination of the balance to query"},
+		},
+		Result: sdk.Coins{},
+	})
 }
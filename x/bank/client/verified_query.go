@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// bank module with verified store reads.
+func VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return map[string]context.VerifiedQuerier{
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBalance): QueryBalanceVerified,
+	}
+}
+
+// QueryBalanceVerified answers the balance query with a verified read of the
+// balance in the bank store.
+func QueryBalanceVerified(cliCtx context.CLIContext, data []byte) ([]byte, error) {
+	var params types.QueryBalanceParams
+	if err := types.ModuleCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, err
+	}
+
+	key := append(append(append([]byte{}, types.BalancesPrefix...), params.Address.Bytes()...), params.Denom...)
+	bz, _, err := cliCtx.QueryStore(key, types.StoreKey)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := sdk.NewCoin(params.Denom, sdk.ZeroInt())
+	if bz != nil {
+		if err := types.ModuleCdc.UnmarshalBinaryBare(bz, &balance); err != nil {
+			return nil, err
+		}
+	}
+
+	return codec.MarshalJSONIndent(types.ModuleCdc, balance)
+}
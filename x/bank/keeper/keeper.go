@@ -24,6 +24,13 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
+
+	GetAuthority() sdk.AccAddress
+	UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error
+	MigrateParams(ctx sdk.Context) error
+
+	GetParamSet(ctx sdk.Context) paramtypes.ParamSet
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -32,10 +39,14 @@ type BaseKeeper struct {
 
 	ak         types.AccountKeeper
 	paramSpace paramtypes.Subspace
+	authority  sdk.AccAddress
 }
 
+// NewBaseKeeper creates a new bank BaseKeeper. The authority is the only
+// address allowed to update the module parameters through MsgUpdateParams.
 func NewBaseKeeper(
 	cdc codec.Marshaler, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blacklistedAddrs map[string]bool,
+	authority sdk.AccAddress,
 ) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -43,6 +54,7 @@ func NewBaseKeeper(
 		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, ps, blacklistedAddrs),
 		ak:             ak,
 		paramSpace:     ps,
+		authority:      authority,
 	}
 }
 
@@ -133,6 +145,9 @@ type SendKeeper interface {
 	SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error
 	SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
 
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
 
@@ -349,14 +364,14 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 
 // GetSendEnabled returns the current SendEnabled
 func (k BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
-	var enabled bool
-	k.paramSpace.Get(ctx, types.ParamStoreKeySendEnabled, &enabled)
-	return enabled
+	return k.GetParams(ctx).SendEnabled
 }
 
 // SetSendEnabled sets the send enabled
 func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
+	params := k.GetParams(ctx)
+	params.SendEnabled = enabled
+	k.SetParams(ctx, params)
 }
 
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// paramStore returns the store of the bank parameters.
+func (k BaseSendKeeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(k.cdc, k.storeKey, types.ParamsKey, k.paramSpace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams returns the total set of bank parameters. Parameters that have not
+// been migrated to the module store yet are read from the legacy param space.
+func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramStore().Get(ctx, &params)
+	return params
+}
+
+// SetParams sets the bank parameters in the module store.
+func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore().Set(ctx, &params)
+}
+
+// GetAuthority returns the address allowed to update the module parameters.
+func (k BaseKeeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+// UpdateParams validates and sets the bank parameters on behalf of the given
+// authority, which must be the authority of the module.
+func (k BaseKeeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(k.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the bank parameters from the legacy x/params
+// subspace to the module store.
+func (k BaseKeeper) MigrateParams(ctx sdk.Context) error {
+	return k.paramStore().Migrate(ctx)
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (k BaseKeeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return k.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (k BaseKeeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return k.paramStore().SetParamSet(ctx, ps)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	bankclient "github.com/cosmos/cosmos-sdk/x/bank/client"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -23,9 +24,10 @@ import (
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                     = AppModule{}
+	_ module.AppModuleBasic                = AppModuleBasic{}
+	_ module.AppModuleSimulation           = AppModule{}
+	_ module.AppModuleBasicVerifiedQueries = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -68,6 +70,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(cdc)
 }
 
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// bank module with verified store reads.
+func (AppModuleBasic) VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return bankclient.VerifiedQueriers()
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the bank module.
@@ -134,6 +142,19 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the in-place store migrations of the bank
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
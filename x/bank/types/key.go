@@ -23,6 +23,7 @@ const (
 // KVStore key prefixes
 var (
 	BalancesPrefix = []byte("balances")
+	ParamsKey      = []byte("params")
 )
 
 // AddressFromBalancesStore returns an account address from a balances prefix
@@ -158,3 +158,35 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+var _ sdk.Msg = MsgUpdateParams{}
+
+// NewMsgUpdateParams - construct a msg replacing the bank parameters on behalf
+// of the given authority.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) MsgUpdateParams {
+	return MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// Route Implements Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
@@ -241,6 +241,17 @@ func TestMsgMultiSendGetSigners(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%v", res), "[696E70757431 696E70757432 696E70757433]")
 }
 
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority"))
+
+	msg := NewMsgUpdateParams(authority, DefaultParams())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, "update_params", msg.Type())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, NewMsgUpdateParams(nil, DefaultParams()).ValidateBasic())
+}
+
 /*
 // what to do w/ this test?
 func TestMsgSendSigners(t *testing.T) {
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"gopkg.in/yaml.v2"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -18,9 +20,37 @@ var ParamStoreKeySendEnabled = []byte("sendenabled")
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
-	)
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(sendEnabled bool) Params {
+	return Params{
+		SendEnabled: sendEnabled,
+	}
+}
+
+// DefaultParams returns the default parameters of the bank module
+func DefaultParams() Params {
+	return NewParams(DefaultSendEnabled)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateSendEnabled(p.SendEnabled)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeySendEnabled, &p.SendEnabled, validateSendEnabled),
+	}
 }
 
 func validateSendEnabled(i interface{}) error {
@@ -0,0 +1,72 @@
+package circuit
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// nolint
+
+const (
+	ModuleName                      = types.ModuleName
+	StoreKey                        = types.StoreKey
+	RouterKey                       = types.RouterKey
+	QuerierRoute                    = types.QuerierRoute
+	QueryAccounts                   = types.QueryAccounts
+	QueryAccount                    = types.QueryAccount
+	QueryDisabledMsgTypes           = types.QueryDisabledMsgTypes
+	TypeMsgTripCircuitBreaker       = types.TypeMsgTripCircuitBreaker
+	TypeMsgResetCircuitBreaker      = types.TypeMsgResetCircuitBreaker
+	ProposalTypeTripCircuitBreaker  = types.ProposalTypeTripCircuitBreaker
+	ProposalTypeResetCircuitBreaker = types.ProposalTypeResetCircuitBreaker
+	EventTypeTripCircuitBreaker     = types.EventTypeTripCircuitBreaker
+	EventTypeResetCircuitBreaker    = types.EventTypeResetCircuitBreaker
+	AttributeKeyMsgType             = types.AttributeKeyMsgType
+	AttributeKeyAuthority           = types.AttributeKeyAuthority
+	AttributeValueCategory          = types.AttributeValueCategory
+	LevelAllMsgs                    = types.LevelAllMsgs
+	LevelSomeMsgs                   = types.LevelSomeMsgs
+)
+
+var (
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	NewPermissions                 = types.NewPermissions
+	NewAccountPermissions          = types.NewAccountPermissions
+	NewMsgTripCircuitBreaker       = types.NewMsgTripCircuitBreaker
+	NewMsgResetCircuitBreaker      = types.NewMsgResetCircuitBreaker
+	NewTripCircuitBreakerProposal  = types.NewTripCircuitBreakerProposal
+	NewResetCircuitBreakerProposal = types.NewResetCircuitBreakerProposal
+	NewQueryAccountParams          = types.NewQueryAccountParams
+	MsgTypeID                      = types.MsgTypeID
+	MatchesMsgType                 = types.MatchesMsgType
+	ValidateMsgType                = types.ValidateMsgType
+	ValidateMsgTypes               = types.ValidateMsgTypes
+	RegisterCodec                  = types.RegisterCodec
+	ModuleCdc                      = types.ModuleCdc
+
+	AccountPermissionsPrefix = types.AccountPermissionsPrefix
+	DisabledMsgTypePrefix    = types.DisabledMsgTypePrefix
+	AccountPermissionsKey    = types.AccountPermissionsKey
+	DisabledMsgTypeKey       = types.DisabledMsgTypeKey
+
+	ErrInvalidMsgType  = types.ErrInvalidMsgType
+	ErrMsgTypeDisabled = types.ErrMsgTypeDisabled
+	ErrUnauthorized    = types.ErrUnauthorized
+	ErrInvalidLevel    = types.ErrInvalidLevel
+)
+
+type (
+	Keeper                      = keeper.Keeper
+	GenesisState                = types.GenesisState
+	PermissionLevel             = types.PermissionLevel
+	Permissions                 = types.Permissions
+	AccountPermissions          = types.AccountPermissions
+	MsgTripCircuitBreaker       = types.MsgTripCircuitBreaker
+	MsgResetCircuitBreaker      = types.MsgResetCircuitBreaker
+	TripCircuitBreakerProposal  = types.TripCircuitBreakerProposal
+	ResetCircuitBreakerProposal = types.ResetCircuitBreakerProposal
+	QueryAccountParams          = types.QueryAccountParams
+)
@@ -0,0 +1,28 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+)
+
+// CircuitBreakerDecorator rejects the transactions containing a message whose
+// msg type is disabled by the circuit breaker, including the messages nested in
+// other messages. It should run early in the ante handler chain, so that such
+// transactions are rejected before fees are deducted.
+type CircuitBreakerDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewCircuitBreakerDecorator creates a new CircuitBreakerDecorator object
+func NewCircuitBreakerDecorator(k keeper.Keeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{keeper: k}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.keeper.CheckMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,37 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	cbd := ante.NewCircuitBreakerDecorator(app.CircuitKeeper)
+	anteHandler := sdk.ChainAnteDecorators(cbd)
+
+	msg := sdk.NewTestMsg()
+	tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+
+	_, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	app.CircuitKeeper.TripCircuitBreaker(ctx, []string{types.MsgTypeID(msg)})
+	_, err = anteHandler(ctx, tx, false)
+	require.Error(t, err)
+	require.True(t, types.ErrMsgTypeDisabled.Is(err))
+
+	app.CircuitKeeper.ResetCircuitBreaker(ctx, []string{types.MsgTypeID(msg)})
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+}
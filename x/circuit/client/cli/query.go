@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit breaker module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryAccounts(cdc),
+		GetCmdQueryDisabledMsgTypes(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryAccounts implements the query command for the accounts allowed to
+// use the circuit breaker.
+func GetCmdQueryAccounts(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accounts [address]",
+		Short: "Query the accounts allowed to use the circuit breaker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the permissions of all the accounts allowed to use the circuit breaker, or of a single account.
+
+Example:
+$ %s query %s accounts
+$ %s query %s accounts cosmos1...
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccounts)
+				res, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var accounts []types.AccountPermissions
+				if err := cdc.UnmarshalJSON(res, &accounts); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(accounts)
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAccountParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccount)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var account types.AccountPermissions
+			if err := cdc.UnmarshalJSON(res, &account); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(account)
+		},
+	}
+}
+
+// GetCmdQueryDisabledMsgTypes implements the query command for the msg types
+// disabled by the circuit breaker.
+func GetCmdQueryDisabledMsgTypes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disabled-msg-types",
+		Short: "Query the msg types and routes disabled by the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDisabledMsgTypes)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var msgTypes []string
+			if err := cdc.UnmarshalJSON(res, &msgTypes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(msgTypes)
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(flags.PostCommands(
+		GetCmdTripCircuitBreaker(cdc),
+		GetCmdResetCircuitBreaker(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdTripCircuitBreaker implements the command to disable msg types with an
+// authorized account.
+func GetCmdTripCircuitBreaker(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "trip [msg-type]...",
+		Short: "Disable msg types or routes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable msg types, given as <route>/<type>, or all the msg types of routes.
+The sender must be authorized to use the circuit breaker for the msg types.
+
+Example:
+$ %s tx %s trip staking/begin_redelegate --from mykey
+$ %s tx %s trip distribution --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgTripCircuitBreaker(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdResetCircuitBreaker implements the command to re-enable msg types with
+// an authorized account.
+func GetCmdResetCircuitBreaker(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset [msg-type]...",
+		Short: "Re-enable msg types or routes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Re-enable msg types, given as <route>/<type>, or routes disabled by the circuit breaker.
+The sender must be authorized to use the circuit breaker for the msg types.
+
+Example:
+$ %s tx %s reset staking/begin_redelegate --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgResetCircuitBreaker(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitTripProposal implements the command to submit a proposal tripping
+// the circuit breaker.
+func GetCmdSubmitTripProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip-circuit-breaker [msg-type]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to disable msg types or routes",
+		Long:  "Submit a proposal to disable msg types, given as <route>/<type>, or routes, along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, description, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, cdc, types.NewTripCircuitBreakerProposal(title, description, args))
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitResetProposal implements the command to submit a proposal
+// resetting the circuit breaker.
+func GetCmdSubmitResetProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breaker [msg-type]... [flags]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to re-enable msg types or routes",
+		Long:  "Submit a proposal to re-enable msg types, given as <route>/<type>, or routes, along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, description, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, cdc, types.NewResetCircuitBreakerProposal(title, description, args))
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+func parseProposalFlags(cmd *cobra.Command) (title, description string, err error) {
+	title, err = cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", err
+	}
+
+	description, err = cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", err
+	}
+
+	return title, description, nil
+}
+
+func submitProposal(cmd *cobra.Command, cdc *codec.Codec, content gov.Content) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoins(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST query and parameter values
+const (
+	RestParamAddress = "address"
+)
+
+// RegisterRoutes registers the REST routes for the circuit module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	rest.Document(r.HandleFunc("/circuit/trip", tripHandler(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction tripping the circuit breaker of msg types",
+		Request:  CircuitBreakerRequest{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc("/circuit/reset", resetHandler(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction resetting the circuit breaker of msg types",
+		Request:  CircuitBreakerRequest{},
+		Response: authtypes.StdTx{},
+	})
+}
+
+// CircuitBreakerRequest defines a request to trip or reset the circuit breaker
+// of msg types with an authorized account.
+type CircuitBreakerRequest struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	MsgTypes []string     `json:"msg_types" yaml:"msg_types"`
+}
+
+// CircuitBreakerProposalRequest defines a proposal to trip or reset the circuit
+// breaker of msg types.
+type CircuitBreakerProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	MsgTypes    []string     `json:"msg_types" yaml:"msg_types"`
+}
+
+// TripProposalRESTHandler returns the REST handler of the proposal tripping the
+// circuit breaker.
+func TripProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "trip_circuit_breaker",
+		Handler: proposalHandler(cliCtx, func(req CircuitBreakerProposalRequest) gov.Content {
+			return types.NewTripCircuitBreakerProposal(req.Title, req.Description, req.MsgTypes)
+		}),
+		Doc: rest.RouteDoc{
+			Summary:  "Generate an unsigned transaction submitting a proposal tripping the circuit breaker of msg types",
+			Request:  CircuitBreakerProposalRequest{},
+			Response: authtypes.StdTx{},
+		},
+	}
+}
+
+// ResetProposalRESTHandler returns the REST handler of the proposal resetting
+// the circuit breaker.
+func ResetProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "reset_circuit_breaker",
+		Handler: proposalHandler(cliCtx, func(req CircuitBreakerProposalRequest) gov.Content {
+			return types.NewResetCircuitBreakerProposal(req.Title, req.Description, req.MsgTypes)
+		}),
+		Doc: rest.RouteDoc{
+			Summary:  "Generate an unsigned transaction submitting a proposal resetting the circuit breaker of msg types",
+			Request:  CircuitBreakerProposalRequest{},
+			Response: authtypes.StdTx{},
+		},
+	}
+}
+
+func tripHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return msgHandler(cliCtx, func(authority sdk.AccAddress, msgTypes []string) sdk.Msg {
+		return types.NewMsgTripCircuitBreaker(authority, msgTypes)
+	})
+}
+
+func resetHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return msgHandler(cliCtx, func(authority sdk.AccAddress, msgTypes []string) sdk.Msg {
+		return types.NewMsgResetCircuitBreaker(authority, msgTypes)
+	})
+}
+
+func msgHandler(cliCtx context.CLIContext, newMsg func(sdk.AccAddress, []string) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := newMsg(fromAddr, req.MsgTypes)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func proposalHandler(cliCtx context.CLIContext, newContent func(CircuitBreakerProposalRequest) gov.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerProposalRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := gov.NewMsgSubmitProposal(newContent(req), req.Deposit, fromAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Package circuit implements a Cosmos SDK module that allows for disabling specific
+msg types, e.g. while the fix of a bug in their handler ships.
+
+The circuit breaker keeps a set of disabled msg types, identified as
+"<route>/<type>", or of disabled routes, which disable all the msg types of the
+route. A transaction containing a disabled message is rejected both by the
+CircuitBreakerDecorator of the ante handler and by the message filter of
+BaseApp, including when the message is nested in another message implementing
+sdk.MsgWithNestedMsgs, e.g. a MsgSubmitProposal of an ExecProposal.
+
+The circuit breaker is tripped and reset by governance, through the
+TripCircuitBreakerProposal and ResetCircuitBreakerProposal proposals, and by the
+accounts named in genesis, through the MsgTripCircuitBreaker and
+MsgResetCircuitBreaker messages. The permissions of an account may be limited to
+specific msg types or routes.
+
+A full setup of the circuit module may look something as follows:
+
+	app.CircuitKeeper = circuit.NewKeeper(appCodec, keys[circuit.StoreKey])
+
+	govRouter.AddRoute(circuit.RouterKey, circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper))
+
+	app.SetAnteHandler(
+		sdk.ChainAnteDecorators(
+			ante.NewSetUpContextDecorator(),
+			circuitante.NewCircuitBreakerDecorator(app.CircuitKeeper),
+			// ...
+		),
+	)
+	app.SetMsgFilter(app.CircuitKeeper.MsgFilter())
+*/
+package circuit
@@ -0,0 +1,26 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the circuit module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, account := range gs.Accounts {
+		k.SetAccountPermissions(ctx, account.Address, account.Permissions)
+	}
+
+	k.TripCircuitBreaker(ctx, gs.DisabledMsgTypes)
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAllAccountPermissions(ctx), k.GetDisabledMsgTypes(ctx))
+}
@@ -0,0 +1,97 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewHandler returns a handler for the circuit module messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgTripCircuitBreaker:
+			return handleMsgTripCircuitBreaker(ctx, k, msg)
+
+		case MsgResetCircuitBreaker:
+			return handleMsgResetCircuitBreaker(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgTripCircuitBreaker(ctx sdk.Context, k Keeper, msg MsgTripCircuitBreaker) (*sdk.Result, error) {
+	if err := k.Authorize(ctx, msg.Authority, msg.MsgTypes); err != nil {
+		return nil, err
+	}
+
+	k.TripCircuitBreaker(ctx, msg.MsgTypes)
+	emitCircuitBreakerEvents(ctx, EventTypeTripCircuitBreaker, msg.Authority.String(), msg.MsgTypes)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgResetCircuitBreaker(ctx sdk.Context, k Keeper, msg MsgResetCircuitBreaker) (*sdk.Result, error) {
+	if err := k.Authorize(ctx, msg.Authority, msg.MsgTypes); err != nil {
+		return nil, err
+	}
+
+	k.ResetCircuitBreaker(ctx, msg.MsgTypes)
+	emitCircuitBreakerEvents(ctx, EventTypeResetCircuitBreaker, msg.Authority.String(), msg.MsgTypes)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// NewCircuitBreakerProposalHandler creates a governance handler for the
+// proposals tripping and resetting the circuit breaker. Governance may trip and
+// reset the circuit breaker of any msg type.
+func NewCircuitBreakerProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case TripCircuitBreakerProposal:
+			k.TripCircuitBreaker(ctx, c.MsgTypes)
+			emitCircuitBreakerEvents(ctx, EventTypeTripCircuitBreaker, govtypes.ModuleName, c.MsgTypes)
+			return nil
+
+		case ResetCircuitBreakerProposal:
+			k.ResetCircuitBreaker(ctx, c.MsgTypes)
+			emitCircuitBreakerEvents(ctx, EventTypeResetCircuitBreaker, govtypes.ModuleName, c.MsgTypes)
+			return nil
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+func emitCircuitBreakerEvents(ctx sdk.Context, eventType, authority string, msgTypes []string) {
+	for _, msgType := range msgTypes {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(AttributeKeyMsgType, msgType),
+				sdk.NewAttribute(AttributeKeyAuthority, authority),
+			),
+		)
+	}
+}
@@ -0,0 +1,112 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	addr3 = sdk.AccAddress([]byte("addr3_______________"))
+)
+
+func createTestApp() (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	circuit.InitGenesis(ctx, app.CircuitKeeper, circuit.NewGenesisState(
+		[]circuit.AccountPermissions{
+			circuit.NewAccountPermissions(addr1, circuit.NewPermissions(circuit.LevelAllMsgs, nil)),
+			circuit.NewAccountPermissions(addr2, circuit.NewPermissions(circuit.LevelSomeMsgs, []string{"staking"})),
+		},
+		[]string{"distribution"},
+	))
+
+	return app, ctx
+}
+
+func TestHandleMsgTripAndResetCircuitBreaker(t *testing.T) {
+	app, ctx := createTestApp()
+	h := circuit.NewHandler(app.CircuitKeeper)
+
+	testCases := []struct {
+		msg       string
+		sdkMsg    sdk.Msg
+		expectErr bool
+		disabled  []string
+	}{
+		{
+			"unauthorized account",
+			circuit.NewMsgTripCircuitBreaker(addr3, []string{"staking"}),
+			true, []string{"distribution"},
+		},
+		{
+			"msg type out of the account permissions",
+			circuit.NewMsgTripCircuitBreaker(addr2, []string{"staking/delegate", "bank"}),
+			true, []string{"distribution"},
+		},
+		{
+			"limited account trips its msg types",
+			circuit.NewMsgTripCircuitBreaker(addr2, []string{"staking/begin_redelegate"}),
+			false, []string{"distribution", "staking/begin_redelegate"},
+		},
+		{
+			"limited account can't reset other msg types",
+			circuit.NewMsgResetCircuitBreaker(addr2, []string{"distribution"}),
+			true, []string{"distribution", "staking/begin_redelegate"},
+		},
+		{
+			"account with all msgs level resets any msg type",
+			circuit.NewMsgResetCircuitBreaker(addr1, []string{"distribution", "staking/begin_redelegate"}),
+			false, []string{},
+		},
+		{"unknown message", sdk.NewTestMsg(), true, []string{}},
+	}
+
+	for _, tc := range testCases {
+		res, err := h(ctx, tc.sdkMsg)
+		if tc.expectErr {
+			require.Error(t, err, tc.msg)
+			require.Nil(t, res, tc.msg)
+		} else {
+			require.NoError(t, err, tc.msg)
+			require.NotNil(t, res, tc.msg)
+		}
+
+		require.Equal(t, tc.disabled, app.CircuitKeeper.GetDisabledMsgTypes(ctx), tc.msg)
+	}
+}
+
+func TestCircuitBreakerProposalHandler(t *testing.T) {
+	app, ctx := createTestApp()
+	h := circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper)
+
+	// governance isn't limited by the permissions of the accounts
+	trip := circuit.NewTripCircuitBreakerProposal("title", "description", []string{"bank", "staking/delegate"})
+	require.NoError(t, h(ctx, trip))
+	require.Equal(t, []string{"bank", "distribution", "staking/delegate"}, app.CircuitKeeper.GetDisabledMsgTypes(ctx))
+
+	reset := circuit.NewResetCircuitBreakerProposal("title", "description", []string{"bank", "distribution"})
+	require.NoError(t, h(ctx, reset))
+	require.Equal(t, []string{"staking/delegate"}, app.CircuitKeeper.GetDisabledMsgTypes(ctx))
+}
+
+func TestExportGenesis(t *testing.T) {
+	app, ctx := createTestApp()
+
+	gs := circuit.ExportGenesis(ctx, app.CircuitKeeper)
+	require.Len(t, gs.Accounts, 2)
+	require.Equal(t, []string{"distribution"}, gs.DisabledMsgTypes)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, abci.Header{})
+	circuit.InitGenesis(ctx2, app2.CircuitKeeper, gs)
+	require.Equal(t, gs, circuit.ExportGenesis(ctx2, app2.CircuitKeeper))
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper defines the circuit module keeper, which maintains the disabled msg
+// types and the permissions of the accounts allowed to disable them.
+type Keeper struct {
+	cdc      codec.Marshaler
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetAccountPermissions sets the circuit breaker permissions of the account.
+func (k Keeper) SetAccountPermissions(ctx sdk.Context, addr sdk.AccAddress, permissions types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AccountPermissionsKey(addr), k.cdc.MustMarshalBinaryBare(&permissions))
+}
+
+// GetAccountPermissions returns the circuit breaker permissions of the account.
+func (k Keeper) GetAccountPermissions(ctx sdk.Context, addr sdk.AccAddress) (permissions types.Permissions, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.AccountPermissionsKey(addr))
+	if bz == nil {
+		return permissions, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &permissions)
+	return permissions, true
+}
+
+// IterateAccountPermissions iterates over the accounts allowed to use the
+// circuit breaker. If the callback returns true, the iteration stops.
+func (k Keeper) IterateAccountPermissions(ctx sdk.Context, cb func(account types.AccountPermissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionsPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var permissions types.Permissions
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &permissions)
+
+		if cb(types.NewAccountPermissions(iterator.Key(), permissions)) {
+			break
+		}
+	}
+}
+
+// GetAllAccountPermissions returns all the accounts allowed to use the circuit
+// breaker along with their permissions.
+func (k Keeper) GetAllAccountPermissions(ctx sdk.Context) []types.AccountPermissions {
+	accounts := []types.AccountPermissions{}
+	k.IterateAccountPermissions(ctx, func(account types.AccountPermissions) bool {
+		accounts = append(accounts, account)
+		return false
+	})
+
+	return accounts
+}
+
+// Authorize returns an error if the account isn't allowed to trip and reset the
+// circuit breaker of all the given msg types.
+func (k Keeper) Authorize(ctx sdk.Context, addr sdk.AccAddress, msgTypes []string) error {
+	permissions, found := k.GetAccountPermissions(ctx, addr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnauthorized, addr.String())
+	}
+
+	for _, msgType := range msgTypes {
+		if !permissions.Allows(msgType) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "%s for msg type %s", addr, msgType)
+		}
+	}
+
+	return nil
+}
+
+// TripCircuitBreaker disables the given msg types, or all the msg types of the
+// given routes.
+func (k Keeper) TripCircuitBreaker(ctx sdk.Context, msgTypes []string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, msgType := range msgTypes {
+		store.Set(types.DisabledMsgTypeKey(msgType), []byte{0x01})
+		k.Logger(ctx).Info("circuit breaker tripped", "msg_type", msgType)
+	}
+}
+
+// ResetCircuitBreaker re-enables the given msg types or routes. Note, a msg type
+// remains disabled if its route is disabled.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, msgTypes []string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, msgType := range msgTypes {
+		store.Delete(types.DisabledMsgTypeKey(msgType))
+		k.Logger(ctx).Info("circuit breaker reset", "msg_type", msgType)
+	}
+}
+
+// IsMsgTypeDisabled returns true if the msg type or route is disabled as such.
+func (k Keeper) IsMsgTypeDisabled(ctx sdk.Context, msgType string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisabledMsgTypeKey(msgType))
+}
+
+// IterateDisabledMsgTypes iterates over the disabled msg types and routes. If
+// the callback returns true, the iteration stops.
+func (k Keeper) IterateDisabledMsgTypes(ctx sdk.Context, cb func(msgType string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledMsgTypePrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetDisabledMsgTypes returns all the disabled msg types and routes.
+func (k Keeper) GetDisabledMsgTypes(ctx sdk.Context) []string {
+	msgTypes := []string{}
+	k.IterateDisabledMsgTypes(ctx, func(msgType string) bool {
+		msgTypes = append(msgTypes, msgType)
+		return false
+	})
+
+	return msgTypes
+}
+
+// CheckMsgs returns an error if any of the messages, or of the messages nested
+// in them, is disabled, either by its msg type or its route.
+func (k Keeper) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range sdk.FlattenMsgs(msgs) {
+		msgType := types.MsgTypeID(msg)
+		if k.IsMsgTypeDisabled(ctx, msg.Route()) || k.IsMsgTypeDisabled(ctx, msgType) {
+			return sdkerrors.Wrap(types.ErrMsgTypeDisabled, msgType)
+		}
+	}
+
+	return nil
+}
+
+// MsgFilter returns the message filter rejecting the disabled messages, to be
+// set on BaseApp.
+func (k Keeper) MsgFilter() sdk.MsgFilter {
+	return func(ctx sdk.Context, msg sdk.Msg) error {
+		return k.CheckMsgs(ctx, []sdk.Msg{msg})
+	}
+}
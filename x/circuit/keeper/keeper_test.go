@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/std"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	addr3 = sdk.AccAddress([]byte("addr3_______________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, abci.Header{})
+}
+
+func (suite *KeeperTestSuite) TestAccountPermissions() {
+	k := suite.app.CircuitKeeper
+
+	_, found := k.GetAccountPermissions(suite.ctx, addr1)
+	suite.False(found)
+	suite.Empty(k.GetAllAccountPermissions(suite.ctx))
+
+	all := types.NewPermissions(types.LevelAllMsgs, nil)
+	some := types.NewPermissions(types.LevelSomeMsgs, []string{"staking", "bank/send"})
+	k.SetAccountPermissions(suite.ctx, addr1, all)
+	k.SetAccountPermissions(suite.ctx, addr2, some)
+
+	permissions, found := k.GetAccountPermissions(suite.ctx, addr2)
+	suite.True(found)
+	suite.Equal(some, permissions)
+	suite.Equal(
+		[]types.AccountPermissions{types.NewAccountPermissions(addr1, all), types.NewAccountPermissions(addr2, some)},
+		k.GetAllAccountPermissions(suite.ctx),
+	)
+
+	suite.NoError(k.Authorize(suite.ctx, addr1, []string{"distribution", "staking/delegate"}))
+	suite.NoError(k.Authorize(suite.ctx, addr2, []string{"staking/delegate", "bank/send"}))
+	suite.Error(k.Authorize(suite.ctx, addr2, []string{"staking/delegate", "bank/multisend"}))
+	suite.Error(k.Authorize(suite.ctx, addr2, []string{"bank"}))
+	suite.Error(k.Authorize(suite.ctx, addr3, []string{"staking"}))
+}
+
+func (suite *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	k := suite.app.CircuitKeeper
+	msg := sdk.NewTestMsg()
+
+	suite.NoError(k.CheckMsgs(suite.ctx, []sdk.Msg{msg}))
+	suite.Empty(k.GetDisabledMsgTypes(suite.ctx))
+
+	// disable the msg type
+	k.TripCircuitBreaker(suite.ctx, []string{types.MsgTypeID(msg)})
+	suite.True(k.IsMsgTypeDisabled(suite.ctx, types.MsgTypeID(msg)))
+	suite.Equal([]string{types.MsgTypeID(msg)}, k.GetDisabledMsgTypes(suite.ctx))
+
+	err := k.CheckMsgs(suite.ctx, []sdk.Msg{msg})
+	suite.Error(err)
+	suite.True(types.ErrMsgTypeDisabled.Is(err))
+	suite.Error(k.MsgFilter()(suite.ctx, msg))
+
+	k.ResetCircuitBreaker(suite.ctx, []string{types.MsgTypeID(msg)})
+	suite.NoError(k.CheckMsgs(suite.ctx, []sdk.Msg{msg}))
+
+	// disable the route of the msg type
+	k.TripCircuitBreaker(suite.ctx, []string{msg.Route()})
+	suite.Error(k.CheckMsgs(suite.ctx, []sdk.Msg{msg}))
+
+	// resetting the msg type doesn't re-enable its route
+	k.ResetCircuitBreaker(suite.ctx, []string{types.MsgTypeID(msg)})
+	suite.Error(k.CheckMsgs(suite.ctx, []sdk.Msg{msg}))
+
+	k.ResetCircuitBreaker(suite.ctx, []string{msg.Route()})
+	suite.NoError(k.CheckMsgs(suite.ctx, []sdk.Msg{msg}))
+	suite.Empty(k.GetDisabledMsgTypes(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCheckNestedMsgs() {
+	k := suite.app.CircuitKeeper
+	authority := suite.app.GovKeeper.GetAuthority()
+	msg := bank.NewMsgUpdateParams(authority, bank.DefaultParams())
+	content, err := std.NewExecProposal("title", "description", msg)
+	suite.Require().NoError(err)
+	nested, err := std.NewMsgSubmitProposal(content, nil, addr1)
+	suite.Require().NoError(err)
+	legacy := gov.NewMsgSubmitProposal(content, nil, addr1)
+
+	for _, m := range []sdk.Msg{nested, legacy} {
+		suite.NoError(k.CheckMsgs(suite.ctx, []sdk.Msg{m}))
+		suite.NoError(k.MsgFilter()(suite.ctx, m))
+	}
+
+	// the messages executed by a proposal are checked on its submission
+	k.TripCircuitBreaker(suite.ctx, []string{types.MsgTypeID(msg)})
+	for _, m := range []sdk.Msg{nested, legacy} {
+		suite.Error(k.CheckMsgs(suite.ctx, []sdk.Msg{m}))
+		suite.Error(k.MsgFilter()(suite.ctx, m))
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
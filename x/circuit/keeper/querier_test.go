@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (suite *KeeperTestSuite) TestQuerier() {
+	k := suite.app.CircuitKeeper
+	querier := keeper.NewQuerier(k)
+
+	permissions := types.NewPermissions(types.LevelSomeMsgs, []string{"staking"})
+	k.SetAccountPermissions(suite.ctx, addr1, permissions)
+	k.TripCircuitBreaker(suite.ctx, []string{"staking/begin_redelegate"})
+
+	bz, err := querier(suite.ctx, []string{types.QueryAccounts}, abci.RequestQuery{})
+	suite.NoError(err)
+	var accounts []types.AccountPermissions
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &accounts))
+	suite.Equal([]types.AccountPermissions{types.NewAccountPermissions(addr1, permissions)}, accounts)
+
+	params := types.ModuleCdc.MustMarshalJSON(types.NewQueryAccountParams(addr1))
+	bz, err = querier(suite.ctx, []string{types.QueryAccount}, abci.RequestQuery{Data: params})
+	suite.NoError(err)
+	var account types.AccountPermissions
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &account))
+	suite.Equal(types.NewAccountPermissions(addr1, permissions), account)
+
+	params = types.ModuleCdc.MustMarshalJSON(types.NewQueryAccountParams(addr2))
+	_, err = querier(suite.ctx, []string{types.QueryAccount}, abci.RequestQuery{Data: params})
+	suite.Error(err)
+
+	bz, err = querier(suite.ctx, []string{types.QueryDisabledMsgTypes}, abci.RequestQuery{})
+	suite.NoError(err)
+	var msgTypes []string
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &msgTypes))
+	suite.Equal([]string{"staking/begin_redelegate"}, msgTypes)
+
+	_, err = querier(suite.ctx, []string{"unknown"}, abci.RequestQuery{})
+	suite.Error(err)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the necessary x/circuit interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgTripCircuitBreaker{}, "cosmos-sdk/MsgTripCircuitBreaker", nil)
+	cdc.RegisterConcrete(MsgResetCircuitBreaker{}, "cosmos-sdk/MsgResetCircuitBreaker", nil)
+	cdc.RegisterConcrete(TripCircuitBreakerProposal{}, "cosmos-sdk/TripCircuitBreakerProposal", nil)
+	cdc.RegisterConcrete(ResetCircuitBreakerProposal{}, "cosmos-sdk/ResetCircuitBreakerProposal", nil)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/circuit module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/circuit and
+	// defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	codec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidMsgType  = sdkerrors.Register(ModuleName, 2, "invalid msg type")
+	ErrMsgTypeDisabled = sdkerrors.Register(ModuleName, 3, "msg type disabled by the circuit breaker")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 4, "account not authorized to use the circuit breaker")
+	ErrInvalidLevel    = sdkerrors.Register(ModuleName, 5, "invalid permission level")
+)
@@ -0,0 +1,12 @@
+package types
+
+// x/circuit module event types
+const (
+	EventTypeTripCircuitBreaker  = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker = "reset_circuit_breaker"
+
+	AttributeKeyMsgType   = "msg_type"
+	AttributeKeyAuthority = "authority"
+
+	AttributeValueCategory = ModuleName
+)
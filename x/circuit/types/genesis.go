@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the circuit module's genesis state.
+type GenesisState struct {
+	// Accounts defines the accounts allowed to trip and reset the circuit
+	// breaker, along with their permissions.
+	Accounts []AccountPermissions `json:"accounts" yaml:"accounts"`
+	// DisabledMsgTypes defines the disabled msg types and routes.
+	DisabledMsgTypes []string `json:"disabled_msg_types" yaml:"disabled_msg_types"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accounts []AccountPermissions, disabledMsgTypes []string) GenesisState {
+	return GenesisState{
+		Accounts:         accounts,
+		DisabledMsgTypes: disabledMsgTypes,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state, without any authorized
+// account nor disabled msg type.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AccountPermissions{}, []string{})
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Accounts))
+	for _, account := range gs.Accounts {
+		if err := account.Validate(); err != nil {
+			return err
+		}
+
+		if seen[account.Address.String()] {
+			return fmt.Errorf("duplicate account %s", account.Address)
+		}
+		seen[account.Address.String()] = true
+	}
+
+	if len(gs.DisabledMsgTypes) != 0 {
+		if err := ValidateMsgTypes(gs.DisabledMsgTypes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	AccountPermissionsPrefix = []byte{0x01}
+	DisabledMsgTypePrefix    = []byte{0x02}
+)
+
+// AccountPermissionsKey returns the key of the permissions of the account.
+func AccountPermissionsKey(addr sdk.AccAddress) []byte {
+	return append(AccountPermissionsPrefix, addr.Bytes()...)
+}
+
+// DisabledMsgTypeKey returns the key of the disabled msg type.
+func DisabledMsgTypeKey(msgType string) []byte {
+	return append(DisabledMsgTypePrefix, []byte(msgType)...)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Circuit breaker message types
+const (
+	TypeMsgTripCircuitBreaker  = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgTripCircuitBreaker{}
+	_ sdk.Msg = MsgResetCircuitBreaker{}
+)
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker object
+func NewMsgTripCircuitBreaker(authority sdk.AccAddress, msgTypes []string) MsgTripCircuitBreaker {
+	return MsgTripCircuitBreaker{Authority: authority, MsgTypes: msgTypes}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) Type() string { return TypeMsgTripCircuitBreaker }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty authority")
+	}
+
+	return ValidateMsgTypes(msg.MsgTypes)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker object
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgTypes []string) MsgResetCircuitBreaker {
+	return MsgResetCircuitBreaker{Authority: authority, MsgTypes: msgTypes}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) Type() string { return TypeMsgResetCircuitBreaker }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty authority")
+	}
+
+	return ValidateMsgTypes(msg.MsgTypes)
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestMsgTripCircuitBreaker(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	msg := types.NewMsgTripCircuitBreaker(addr, []string{"staking/begin_redelegate"})
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgTripCircuitBreaker, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.Error(t, types.NewMsgTripCircuitBreaker(nil, []string{"staking"}).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker(addr, nil).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker(addr, []string{types.ModuleName}).ValidateBasic())
+}
+
+func TestMsgResetCircuitBreaker(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	msg := types.NewMsgResetCircuitBreaker(addr, []string{"staking"})
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgResetCircuitBreaker, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.Error(t, types.NewMsgResetCircuitBreaker(nil, []string{"staking"}).ValidateBasic())
+	require.Error(t, types.NewMsgResetCircuitBreaker(addr, []string{}).ValidateBasic())
+}
+
+func TestCircuitBreakerProposals(t *testing.T) {
+	trip := types.NewTripCircuitBreakerProposal("title", "description", []string{"staking"})
+	require.Equal(t, types.RouterKey, trip.ProposalRoute())
+	require.Equal(t, types.ProposalTypeTripCircuitBreaker, trip.ProposalType())
+	require.NoError(t, trip.ValidateBasic())
+	require.Error(t, types.NewTripCircuitBreakerProposal("", "description", []string{"staking"}).ValidateBasic())
+	require.Error(t, types.NewTripCircuitBreakerProposal("title", "description", nil).ValidateBasic())
+
+	reset := types.NewResetCircuitBreakerProposal("title", "description", []string{"staking"})
+	require.Equal(t, types.RouterKey, reset.ProposalRoute())
+	require.Equal(t, types.ProposalTypeResetCircuitBreaker, reset.ProposalType())
+	require.NoError(t, reset.ValidateBasic())
+	require.Error(t, types.NewResetCircuitBreakerProposal("title", "", []string{"staking"}).ValidateBasic())
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PermissionLevel defines which msg types an account may trip and reset the
+// circuit breaker of.
+type PermissionLevel string
+
+// Permission levels
+const (
+	// LevelAllMsgs allows the account to trip and reset the circuit breaker of
+	// any msg type.
+	LevelAllMsgs PermissionLevel = "all-msgs"
+	// LevelSomeMsgs allows the account to trip and reset the circuit breaker of
+	// the msg types of its LimitMsgTypes only.
+	LevelSomeMsgs PermissionLevel = "some-msgs"
+)
+
+// Validate returns an error if the permission level is unknown.
+func (l PermissionLevel) Validate() error {
+	switch l {
+	case LevelAllMsgs, LevelSomeMsgs:
+		return nil
+
+	default:
+		return sdkerrors.Wrapf(ErrInvalidLevel, "%q", l)
+	}
+}
+
+// MsgTypeID returns the identifier of the msg type of the message, used by the
+// circuit breaker: "<route>/<type>".
+func MsgTypeID(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
+
+// MatchesMsgType returns true if the msg type, or the route of the msg type,
+// entry matches the msg type identifier, i.e. if it is either the identifier
+// itself or its route.
+func MatchesMsgType(entry, msgTypeID string) bool {
+	return entry == msgTypeID || strings.HasPrefix(msgTypeID, entry+"/")
+}
+
+// ValidateMsgType returns an error if the msg type is neither a route nor a
+// "<route>/<type>" msg type identifier, or if it belongs to the circuit module
+// itself, whose messages can't be disabled.
+func ValidateMsgType(msgType string) error {
+	parts := strings.Split(msgType, "/")
+	if len(parts) > 2 {
+		return sdkerrors.Wrapf(ErrInvalidMsgType, "%q must be a route or a <route>/<type> msg type", msgType)
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return sdkerrors.Wrapf(ErrInvalidMsgType, "%q must be a route or a <route>/<type> msg type", msgType)
+		}
+	}
+	if parts[0] == RouterKey {
+		return sdkerrors.Wrapf(ErrInvalidMsgType, "the messages of the %s module can't be disabled", ModuleName)
+	}
+
+	return nil
+}
+
+// ValidateMsgTypes validates each of the msg types and returns an error if the
+// list is empty or contains duplicates.
+func ValidateMsgTypes(msgTypes []string) error {
+	if len(msgTypes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgType, "no msg types")
+	}
+
+	seen := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		if seen[msgType] {
+			return sdkerrors.Wrapf(ErrInvalidMsgType, "duplicate msg type %s", msgType)
+		}
+		if err := ValidateMsgType(msgType); err != nil {
+			return err
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+// NewPermissions creates a new Permissions object
+func NewPermissions(level PermissionLevel, limitMsgTypes []string) Permissions {
+	return Permissions{Level: level, LimitMsgTypes: limitMsgTypes}
+}
+
+// Validate performs a basic validation of the permissions.
+func (p Permissions) Validate() error {
+	if err := p.Level.Validate(); err != nil {
+		return err
+	}
+
+	switch p.Level {
+	case LevelAllMsgs:
+		if len(p.LimitMsgTypes) != 0 {
+			return fmt.Errorf("msg types can't be limited with the %s permission level", LevelAllMsgs)
+		}
+
+	case LevelSomeMsgs:
+		if err := ValidateMsgTypes(p.LimitMsgTypes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Allows returns true if the permissions allow tripping and resetting the
+// circuit breaker of the msg type. A permission on a route covers all the msg
+// types of the route.
+func (p Permissions) Allows(msgType string) bool {
+	if p.Level == LevelAllMsgs {
+		return true
+	}
+
+	for _, limit := range p.LimitMsgTypes {
+		if MatchesMsgType(limit, msgType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Permissions) String() string {
+	if p.Level == LevelAllMsgs {
+		return string(p.Level)
+	}
+
+	return fmt.Sprintf("%s: %s", p.Level, strings.Join(p.LimitMsgTypes, ", "))
+}
+
+// NewAccountPermissions creates a new AccountPermissions object
+func NewAccountPermissions(addr sdk.AccAddress, permissions Permissions) AccountPermissions {
+	return AccountPermissions{Address: addr, Permissions: permissions}
+}
+
+// Validate performs a basic validation of the account permissions.
+func (ap AccountPermissions) Validate() error {
+	if ap.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty address")
+	}
+
+	if err := ap.Permissions.Validate(); err != nil {
+		return fmt.Errorf("invalid permissions of %s: %w", ap.Address, err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ap AccountPermissions) String() string {
+	return fmt.Sprintf("%s: %s", ap.Address, ap.Permissions)
+}
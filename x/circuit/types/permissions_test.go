@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestValidateMsgType(t *testing.T) {
+	testCases := []struct {
+		msgType   string
+		expectErr bool
+	}{
+		{"staking", false},
+		{"staking/begin_redelegate", false},
+		{"", true},
+		{"staking/", true},
+		{"/begin_redelegate", true},
+		{"staking/begin_redelegate/extra", true},
+		{types.RouterKey, true},
+		{types.RouterKey + "/" + types.TypeMsgResetCircuitBreaker, true},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateMsgType(tc.msgType)
+		if tc.expectErr {
+			require.Error(t, err, tc.msgType)
+		} else {
+			require.NoError(t, err, tc.msgType)
+		}
+	}
+
+	require.Error(t, types.ValidateMsgTypes(nil))
+	require.Error(t, types.ValidateMsgTypes([]string{"staking", "staking"}))
+	require.NoError(t, types.ValidateMsgTypes([]string{"staking", "staking/begin_redelegate"}))
+}
+
+func TestMatchesMsgType(t *testing.T) {
+	require.Equal(t, "TestMsg/Test message", types.MsgTypeID(sdk.NewTestMsg()))
+
+	require.True(t, types.MatchesMsgType("staking", "staking/begin_redelegate"))
+	require.True(t, types.MatchesMsgType("staking/begin_redelegate", "staking/begin_redelegate"))
+	require.False(t, types.MatchesMsgType("staking/delegate", "staking/begin_redelegate"))
+	require.False(t, types.MatchesMsgType("stak", "staking/begin_redelegate"))
+}
+
+func TestPermissions(t *testing.T) {
+	all := types.NewPermissions(types.LevelAllMsgs, nil)
+	require.NoError(t, all.Validate())
+	require.True(t, all.Allows("staking/begin_redelegate"))
+	require.True(t, all.Allows("bank"))
+
+	some := types.NewPermissions(types.LevelSomeMsgs, []string{"staking", "bank/send"})
+	require.NoError(t, some.Validate())
+	require.True(t, some.Allows("staking"))
+	require.True(t, some.Allows("staking/begin_redelegate"))
+	require.True(t, some.Allows("bank/send"))
+	require.False(t, some.Allows("bank"))
+	require.False(t, some.Allows("bank/multisend"))
+	require.False(t, some.Allows("distribution"))
+
+	require.Error(t, types.NewPermissions(types.LevelAllMsgs, []string{"staking"}).Validate())
+	require.Error(t, types.NewPermissions(types.LevelSomeMsgs, nil).Validate())
+	require.Error(t, types.NewPermissions("none", nil).Validate())
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	all := types.NewPermissions(types.LevelAllMsgs, nil)
+
+	testCases := []struct {
+		msg       string
+		gs        types.GenesisState
+		expectErr bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{
+			"valid",
+			types.NewGenesisState([]types.AccountPermissions{types.NewAccountPermissions(addr, all)}, []string{"staking"}),
+			false,
+		},
+		{
+			"empty address",
+			types.NewGenesisState([]types.AccountPermissions{types.NewAccountPermissions(nil, all)}, nil),
+			true,
+		},
+		{
+			"duplicate account",
+			types.NewGenesisState(
+				[]types.AccountPermissions{types.NewAccountPermissions(addr, all), types.NewAccountPermissions(addr, all)}, nil,
+			),
+			true,
+		},
+		{
+			"invalid permissions",
+			types.NewGenesisState(
+				[]types.AccountPermissions{types.NewAccountPermissions(addr, types.NewPermissions(types.LevelSomeMsgs, nil))}, nil,
+			),
+			true,
+		},
+		{"invalid disabled msg type", types.NewGenesisState(nil, []string{types.ModuleName}), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			if tc.expectErr {
+				require.Error(t, tc.gs.Validate())
+			} else {
+				require.NoError(t, tc.gs.Validate())
+			}
+		})
+	}
+}
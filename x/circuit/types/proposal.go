@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Circuit breaker proposal types
+const (
+	ProposalTypeTripCircuitBreaker  = "TripCircuitBreaker"
+	ProposalTypeResetCircuitBreaker = "ResetCircuitBreaker"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = TripCircuitBreakerProposal{}
+	_ govtypes.Content = ResetCircuitBreakerProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTripCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(TripCircuitBreakerProposal{}, "cosmos-sdk/TripCircuitBreakerProposal")
+	govtypes.RegisterProposalType(ProposalTypeResetCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(ResetCircuitBreakerProposal{}, "cosmos-sdk/ResetCircuitBreakerProposal")
+}
+
+// NewTripCircuitBreakerProposal creates a new TripCircuitBreakerProposal object
+func NewTripCircuitBreakerProposal(title, description string, msgTypes []string) TripCircuitBreakerProposal {
+	return TripCircuitBreakerProposal{Title: title, Description: description, MsgTypes: msgTypes}
+}
+
+// nolint
+func (p TripCircuitBreakerProposal) GetTitle() string       { return p.Title }
+func (p TripCircuitBreakerProposal) GetDescription() string { return p.Description }
+func (p TripCircuitBreakerProposal) ProposalRoute() string  { return RouterKey }
+func (p TripCircuitBreakerProposal) ProposalType() string   { return ProposalTypeTripCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (p TripCircuitBreakerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateMsgTypes(p.MsgTypes)
+}
+
+// String implements the Stringer interface.
+func (p TripCircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Trip Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  Msg Types:   %s
+`, p.Title, p.Description, strings.Join(p.MsgTypes, ", "))
+}
+
+// NewResetCircuitBreakerProposal creates a new ResetCircuitBreakerProposal object
+func NewResetCircuitBreakerProposal(title, description string, msgTypes []string) ResetCircuitBreakerProposal {
+	return ResetCircuitBreakerProposal{Title: title, Description: description, MsgTypes: msgTypes}
+}
+
+// nolint
+func (p ResetCircuitBreakerProposal) GetTitle() string       { return p.Title }
+func (p ResetCircuitBreakerProposal) GetDescription() string { return p.Description }
+func (p ResetCircuitBreakerProposal) ProposalRoute() string  { return RouterKey }
+func (p ResetCircuitBreakerProposal) ProposalType() string   { return ProposalTypeResetCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ResetCircuitBreakerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return ValidateMsgTypes(p.MsgTypes)
+}
+
+// String implements the Stringer interface.
+func (p ResetCircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Reset Circuit Breaker Proposal:
+  Title:       %s
+  Description: %s
+  Msg Types:   %s
+`, p.Title, p.Description, strings.Join(p.MsgTypes, ", "))
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the circuit module
+const (
+	QueryAccounts         = "accounts"
+	QueryAccount          = "account"
+	QueryDisabledMsgTypes = "disabled_msg_types"
+)
+
+// QueryAccountParams defines the params for querying the circuit breaker
+// permissions of an account.
+type QueryAccountParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQueryAccountParams creates a new QueryAccountParams object
+func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
+	return QueryAccountParams{Address: addr}
+}
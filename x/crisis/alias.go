@@ -8,30 +8,67 @@ import (
 )
 
 const (
-	ModuleName           = types.ModuleName
-	DefaultParamspace    = types.DefaultParamspace
-	EventTypeInvariant   = types.EventTypeInvariant
-	AttributeValueCrisis = types.AttributeValueCrisis
-	AttributeKeyRoute    = types.AttributeKeyRoute
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	QuerierRoute                = types.QuerierRoute
+	DefaultParamspace           = types.DefaultParamspace
+	EventTypeInvariant          = types.EventTypeInvariant
+	EventTypeInvariantBroken    = types.EventTypeInvariantBroken
+	EventTypeModuleMsgsDisabled = types.EventTypeModuleMsgsDisabled
+	AttributeValueCrisis        = types.AttributeValueCrisis
+	AttributeKeyRoute           = types.AttributeKeyRoute
+	AttributeKeyPolicy          = types.AttributeKeyPolicy
+	AttributeKeyModule          = types.AttributeKeyModule
+	PolicyHalt                  = types.PolicyHalt
+	PolicyLogAndEmitEvent       = types.PolicyLogAndEmitEvent
+	PolicyDisableModuleMsgs     = types.PolicyDisableModuleMsgs
+	QueryInvariantResults       = types.QueryInvariantResults
+	QueryInvariantResult        = types.QueryInvariantResult
+	QueryDisabledModules        = types.QueryDisabledModules
 )
 
 var (
 	RegisterCodec            = types.RegisterCodec
 	ErrNoSender              = types.ErrNoSender
 	ErrUnknownInvariant      = types.ErrUnknownInvariant
+	ErrModuleMsgsDisabled    = types.ErrModuleMsgsDisabled
+	ErrNoInvariantResult     = types.ErrNoInvariantResult
 	NewGenesisState          = types.NewGenesisState
 	DefaultGenesisState      = types.DefaultGenesisState
 	NewMsgVerifyInvariant    = types.NewMsgVerifyInvariant
+	NewMsgUpdateParams       = types.NewMsgUpdateParams
 	ParamKeyTable            = types.ParamKeyTable
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
 	NewInvarRoute            = types.NewInvarRoute
 	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
 	ModuleCdc                = types.ModuleCdc
 	ParamStoreKeyConstantFee = types.ParamStoreKeyConstantFee
+
+	ParamStoreKeyDefaultInvariantPolicy = types.ParamStoreKeyDefaultInvariantPolicy
+	ParamStoreKeyInvariantPolicies      = types.ParamStoreKeyInvariantPolicies
+	ParamStoreKeyDisabledModules        = types.ParamStoreKeyDisabledModules
+	ProtectedModules                    = types.ProtectedModules
+	IsProtectedModule                   = types.IsProtectedModule
+	NewInvariantPolicyRoute             = types.NewInvariantPolicyRoute
+	ParseInvariantPolicyOverrides       = types.ParseInvariantPolicyOverrides
+	NewInvariantResult                  = types.NewInvariantResult
+	InvariantResultKey                  = types.InvariantResultKey
+	ParamsKey                           = types.ParamsKey
+	NewQueryInvariantResultParams       = types.NewQueryInvariantResultParams
 )
 
 type (
 	GenesisState       = types.GenesisState
 	MsgVerifyInvariant = types.MsgVerifyInvariant
+	MsgUpdateParams    = types.MsgUpdateParams
+	Params             = types.Params
 	InvarRoute         = types.InvarRoute
 	Keeper             = keeper.Keeper
+
+	InvariantPolicy            = types.InvariantPolicy
+	InvariantPolicyRoute       = types.InvariantPolicyRoute
+	InvariantResult            = types.InvariantResult
+	QueryInvariantResultParams = types.QueryInvariantResultParams
 )
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryInvariantResults(cdc),
+		GetCmdQueryDisabledModules(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryInvariantResults implements the query command for the results of
+// broken invariants.
+func GetCmdQueryInvariantResults(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "invariant-results [module-name] [invariant-route]",
+		Short: "Query the results of broken invariants",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last result of all broken invariants, or of a single invariant.
+
+Example:
+$ %s query %s invariant-results
+$ %s query %s invariant-results bank total-supply
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantResults)
+				res, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var results []types.InvariantResult
+				if err := cdc.UnmarshalJSON(res, &results); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(results)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryInvariantResultParams(args[0] + "/" + args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantResult)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.InvariantResult
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryDisabledModules implements the query command for the modules
+// whose messages are disabled.
+func GetCmdQueryDisabledModules(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disabled-modules",
+		Short: "Query the modules whose messages are disabled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDisabledModules)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var modules []string
+			if err := cdc.UnmarshalJSON(res, &modules); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(modules)
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// RegisterRoutes registers the REST routes for the crisis module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	height := []rest.QueryParam{rest.HeightParam}
+
+	rest.Document(r.HandleFunc("/crisis/invariant_results", queryInvariantResultsHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the results of the last checks of all invariants",
+		QueryParams: height,
+		Result:      []types.InvariantResult{},
+	})
+	rest.Document(r.HandleFunc("/crisis/invariant_results/{module}/{route}", queryInvariantResultHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the result of the last check of an invariant",
+		QueryParams: height,
+		Result:      types.InvariantResult{},
+	})
+	rest.Document(r.HandleFunc("/crisis/disabled_modules", queryDisabledModulesHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the modules disabled by the halt invariant policy",
+		QueryParams: height,
+		Result:      []string{},
+	})
+}
+
+func queryInvariantResultsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantResults)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryInvariantResultHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryInvariantResultParams(vars["module"] + "/" + vars["route"]))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariantResult)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryDisabledModulesHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDisabledModules)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -9,10 +9,22 @@ import (
 // new crisis genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
 	keeper.SetConstantFee(ctx, data.ConstantFee)
+	keeper.SetDefaultInvariantPolicy(ctx, data.DefaultInvariantPolicy)
+	keeper.SetInvariantPolicies(ctx, data.InvariantPolicies)
+	keeper.SetDisabledModules(ctx, data.DisabledModules)
+
+	for _, res := range data.InvariantResults {
+		keeper.SetInvariantResult(ctx, res)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
-	constantFee := keeper.GetConstantFee(ctx)
-	return types.NewGenesisState(constantFee)
+	return types.NewGenesisState(
+		keeper.GetConstantFee(ctx),
+		keeper.GetDefaultInvariantPolicy(ctx),
+		keeper.GetInvariantPolicies(ctx),
+		keeper.GetDisabledModules(ctx),
+		keeper.GetAllInvariantResults(ctx),
+	)
 }
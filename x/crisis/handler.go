@@ -18,6 +18,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgVerifyInvariant:
 			return handleMsgVerifyInvariant(ctx, msg, k)
 
+		case types.MsgUpdateParams:
+			return handleMsgUpdateParams(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized crisis message type: %T", msg)
 		}
@@ -37,11 +40,15 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 	found := false
 	msgFullRoute := msg.FullInvariantRoute()
 
-	var res string
-	var stop bool
+	var (
+		res   string
+		stop  bool
+		route types.InvarRoute
+	)
 	for _, invarRoute := range k.Routes() {
 		if invarRoute.FullRoute() == msgFullRoute {
 			res, stop = invarRoute.Invar(cacheCtx)
+			route = invarRoute
 			found = true
 			break
 		}
@@ -52,23 +59,11 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 	}
 
 	if stop {
-		// NOTE currently, because the chain halts here, this transaction will never be included
+		// NOTE currently, when the chain halts here, this transaction will never be included
 		// in the blockchain thus the constant fee will have never been deducted. Thus no
-		// refund is required.
-
-		// TODO uncomment the following code block with implementation of the circuit breaker
-		//// refund constant fee
-		//err := k.distrKeeper.DistributeFromFeePool(ctx, constantFee, msg.Sender)
-		//if err != nil {
-		//// if there are insufficient coins to refund, log the error,
-		//// but still halt the chain.
-		//logger := ctx.Logger().With("module", "x/crisis")
-		//logger.Error(fmt.Sprintf(
-		//"WARNING: insufficient funds to allocate to sender from fee pool, err: %s", err))
-		//}
-
-		// TODO replace with circuit breaker
-		panic(res)
+		// refund is required. Under the other policies, the broken invariant is recorded
+		// and the fee is kept.
+		k.HandleBrokenInvariant(ctx, route, res)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -85,3 +80,19 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgUpdateParams(ctx sdk.Context, msg types.MsgUpdateParams, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCrisis),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -119,3 +119,20 @@ func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *tes
 		res, _ = h(ctx, msg)
 	}, fmt.Sprintf("%v", res))
 }
+
+func TestHandleMsgVerifyInvariantWithLogPolicy(t *testing.T) {
+	app, ctx, addrs := createTestApp()
+	sender := addrs[0]
+	app.CrisisKeeper.SetDefaultInvariantPolicy(ctx, crisis.PolicyLogAndEmitEvent)
+
+	h := crisis.NewHandler(app.CrisisKeeper)
+	msg := crisis.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route)
+
+	res, err := h(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	result, found := app.CrisisKeeper.GetInvariantResult(ctx, testModuleName+"/"+dummyRouteWhichFails.Route)
+	require.True(t, found)
+	require.Equal(t, "whoops", result.Message)
+}
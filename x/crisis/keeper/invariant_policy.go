@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// SetInvariantPolicyOverrides sets the invariant policies chosen by the node
+// operator, by full invariant route. They take precedence over the policies set
+// by governance. Note, policies other than halt change the state, so overrides
+// must be coordinated between validators, e.g. to restart a halted chain.
+//
+// The overrides are shared by all the copies of the keeper, such as the one
+// used by the module handler, so they can be set once the app is created.
+func (k Keeper) SetInvariantPolicyOverrides(overrides map[string]types.InvariantPolicy) {
+	for route := range k.policyOverrides {
+		delete(k.policyOverrides, route)
+	}
+	for route, policy := range overrides {
+		k.policyOverrides[route] = policy
+	}
+}
+
+// GetInvariantPolicy returns the policy of the invariant with the given full
+// route: the operator override if any, else the policy set for the route in
+// the params, else the default policy.
+func (k Keeper) GetInvariantPolicy(ctx sdk.Context, fullRoute string) types.InvariantPolicy {
+	if policy, ok := k.policyOverrides[fullRoute]; ok {
+		return policy
+	}
+
+	for _, route := range k.GetInvariantPolicies(ctx) {
+		if route.Route == fullRoute {
+			return route.Policy
+		}
+	}
+
+	return k.GetDefaultInvariantPolicy(ctx)
+}
+
+// HandleBrokenInvariant handles the broken invariant according to its policy.
+// The chain is halted with a panic under the halt policy. Otherwise, the result
+// is stored, logged and an event is emitted, and under the disable-module-msgs
+// policy the messages of the module of the invariant are disabled.
+func (k Keeper) HandleBrokenInvariant(ctx sdk.Context, ir types.InvarRoute, msg string) {
+	policy := k.GetInvariantPolicy(ctx, ir.FullRoute())
+	if policy == types.PolicyHalt {
+		// TODO: Include app name as part of context to allow for this to be
+		// variable.
+		panic(fmt.Errorf("invariant broken: %s\n"+
+			"\tCRITICAL please submit the following transaction:\n"+
+			"\t\t tx crisis invariant-broken %s %s", msg, ir.ModuleName, ir.Route))
+	}
+
+	k.SetInvariantResult(ctx, types.NewInvariantResult(
+		ir.ModuleName, ir.Route, ctx.BlockHeight(), ctx.BlockTime(), policy, msg,
+	))
+
+	k.Logger(ctx).Error(
+		"invariant broken", "route", ir.FullRoute(), "policy", policy, "height", ctx.BlockHeight(), "msg", msg,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, ir.FullRoute()),
+			sdk.NewAttribute(types.AttributeKeyPolicy, string(policy)),
+		),
+	)
+
+	if policy == types.PolicyDisableModuleMsgs {
+		k.DisableModuleMsgs(ctx, ir.ModuleName)
+	}
+}
+
+// DisableModuleMsgs disables the messages routed to the given module until
+// governance removes it from the DisabledModules param. The messages of the
+// protected modules are never disabled.
+func (k Keeper) DisableModuleMsgs(ctx sdk.Context, module string) {
+	if types.IsProtectedModule(module) {
+		k.Logger(ctx).Error("cannot disable messages of protected module", "module", module)
+		return
+	}
+	if k.IsModuleMsgsDisabled(ctx, module) {
+		return
+	}
+
+	k.SetDisabledModules(ctx, append(k.GetDisabledModules(ctx), module))
+	k.Logger(ctx).Error("disabled module messages", "module", module)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeModuleMsgsDisabled,
+			sdk.NewAttribute(types.AttributeKeyModule, module),
+		),
+	)
+}
+
+// IsModuleMsgsDisabled returns true if the messages of the module are disabled.
+func (k Keeper) IsModuleMsgsDisabled(ctx sdk.Context, module string) bool {
+	for _, disabled := range k.GetDisabledModules(ctx) {
+		if disabled == module {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckMsgs returns an error if any of the messages, or of the messages nested
+// in them, is routed to a module disabled by the disable-module-msgs policy.
+func (k Keeper) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range sdk.FlattenMsgs(msgs) {
+		if k.IsModuleMsgsDisabled(ctx, msg.Route()) {
+			return sdkerrors.Wrapf(types.ErrModuleMsgsDisabled, "module %s, message %s", msg.Route(), msg.Type())
+		}
+	}
+
+	return nil
+}
+
+// MsgFilter returns the message filter rejecting the messages routed to the
+// modules disabled by the disable-module-msgs policy, to be set on BaseApp.
+func (k Keeper) MsgFilter() sdk.MsgFilter {
+	return func(ctx sdk.Context, msg sdk.Msg) error {
+		return k.CheckMsgs(ctx, []sdk.Msg{msg})
+	}
+}
+
+// SetInvariantResult stores the result of a broken invariant, replacing the
+// previous result of the invariant.
+func (k Keeper) SetInvariantResult(ctx sdk.Context, res types.InvariantResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.InvariantResultKey(res.FullRoute()), k.cdc.MustMarshalBinaryBare(&res))
+}
+
+// GetInvariantResult returns the last result stored for the invariant with the
+// given full route.
+func (k Keeper) GetInvariantResult(ctx sdk.Context, fullRoute string) (res types.InvariantResult, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.InvariantResultKey(fullRoute))
+	if bz == nil {
+		return res, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &res)
+	return res, true
+}
+
+// IterateInvariantResults iterates over the stored invariant results. If the
+// callback returns true, the iteration stops.
+func (k Keeper) IterateInvariantResults(ctx sdk.Context, cb func(res types.InvariantResult) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InvariantResultPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var res types.InvariantResult
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &res)
+
+		if cb(res) {
+			break
+		}
+	}
+}
+
+// GetAllInvariantResults returns all the stored invariant results.
+func (k Keeper) GetAllInvariantResults(ctx sdk.Context) []types.InvariantResult {
+	results := []types.InvariantResult{}
+	k.IterateInvariantResults(ctx, func(res types.InvariantResult) bool {
+		results = append(results, res)
+		return false
+	})
+
+	return results
+}
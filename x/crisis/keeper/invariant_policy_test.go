@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestGetInvariantPolicy(t *testing.T) {
+	app := createTestApp()
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	require.Equal(t, types.PolicyHalt, app.CrisisKeeper.GetInvariantPolicy(ctx, "testModule/testRoute"))
+
+	app.CrisisKeeper.SetDefaultInvariantPolicy(ctx, types.PolicyLogAndEmitEvent)
+	require.Equal(t, types.PolicyLogAndEmitEvent, app.CrisisKeeper.GetInvariantPolicy(ctx, "testModule/testRoute"))
+
+	app.CrisisKeeper.SetInvariantPolicies(ctx, []types.InvariantPolicyRoute{
+		types.NewInvariantPolicyRoute("testModule/testRoute", types.PolicyDisableModuleMsgs),
+	})
+	require.Equal(t, types.PolicyDisableModuleMsgs, app.CrisisKeeper.GetInvariantPolicy(ctx, "testModule/testRoute"))
+	require.Equal(t, types.PolicyLogAndEmitEvent, app.CrisisKeeper.GetInvariantPolicy(ctx, "testModule/otherRoute"))
+
+	// operator overrides take precedence over the params
+	app.CrisisKeeper.SetInvariantPolicyOverrides(map[string]types.InvariantPolicy{
+		"testModule/testRoute": types.PolicyHalt,
+	})
+	require.Equal(t, types.PolicyHalt, app.CrisisKeeper.GetInvariantPolicy(ctx, "testModule/testRoute"))
+}
+
+func TestAssertInvariantsLogAndEmitEvent(t *testing.T) {
+	app := createTestApp()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10})
+
+	app.CrisisKeeper.SetDefaultInvariantPolicy(ctx, types.PolicyLogAndEmitEvent)
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "whoops", true })
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+
+	res, found := app.CrisisKeeper.GetInvariantResult(ctx, "testModule/testRoute")
+	require.True(t, found)
+	require.Equal(t, "testModule", res.ModuleName)
+	require.Equal(t, "testRoute", res.Route)
+	require.Equal(t, int64(10), res.Height)
+	require.Equal(t, types.PolicyLogAndEmitEvent, res.Policy)
+	require.Equal(t, "whoops", res.Message)
+	require.Equal(t, []types.InvariantResult{res}, app.CrisisKeeper.GetAllInvariantResults(ctx))
+
+	require.Empty(t, app.CrisisKeeper.GetDisabledModules(ctx))
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeInvariantBroken, ctx.EventManager().Events()[0].Type)
+}
+
+func TestAssertInvariantsDisableModuleMsgs(t *testing.T) {
+	app := createTestApp()
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	app.CrisisKeeper.SetInvariantPolicies(ctx, []types.InvariantPolicyRoute{
+		types.NewInvariantPolicyRoute("testModule/testRoute", types.PolicyDisableModuleMsgs),
+	})
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "whoops", true })
+
+	filter := app.CrisisKeeper.MsgFilter()
+	require.NoError(t, filter(ctx, sdk.NewTestMsg()))
+
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	require.Equal(t, []string{"testModule"}, app.CrisisKeeper.GetDisabledModules(ctx))
+	require.True(t, app.CrisisKeeper.IsModuleMsgsDisabled(ctx, "testModule"))
+
+	// the messages of other modules are not rejected
+	require.NoError(t, filter(ctx, sdk.NewTestMsg()))
+
+	// breaking the invariant again doesn't disable the module twice
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+	require.Equal(t, []string{"testModule"}, app.CrisisKeeper.GetDisabledModules(ctx))
+
+	// test messages are routed to the "TestMsg" route
+	app.CrisisKeeper.DisableModuleMsgs(ctx, "TestMsg")
+	err := filter(ctx, sdk.NewTestMsg())
+	require.Error(t, err)
+	require.True(t, types.ErrModuleMsgsDisabled.Is(err))
+
+	// the messages executed by a proposal are rejected on its submission
+	content, err := std.NewExecProposal(
+		"title", "description", bank.NewMsgUpdateParams(app.GovKeeper.GetAuthority(), bank.DefaultParams()),
+	)
+	require.NoError(t, err)
+	nested, err := std.NewMsgSubmitProposal(content, nil, sdk.AccAddress([]byte("addr1_______________")))
+	require.NoError(t, err)
+	require.NoError(t, filter(ctx, nested))
+	app.CrisisKeeper.DisableModuleMsgs(ctx, bank.ModuleName)
+	require.Error(t, app.CrisisKeeper.CheckMsgs(ctx, []sdk.Msg{nested}))
+	require.Error(t, filter(ctx, nested))
+
+	// governance re-enables the modules through the params
+	app.CrisisKeeper.SetDisabledModules(ctx, []string{})
+	require.NoError(t, filter(ctx, sdk.NewTestMsg()))
+	require.NoError(t, filter(ctx, nested))
+}
+
+func TestDisableProtectedModuleMsgs(t *testing.T) {
+	app := createTestApp()
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	for _, module := range types.ProtectedModules {
+		app.CrisisKeeper.DisableModuleMsgs(ctx, module)
+		require.False(t, app.CrisisKeeper.IsModuleMsgsDisabled(ctx, module))
+	}
+	require.Empty(t, app.CrisisKeeper.GetDisabledModules(ctx))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,30 +14,47 @@ import (
 
 // Keeper - crisis keeper
 type Keeper struct {
+	cdc            codec.Marshaler
+	storeKey       sdk.StoreKey
 	routes         []types.InvarRoute
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
+	// policies set by the node operator, overriding the ones of the params
+	policyOverrides map[string]types.InvariantPolicy
+
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	authority sdk.AccAddress
 }
 
-// NewKeeper creates a new Keeper object
+// NewKeeper creates a new Keeper object. The authority is the only address
+// allowed to update the module parameters through MsgUpdateParams.
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace, invCheckPeriod uint,
+	supplyKeeper types.SupplyKeeper, feeCollectorName string, authority sdk.AccAddress,
 ) Keeper {
 
 	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
 		routes:           make([]types.InvarRoute, 0),
+		policyOverrides:  make(map[string]types.InvariantPolicy),
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -62,8 +80,8 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
-// AssertInvariants asserts all registered invariants. If any invariant fails,
-// the method panics.
+// AssertInvariants asserts all registered invariants. Broken invariants are
+// handled according to their policy, see HandleBrokenInvariant.
 func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
@@ -72,11 +90,7 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 
 	for _, ir := range invarRoutes {
 		if res, stop := ir.Invar(ctx); stop {
-			// TODO: Include app name as part of context to allow for this to be
-			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
-				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+			k.HandleBrokenInvariant(ctx, ir, res)
 		}
 	}
 
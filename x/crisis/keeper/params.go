@@ -2,16 +2,113 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// GetConstantFee get's the constant fee from the paramSpace
+// paramStore returns the store of the crisis parameters.
+func (k Keeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(k.cdc, k.storeKey, types.ParamsKey, k.paramSpace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams returns the total set of crisis parameters. Parameters that have
+// not been migrated to the module store yet are read from the legacy param
+// space.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramStore().Get(ctx, &params)
+	return params
+}
+
+// SetParams sets the crisis parameters in the module store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore().Set(ctx, &params)
+}
+
+// UpdateParams validates and sets the crisis parameters on behalf of the given
+// authority, which must be the authority of the module.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(k.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the crisis parameters from the legacy x/params
+// subspace to the module store.
+func (k Keeper) MigrateParams(ctx sdk.Context) error {
+	return k.paramStore().Migrate(ctx)
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return k.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return k.paramStore().SetParamSet(ctx, ps)
+}
+
+// GetConstantFee get's the constant fee
 func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
-	return
+	return k.GetParams(ctx).ConstantFee
 }
 
-// GetConstantFee set's the constant fee in the paramSpace
+// GetConstantFee set's the constant fee
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+	params := k.GetParams(ctx)
+	params.ConstantFee = constantFee
+	k.SetParams(ctx, params)
+}
+
+// GetDefaultInvariantPolicy returns the policy of the invariants without their
+// own policy. It is halt if the param is not set.
+func (k Keeper) GetDefaultInvariantPolicy(ctx sdk.Context) types.InvariantPolicy {
+	policy := k.GetParams(ctx).DefaultInvariantPolicy
+	if policy == "" {
+		return types.PolicyHalt
+	}
+
+	return policy
+}
+
+// SetDefaultInvariantPolicy sets the policy of the invariants without their own
+// policy.
+func (k Keeper) SetDefaultInvariantPolicy(ctx sdk.Context, policy types.InvariantPolicy) {
+	params := k.GetParams(ctx)
+	params.DefaultInvariantPolicy = policy
+	k.SetParams(ctx, params)
+}
+
+// GetInvariantPolicies returns the policies of specific invariants.
+func (k Keeper) GetInvariantPolicies(ctx sdk.Context) (policies []types.InvariantPolicyRoute) {
+	return k.GetParams(ctx).InvariantPolicies
+}
+
+// SetInvariantPolicies sets the policies of specific invariants.
+func (k Keeper) SetInvariantPolicies(ctx sdk.Context, policies []types.InvariantPolicyRoute) {
+	params := k.GetParams(ctx)
+	params.InvariantPolicies = policies
+	k.SetParams(ctx, params)
+}
+
+// GetDisabledModules returns the modules whose messages are disabled.
+func (k Keeper) GetDisabledModules(ctx sdk.Context) (modules []string) {
+	return k.GetParams(ctx).DisabledModules
+}
+
+// SetDisabledModules sets the modules whose messages are disabled.
+func (k Keeper) SetDisabledModules(ctx sdk.Context, modules []string) {
+	params := k.GetParams(ctx)
+	params.DisabledModules = modules
+	k.SetParams(ctx, params)
 }
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// NewQuerier creates a querier for the crisis module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryInvariantResults:
+			res, err = queryInvariantResults(ctx, k)
+
+		case types.QueryInvariantResult:
+			res, err = queryInvariantResult(ctx, req, k)
+
+		case types.QueryDisabledModules:
+			res, err = queryDisabledModules(ctx, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryInvariantResults(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetAllInvariantResults(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryInvariantResult(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryInvariantResultParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	result, found := k.GetInvariantResult(ctx, params.Route)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoInvariantResult, params.Route)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDisabledModules(ctx sdk.Context, k Keeper) ([]byte, error) {
+	modules := k.GetDisabledModules(ctx)
+	if modules == nil {
+		modules = []string{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, modules)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
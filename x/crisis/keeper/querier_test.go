@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestQuerier(t *testing.T) {
+	app := createTestApp()
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	querier := keeper.NewQuerier(app.CrisisKeeper)
+
+	// empty state
+	bz, err := querier(ctx, []string{types.QueryInvariantResults}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var results []types.InvariantResult
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &results))
+	require.Empty(t, results)
+
+	bz, err = querier(ctx, []string{types.QueryDisabledModules}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var modules []string
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &modules))
+	require.Empty(t, modules)
+
+	params := types.ModuleCdc.MustMarshalJSON(types.NewQueryInvariantResultParams("testModule/testRoute"))
+	_, err = querier(ctx, []string{types.QueryInvariantResult}, abci.RequestQuery{Data: params})
+	require.Error(t, err)
+
+	// populated state
+	expected := types.NewInvariantResult(
+		"testModule", "testRoute", 5, time.Unix(100, 0).UTC(), types.PolicyDisableModuleMsgs, "whoops",
+	)
+	app.CrisisKeeper.SetInvariantResult(ctx, expected)
+	app.CrisisKeeper.DisableModuleMsgs(ctx, "testModule")
+
+	bz, err = querier(ctx, []string{types.QueryInvariantResults}, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &results))
+	require.Equal(t, []types.InvariantResult{expected}, results)
+
+	bz, err = querier(ctx, []string{types.QueryInvariantResult}, abci.RequestQuery{Data: params})
+	require.NoError(t, err)
+	var result types.InvariantResult
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &result))
+	require.Equal(t, expected, result)
+
+	bz, err = querier(ctx, []string{types.QueryDisabledModules}, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &modules))
+	require.Equal(t, []string{"testModule"}, modules)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
@@ -0,0 +1,19 @@
+package v039
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// MigrateInvariantPolicies sets the invariant policy crisis params. Broken
+// invariants halt the chain and no module messages are disabled, so crisis
+// handling remains unchanged until governance sets other policies. The params
+// are written to the module store, with the constant fee of the x/params
+// subspace.
+func MigrateInvariantPolicies(ctx sdk.Context, k keeper.Keeper) error {
+	k.SetDefaultInvariantPolicy(ctx, types.PolicyHalt)
+	k.SetInvariantPolicies(ctx, []types.InvariantPolicyRoute{})
+	k.SetDisabledModules(ctx, []string{})
+	return nil
+}
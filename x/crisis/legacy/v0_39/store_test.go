@@ -0,0 +1,32 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v039crisis "github.com/cosmos/cosmos-sdk/x/crisis/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestMigrateInvariantPolicies(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// simulate a chain storing only the constant fee in the x/params subspace
+	constantFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)
+	app.GetSubspace(types.ModuleName).Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	require.NoError(t, v039crisis.MigrateInvariantPolicies(ctx, app.CrisisKeeper))
+
+	params := app.CrisisKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, constantFee, params.ConstantFee)
+	require.Equal(t, types.PolicyHalt, params.DefaultInvariantPolicy)
+	require.Empty(t, app.CrisisKeeper.GetInvariantPolicies(ctx))
+	require.Empty(t, app.CrisisKeeper.GetDisabledModules(ctx))
+}
@@ -14,7 +14,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	v039 "github.com/cosmos/cosmos-sdk/x/crisis/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
@@ -52,16 +54,20 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, bz json.RawMessag
 	return types.ValidateGenesis(data)
 }
 
-// RegisterRESTRoutes registers no REST routes for the crisis module.
-func (AppModuleBasic) RegisterRESTRoutes(_ context.CLIContext, _ *mux.Router) {}
+// RegisterRESTRoutes registers the REST routes for the crisis module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	rest.RegisterRoutes(ctx, rtr)
+}
 
 // GetTxCmd returns the root tx command for the crisis module.
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetTxCmd(cdc)
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(cdc)
+}
 
 //____________________________________________________________________________
 
@@ -101,11 +107,13 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(*am.keeper)
 }
 
-// QuerierRoute returns no querier route.
-func (AppModule) QuerierRoute() string { return "" }
+// QuerierRoute returns the crisis module's querier route name.
+func (AppModule) QuerierRoute() string { return QuerierRoute }
 
-// NewQuerierHandler returns no sdk.Querier.
-func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+// NewQuerierHandler returns the crisis module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(*am.keeper)
+}
 
 // InitGenesis performs genesis initialization for the crisis module. It returns
 // no validator updates.
@@ -134,3 +142,24 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	EndBlocker(ctx, *am.keeper)
 	return []abci.ValidatorUpdate{}
 }
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterMigrations registers the in-place store migrations of the crisis
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 adds the invariant policy params
+	err := cfg.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		return v039.MigrateInvariantPolicies(ctx, *am.keeper)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 stores the params in the module store
+	err = cfg.RegisterMigration(ModuleName, 2, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 // on the provided Amino codec. These types are used for Amino JSON serialization.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgVerifyInvariant{}, "cosmos-sdk/MsgVerifyInvariant", nil)
+	cdc.RegisterConcrete(MsgUpdateParams{}, "cosmos-sdk/MsgUpdateCrisisParams", nil)
 }
 
 var (
@@ -6,6 +6,8 @@ import (
 
 // x/crisis module sentinel errors
 var (
-	ErrNoSender         = sdkerrors.Register(ModuleName, 2, "sender address is empty")
-	ErrUnknownInvariant = sdkerrors.Register(ModuleName, 3, "unknown invariant")
+	ErrNoSender           = sdkerrors.Register(ModuleName, 2, "sender address is empty")
+	ErrUnknownInvariant   = sdkerrors.Register(ModuleName, 3, "unknown invariant")
+	ErrModuleMsgsDisabled = sdkerrors.Register(ModuleName, 4, "module messages are disabled")
+	ErrNoInvariantResult  = sdkerrors.Register(ModuleName, 5, "no result for invariant")
 )
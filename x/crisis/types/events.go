@@ -2,8 +2,12 @@ package types
 
 // crisis module event types
 const (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant          = "invariant"
+	EventTypeInvariantBroken    = "invariant_broken"
+	EventTypeModuleMsgsDisabled = "module_msgs_disabled"
 
 	AttributeValueCrisis = ModuleName
 	AttributeKeyRoute    = "route"
+	AttributeKeyPolicy   = "policy"
+	AttributeKeyModule   = "module_name"
 )
@@ -8,20 +8,35 @@ import (
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	ConstantFee sdk.Coin `json:"constant_fee" yaml:"constant_fee"`
+	ConstantFee            sdk.Coin               `json:"constant_fee" yaml:"constant_fee"`
+	DefaultInvariantPolicy InvariantPolicy        `json:"default_invariant_policy" yaml:"default_invariant_policy"`
+	InvariantPolicies      []InvariantPolicyRoute `json:"invariant_policies" yaml:"invariant_policies"`
+	DisabledModules        []string               `json:"disabled_modules" yaml:"disabled_modules"`
+	InvariantResults       []InvariantResult      `json:"invariant_results" yaml:"invariant_results"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) GenesisState {
+func NewGenesisState(
+	constantFee sdk.Coin, defaultInvariantPolicy InvariantPolicy, invariantPolicies []InvariantPolicyRoute,
+	disabledModules []string, invariantResults []InvariantResult,
+) GenesisState {
 	return GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:            constantFee,
+		DefaultInvariantPolicy: defaultInvariantPolicy,
+		InvariantPolicies:      invariantPolicies,
+		DisabledModules:        disabledModules,
+		InvariantResults:       invariantResults,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:            sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		DefaultInvariantPolicy: PolicyHalt,
+		InvariantPolicies:      []InvariantPolicyRoute{},
+		DisabledModules:        []string{},
+		InvariantResults:       []InvariantResult{},
 	}
 }
 
@@ -30,5 +45,24 @@ func ValidateGenesis(data GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
+	if err := validateDefaultInvariantPolicy(data.DefaultInvariantPolicy); err != nil {
+		return err
+	}
+	if err := validateInvariantPolicies(data.InvariantPolicies); err != nil {
+		return err
+	}
+	if err := validateDisabledModules(data.DisabledModules); err != nil {
+		return err
+	}
+
+	for _, res := range data.InvariantResults {
+		if err := validateFullRoute(res.FullRoute()); err != nil {
+			return err
+		}
+		if err := res.Policy.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -3,4 +3,27 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the default store key for crisis
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for crisis
+	QuerierRoute = ModuleName
 )
+
+// Keys for crisis store
+// Items are stored with the following key: values
+//
+// - 0x01<fullRoute_Bytes>: InvariantResult
+//
+// - 0x02: Params
+var (
+	InvariantResultPrefix = []byte{0x01}
+	ParamsKey             = []byte{0x02}
+)
+
+// InvariantResultKey returns the key of the result of the invariant with the
+// given full route.
+func InvariantResultKey(fullRoute string) []byte {
+	return append(InvariantResultPrefix, []byte(fullRoute)...)
+}
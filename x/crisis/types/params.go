@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -15,15 +18,70 @@ const (
 var (
 	// key for constant fee parameter
 	ParamStoreKeyConstantFee = []byte("ConstantFee")
+	// key for the policy of invariants without their own policy
+	ParamStoreKeyDefaultInvariantPolicy = []byte("DefaultInvariantPolicy")
+	// key for the policies of specific invariants
+	ParamStoreKeyInvariantPolicies = []byte("InvariantPolicies")
+	// key for the modules whose messages are disabled
+	ParamStoreKeyDisabledModules = []byte("DisabledModules")
 )
 
 // type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee),
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	constantFee sdk.Coin, defaultInvariantPolicy InvariantPolicy, invariantPolicies []InvariantPolicyRoute,
+	disabledModules []string,
+) Params {
+	return Params{
+		ConstantFee:            constantFee,
+		DefaultInvariantPolicy: defaultInvariantPolicy,
+		InvariantPolicies:      invariantPolicies,
+		DisabledModules:        disabledModules,
+	}
+}
+
+// DefaultParams returns the default parameters of the crisis module
+func DefaultParams() Params {
+	return NewParams(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)), PolicyHalt, []InvariantPolicyRoute{}, []string{},
 	)
 }
 
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyConstantFee, &p.ConstantFee, validateConstantFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyDefaultInvariantPolicy, &p.DefaultInvariantPolicy, validateDefaultInvariantPolicy),
+		paramtypes.NewParamSetPair(ParamStoreKeyInvariantPolicies, &p.InvariantPolicies, validateInvariantPolicies),
+		paramtypes.NewParamSetPair(ParamStoreKeyDisabledModules, &p.DisabledModules, validateDisabledModules),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateConstantFee(p.ConstantFee); err != nil {
+		return err
+	}
+	if err := validateDefaultInvariantPolicy(p.DefaultInvariantPolicy); err != nil {
+		return err
+	}
+	if err := validateInvariantPolicies(p.InvariantPolicies); err != nil {
+		return err
+	}
+
+	return validateDisabledModules(p.DisabledModules)
+}
+
 func validateConstantFee(i interface{}) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
@@ -36,3 +94,55 @@ func validateConstantFee(i interface{}) error {
 
 	return nil
 }
+
+func validateDefaultInvariantPolicy(i interface{}) error {
+	v, ok := i.(InvariantPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateInvariantPolicies(i interface{}) error {
+	v, ok := i.([]InvariantPolicyRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, route := range v {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if seen[route.Route] {
+			return fmt.Errorf("duplicate invariant policy for route %s", route.Route)
+		}
+		seen[route.Route] = true
+	}
+
+	return nil
+}
+
+func validateDisabledModules(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, module := range v {
+		if strings.TrimSpace(module) == "" {
+			return fmt.Errorf("disabled module name cannot be blank")
+		}
+		if IsProtectedModule(module) {
+			return fmt.Errorf("messages of module %s cannot be disabled", module)
+		}
+		if seen[module] {
+			return fmt.Errorf("duplicate disabled module %s", module)
+		}
+		seen[module] = true
+	}
+
+	return nil
+}
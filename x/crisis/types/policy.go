@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvariantPolicy defines what happens when an invariant is broken.
+type InvariantPolicy string
+
+// Invariant policies
+const (
+	// PolicyHalt halts the chain by panicking.
+	PolicyHalt InvariantPolicy = "halt"
+	// PolicyLogAndEmitEvent records the broken invariant, logs it and emits an
+	// event, and lets the chain continue.
+	PolicyLogAndEmitEvent InvariantPolicy = "log-and-emit-event"
+	// PolicyDisableModuleMsgs does the same as PolicyLogAndEmitEvent and also
+	// disables the messages of the module of the invariant until governance
+	// re-enables them.
+	PolicyDisableModuleMsgs InvariantPolicy = "disable-module-msgs"
+)
+
+// ProtectedModules lists the modules whose messages are never disabled, so
+// that governance can always re-enable disabled modules.
+var ProtectedModules = []string{"gov", ModuleName}
+
+// IsProtectedModule returns true if the messages of the module can never be
+// disabled.
+func IsProtectedModule(module string) bool {
+	for _, protected := range ProtectedModules {
+		if module == protected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error if the policy is unknown.
+func (p InvariantPolicy) Validate() error {
+	switch p {
+	case PolicyHalt, PolicyLogAndEmitEvent, PolicyDisableModuleMsgs:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown invariant policy: %q", p)
+	}
+}
+
+// NewInvariantPolicyRoute creates a new InvariantPolicyRoute object
+func NewInvariantPolicyRoute(route string, policy InvariantPolicy) InvariantPolicyRoute {
+	return InvariantPolicyRoute{Route: route, Policy: policy}
+}
+
+// Validate performs basic validation of the invariant policy route.
+func (r InvariantPolicyRoute) Validate() error {
+	if err := validateFullRoute(r.Route); err != nil {
+		return err
+	}
+
+	return r.Policy.Validate()
+}
+
+// ParseInvariantPolicyOverrides parses the invariant policies set by a node
+// operator, given as "<module>/<route>=<policy>" entries.
+func ParseInvariantPolicyOverrides(entries []string) (map[string]InvariantPolicy, error) {
+	overrides := make(map[string]InvariantPolicy, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid invariant policy %q, expected <module>/<route>=<policy>", entry)
+		}
+
+		route := NewInvariantPolicyRoute(strings.TrimSpace(parts[0]), InvariantPolicy(strings.TrimSpace(parts[1])))
+		if err := route.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := overrides[route.Route]; ok {
+			return nil, fmt.Errorf("duplicate invariant policy for route %s", route.Route)
+		}
+
+		overrides[route.Route] = route.Policy
+	}
+
+	return overrides, nil
+}
+
+func validateFullRoute(route string) error {
+	parts := strings.Split(route, "/")
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return fmt.Errorf("invalid invariant route %q, expected <module>/<route>", route)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+// Querier routes for the crisis module
+const (
+	QueryInvariantResults = "invariant_results"
+	QueryInvariantResult  = "invariant_result"
+	QueryDisabledModules  = "disabled_modules"
+)
+
+// QueryInvariantResultParams defines the parameters necessary for querying the
+// result of an invariant.
+type QueryInvariantResultParams struct {
+	Route string `json:"route" yaml:"route"`
+}
+
+// NewQueryInvariantResultParams creates a new QueryInvariantResultParams object
+// for the invariant with the given full route, i.e. "<module>/<route>".
+func NewQueryInvariantResultParams(route string) QueryInvariantResultParams {
+	return QueryInvariantResultParams{Route: route}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// NewInvariantResult creates a new InvariantResult object
+func NewInvariantResult(
+	moduleName, route string, height int64, t time.Time, policy InvariantPolicy, msg string,
+) InvariantResult {
+	return InvariantResult{
+		ModuleName: moduleName,
+		Route:      route,
+		Height:     height,
+		Time:       t,
+		Policy:     policy,
+		Message:    msg,
+	}
+}
+
+// FullRoute returns the full route of the broken invariant.
+func (r InvariantResult) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+func (r InvariantResult) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
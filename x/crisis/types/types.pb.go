@@ -7,17 +7,22 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -86,33 +91,327 @@ func (m *MsgVerifyInvariant) GetInvariantRoute() string {
 	return ""
 }
 
+// InvariantResult defines the record of a broken invariant, stored for the
+// failure to be investigated.
+type InvariantResult struct {
+	ModuleName string          `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	Route      string          `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	Height     int64           `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time       time.Time       `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	Policy     InvariantPolicy `protobuf:"bytes,5,opt,name=policy,proto3,casttype=InvariantPolicy" json:"policy,omitempty"`
+	Message    string          `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()      { *m = InvariantResult{} }
+func (*InvariantResult) ProtoMessage() {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d15f5abb7502dad7, []int{1}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InvariantResult) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *InvariantResult) GetPolicy() InvariantPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// MsgUpdateParams defines an SDK message for replacing the parameters of the
+// crisis module. It must be signed by the module authority.
+type MsgUpdateParams struct {
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+	Params    Params                                        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d15f5abb7502dad7, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params defines the parameters of the crisis module.
+type Params struct {
+	ConstantFee types.Coin `protobuf:"bytes,1,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee" yaml:"constant_fee"`
+	// policy of the invariants without their own policy
+	DefaultInvariantPolicy InvariantPolicy `protobuf:"bytes,2,opt,name=default_invariant_policy,json=defaultInvariantPolicy,proto3,casttype=InvariantPolicy" json:"default_invariant_policy,omitempty" yaml:"default_invariant_policy"`
+	// policies of specific invariants
+	InvariantPolicies []InvariantPolicyRoute `protobuf:"bytes,3,rep,name=invariant_policies,json=invariantPolicies,proto3" json:"invariant_policies" yaml:"invariant_policies"`
+	// modules whose messages are disabled
+	DisabledModules []string `protobuf:"bytes,4,rep,name=disabled_modules,json=disabledModules,proto3" json:"disabled_modules,omitempty" yaml:"disabled_modules"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d15f5abb7502dad7, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetConstantFee() types.Coin {
+	if m != nil {
+		return m.ConstantFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDefaultInvariantPolicy() InvariantPolicy {
+	if m != nil {
+		return m.DefaultInvariantPolicy
+	}
+	return ""
+}
+
+func (m *Params) GetInvariantPolicies() []InvariantPolicyRoute {
+	if m != nil {
+		return m.InvariantPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetDisabledModules() []string {
+	if m != nil {
+		return m.DisabledModules
+	}
+	return nil
+}
+
+// InvariantPolicyRoute defines the policy of a single invariant, identified by
+// its full route, i.e. "<module>/<route>".
+type InvariantPolicyRoute struct {
+	Route  string          `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+	Policy InvariantPolicy `protobuf:"bytes,2,opt,name=policy,proto3,casttype=InvariantPolicy" json:"policy"`
+}
+
+func (m *InvariantPolicyRoute) Reset()         { *m = InvariantPolicyRoute{} }
+func (m *InvariantPolicyRoute) String() string { return proto.CompactTextString(m) }
+func (*InvariantPolicyRoute) ProtoMessage()    {}
+func (*InvariantPolicyRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d15f5abb7502dad7, []int{4}
+}
+func (m *InvariantPolicyRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantPolicyRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantPolicyRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantPolicyRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantPolicyRoute.Merge(m, src)
+}
+func (m *InvariantPolicyRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantPolicyRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantPolicyRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantPolicyRoute proto.InternalMessageInfo
+
+func (m *InvariantPolicyRoute) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantPolicyRoute) GetPolicy() InvariantPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgVerifyInvariant)(nil), "cosmos_sdk.x.crisis.v1.MsgVerifyInvariant")
+	proto.RegisterType((*InvariantResult)(nil), "cosmos_sdk.x.crisis.v1.InvariantResult")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos_sdk.x.crisis.v1.MsgUpdateParams")
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.crisis.v1.Params")
+	proto.RegisterType((*InvariantPolicyRoute)(nil), "cosmos_sdk.x.crisis.v1.InvariantPolicyRoute")
 }
 
 func init() { proto.RegisterFile("x/crisis/types/types.proto", fileDescriptor_d15f5abb7502dad7) }
 
 var fileDescriptor_d15f5abb7502dad7 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xaa, 0xd0, 0x4f, 0x2e,
-	0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x85, 0x92, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x62, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0xc5, 0x29, 0xd9, 0x7a, 0x15, 0x7a,
-	0x10, 0x65, 0x7a, 0x65, 0x86, 0x52, 0x6a, 0x25, 0x19, 0x99, 0x45, 0x29, 0xf1, 0x05, 0x89, 0x45,
-	0x25, 0x95, 0xfa, 0x60, 0xa5, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0x08, 0x16, 0x44, 0xbf, 0x52, 0x03,
-	0x13, 0x97, 0x90, 0x6f, 0x71, 0x7a, 0x58, 0x6a, 0x51, 0x66, 0x5a, 0xa5, 0x67, 0x5e, 0x59, 0x62,
-	0x51, 0x66, 0x62, 0x5e, 0x89, 0x90, 0x27, 0x17, 0x5b, 0x71, 0x6a, 0x5e, 0x4a, 0x6a, 0x91, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x8f, 0x93, 0xe1, 0xaf, 0x7b, 0xf2, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x5b, 0xa1, 0x94, 0x6e, 0x71, 0x4a, 0x36, 0xd4, 0x51,
-	0x8e, 0xc9, 0xc9, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x41, 0x50, 0x03, 0x84, 0x42, 0xb8,
-	0x44, 0x33, 0x61, 0xe6, 0xc6, 0xe7, 0xe6, 0xa7, 0x94, 0xe6, 0xa4, 0xc6, 0xe7, 0x25, 0xe6, 0xa6,
-	0x4a, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x3a, 0x29, 0x7c, 0xba, 0x27, 0x2f, 0x53, 0x99, 0x98, 0x9b,
-	0x63, 0xa5, 0x84, 0x55, 0x99, 0x52, 0x90, 0x30, 0x5c, 0xdc, 0x17, 0x2c, 0xec, 0x97, 0x98, 0x9b,
-	0x2a, 0xe4, 0xcc, 0xc5, 0x8f, 0x50, 0x5e, 0x94, 0x5f, 0x5a, 0x92, 0x2a, 0xc1, 0x0c, 0x36, 0x4f,
-	0xea, 0xd3, 0x3d, 0x79, 0x31, 0x74, 0xf3, 0xc0, 0x0a, 0x94, 0x82, 0xf8, 0xe0, 0x22, 0x41, 0x20,
-	0x01, 0x2b, 0x96, 0x17, 0x0b, 0xe4, 0x19, 0x9d, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x1b, 0xaf, 0x8f, 0x51, 0x63, 0x25, 0x89, 0x0d, 0x1c, 0xa0, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x65, 0xef, 0x2c, 0xae, 0x01, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6f, 0x1a, 0x49,
+	0x18, 0x65, 0x0d, 0xde, 0x33, 0x83, 0x75, 0x9c, 0x07, 0x1f, 0xb7, 0x87, 0xef, 0x18, 0x6e, 0x8b,
+	0x13, 0x92, 0xe3, 0x45, 0x26, 0xca, 0x0f, 0xa1, 0x34, 0x5e, 0x2b, 0x96, 0x5c, 0x38, 0xb1, 0x36,
+	0x4e, 0x8a, 0x34, 0x68, 0x61, 0x87, 0x65, 0x64, 0x96, 0x59, 0xed, 0x0c, 0x96, 0x69, 0xa2, 0xfc,
+	0x09, 0x2e, 0x53, 0x3a, 0x4d, 0xfe, 0x16, 0x97, 0x2e, 0x53, 0x6d, 0x22, 0xbb, 0x89, 0x5c, 0x52,
+	0xba, 0x49, 0xc4, 0xcc, 0xac, 0x31, 0x04, 0xbb, 0x48, 0x03, 0xcc, 0xc7, 0xfb, 0xde, 0xbe, 0x6f,
+	0xde, 0xfb, 0x16, 0x94, 0x8e, 0x6b, 0xed, 0x88, 0x30, 0xc2, 0x6a, 0x7c, 0x18, 0x62, 0xf5, 0x69,
+	0x85, 0x11, 0xe5, 0x14, 0x16, 0xdb, 0x94, 0x05, 0x94, 0x35, 0x99, 0x77, 0x68, 0x1d, 0x5b, 0x12,
+	0x66, 0x1d, 0x6d, 0x96, 0xfe, 0xe7, 0x5d, 0x12, 0x79, 0xcd, 0xd0, 0x8d, 0xf8, 0xb0, 0x26, 0xa0,
+	0x35, 0x9f, 0xfa, 0x74, 0xf2, 0x4b, 0xf6, 0x97, 0x90, 0x4f, 0xa9, 0xdf, 0xc3, 0x12, 0xd2, 0x1a,
+	0x74, 0x6a, 0x9c, 0x04, 0x98, 0x71, 0x37, 0x08, 0x15, 0x60, 0xe5, 0xa7, 0x67, 0x9a, 0xef, 0x17,
+	0x00, 0xdc, 0x63, 0xfe, 0x1b, 0x1c, 0x91, 0xce, 0x70, 0xb7, 0x7f, 0xe4, 0x46, 0xc4, 0xed, 0x73,
+	0xb8, 0x0b, 0x74, 0x86, 0xfb, 0x1e, 0x8e, 0x0c, 0xad, 0xa2, 0x55, 0x97, 0xed, 0xcd, 0xeb, 0x18,
+	0x6d, 0xf8, 0x84, 0x77, 0x07, 0x2d, 0xab, 0x4d, 0x83, 0x9a, 0x54, 0xaa, 0xbe, 0x36, 0x98, 0x77,
+	0xa8, 0x48, 0xb7, 0xda, 0xed, 0x2d, 0xcf, 0x8b, 0x30, 0x63, 0x8e, 0x22, 0x80, 0x07, 0xe0, 0x4f,
+	0x92, 0xf0, 0x36, 0x03, 0xea, 0x0d, 0x7a, 0xb8, 0xd9, 0x77, 0x03, 0x6c, 0x2c, 0x54, 0xb4, 0x6a,
+	0xd6, 0xae, 0x8c, 0x62, 0xf4, 0xcf, 0xd0, 0x0d, 0x7a, 0x0d, 0x73, 0x2e, 0xcc, 0x74, 0x0a, 0x37,
+	0xf5, 0x3d, 0x51, 0x7e, 0xe1, 0x06, 0x18, 0x6e, 0x83, 0xfc, 0x04, 0x1e, 0xd1, 0x01, 0xc7, 0x46,
+	0x5a, 0xf0, 0x95, 0x46, 0x31, 0x2a, 0xce, 0xf2, 0x09, 0x80, 0xe9, 0xfc, 0x7e, 0x53, 0x71, 0xc6,
+	0x85, 0x46, 0xe6, 0xdb, 0x29, 0xd2, 0xcc, 0xef, 0x1a, 0xc8, 0xdf, 0x4c, 0xee, 0x60, 0x36, 0xe8,
+	0x71, 0xf8, 0x04, 0xe4, 0x6e, 0x4b, 0xd5, 0x04, 0x75, 0x71, 0x14, 0x23, 0x28, 0xa9, 0xa7, 0x04,
+	0x82, 0x60, 0xa2, 0x6b, 0x15, 0x2c, 0x4a, 0x35, 0x62, 0x3a, 0x47, 0x1e, 0x60, 0x11, 0xe8, 0x5d,
+	0x4c, 0xfc, 0x2e, 0x17, 0x22, 0xd3, 0x8e, 0x3a, 0xc1, 0xa7, 0x20, 0x33, 0xf6, 0xc8, 0xc8, 0x54,
+	0xb4, 0x6a, 0xae, 0x5e, 0xb2, 0xa4, 0x81, 0x56, 0x62, 0xa0, 0x75, 0x90, 0x18, 0x68, 0x2f, 0x9d,
+	0xc5, 0x28, 0x75, 0xf2, 0x05, 0x69, 0x8e, 0xe8, 0x80, 0xeb, 0x40, 0x0f, 0x69, 0x8f, 0xb4, 0x87,
+	0xc6, 0xa2, 0xd0, 0x56, 0xb8, 0x8e, 0xd1, 0x64, 0x8a, 0x7d, 0xf1, 0x97, 0xa3, 0x20, 0xd0, 0x00,
+	0xbf, 0x05, 0x98, 0x31, 0xd7, 0xc7, 0x86, 0x2e, 0x64, 0x25, 0xc7, 0x46, 0xe6, 0xc3, 0x29, 0x4a,
+	0x99, 0x9f, 0x34, 0x90, 0xdf, 0x63, 0xfe, 0xeb, 0xd0, 0x73, 0x39, 0xde, 0x77, 0x23, 0x37, 0x60,
+	0xf0, 0x25, 0xc8, 0xba, 0x03, 0xde, 0xa5, 0x11, 0xe1, 0xc3, 0x5f, 0x0f, 0xc1, 0x84, 0x03, 0x3e,
+	0x03, 0x7a, 0x28, 0xa8, 0xc5, 0xd5, 0xe4, 0xea, 0x65, 0x6b, 0x7e, 0xdc, 0x2d, 0x29, 0xc0, 0xce,
+	0x8c, 0x27, 0x76, 0x54, 0x8f, 0xb2, 0xea, 0x63, 0x1a, 0xe8, 0x4a, 0xdf, 0x2b, 0xb0, 0xdc, 0xa6,
+	0x7d, 0xc6, 0xc7, 0xf6, 0x76, 0xb0, 0xb4, 0x28, 0x57, 0x2f, 0xdc, 0x26, 0x3d, 0xda, 0xb4, 0xb6,
+	0x29, 0xe9, 0xdb, 0x6b, 0x63, 0xa6, 0x51, 0x8c, 0x0a, 0xd2, 0xbb, 0xdb, 0x6d, 0xa6, 0x93, 0x4b,
+	0x8e, 0x3b, 0x18, 0xc3, 0x10, 0x18, 0x1e, 0xee, 0xb8, 0x83, 0x1e, 0x6f, 0x4e, 0xc2, 0xa3, 0xee,
+	0x59, 0xc6, 0xf5, 0xf1, 0x28, 0x46, 0x48, 0xf2, 0xdc, 0x85, 0x34, 0xe7, 0x59, 0x51, 0x54, 0xe8,
+	0x99, 0x3a, 0x7c, 0x07, 0xe0, 0x4c, 0x3f, 0xc1, 0xcc, 0x48, 0x57, 0xd2, 0xd5, 0x5c, 0xfd, 0xc1,
+	0x5d, 0x37, 0x34, 0x4b, 0x3e, 0xce, 0x98, 0xfd, 0x9f, 0x9a, 0xf2, 0xef, 0xd9, 0xf0, 0x27, 0xac,
+	0xa6, 0xb3, 0x42, 0xa6, 0x1a, 0x09, 0x66, 0x70, 0x07, 0xfc, 0xe1, 0x11, 0xe6, 0xb6, 0x7a, 0xd8,
+	0x53, 0x5b, 0xc7, 0x8c, 0x4c, 0x25, 0x5d, 0xcd, 0xda, 0x6b, 0xa3, 0x18, 0xfd, 0xa5, 0x26, 0x9d,
+	0x41, 0x98, 0x4e, 0x3e, 0x29, 0xc9, 0x95, 0x64, 0x8d, 0xa5, 0x71, 0x90, 0x84, 0x47, 0x1c, 0xac,
+	0xce, 0xd3, 0x07, 0x51, 0xb2, 0x19, 0x72, 0x99, 0xb2, 0x57, 0x31, 0x92, 0x85, 0x64, 0x49, 0x1e,
+	0x01, 0x7d, 0xea, 0xaa, 0xff, 0xbd, 0x8a, 0x91, 0xaa, 0xdc, 0x13, 0x6e, 0x99, 0x0c, 0xfb, 0xf9,
+	0xd9, 0x45, 0x59, 0x3b, 0xbf, 0x28, 0x6b, 0x5f, 0x2f, 0xca, 0xda, 0xc9, 0x65, 0x39, 0x75, 0x7e,
+	0x59, 0x4e, 0x7d, 0xbe, 0x2c, 0xa7, 0xde, 0xae, 0xdf, 0x9b, 0xd8, 0xe9, 0xd7, 0x71, 0x4b, 0x17,
+	0xab, 0xf7, 0xf0, 0xc7, 0x00, 0x4a, 0xbd, 0x17, 0xbb, 0xa7, 0x05, 0x00, 0x00,
 }
 
 func (this *MsgVerifyInvariant) Equal(that interface{}) bool {
@@ -145,6 +444,103 @@ func (this *MsgVerifyInvariant) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Authority, that1.Authority) {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ConstantFee.Equal(&that1.ConstantFee) {
+		return false
+	}
+	if this.DefaultInvariantPolicy != that1.DefaultInvariantPolicy {
+		return false
+	}
+	if len(this.InvariantPolicies) != len(that1.InvariantPolicies) {
+		return false
+	}
+	for i := range this.InvariantPolicies {
+		if !this.InvariantPolicies[i].Equal(&that1.InvariantPolicies[i]) {
+			return false
+		}
+	}
+	if len(this.DisabledModules) != len(that1.DisabledModules) {
+		return false
+	}
+	for i := range this.DisabledModules {
+		if this.DisabledModules[i] != that1.DisabledModules[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *InvariantPolicyRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InvariantPolicyRoute)
+	if !ok {
+		that2, ok := that.(InvariantPolicyRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if this.Policy != that1.Policy {
+		return false
+	}
+	return true
+}
 func (m *MsgVerifyInvariant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -189,26 +585,230 @@ func (m *MsgVerifyInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgVerifyInvariant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledModules) > 0 {
+		for iNdEx := len(m.DisabledModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledModules[iNdEx])
+			copy(dAtA[i:], m.DisabledModules[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledModules[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InvariantPolicies) > 0 {
+		for iNdEx := len(m.InvariantPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InvariantPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DefaultInvariantPolicy) > 0 {
+		i -= len(m.DefaultInvariantPolicy)
+		copy(dAtA[i:], m.DefaultInvariantPolicy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DefaultInvariantPolicy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ConstantFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantPolicyRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantPolicyRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantPolicyRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgVerifyInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.InvariantModuleName)
 	if l > 0 {
@@ -221,13 +821,486 @@ func (m *MsgVerifyInvariant) Size() (n int) {
 	return n
 }
 
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConstantFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.DefaultInvariantPolicy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.InvariantPolicies) > 0 {
+		for _, e := range m.InvariantPolicies {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledModules) > 0 {
+		for _, s := range m.DisabledModules {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantPolicyRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifyInvariant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifyInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = InvariantPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -250,15 +1323,15 @@ func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVerifyInvariant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVerifyInvariant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -285,14 +1358,133 @@ func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConstantFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInvariantPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -320,11 +1512,45 @@ func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			m.DefaultInvariantPolicy = InvariantPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantPolicies = append(m.InvariantPolicies, InvariantPolicyRoute{})
+			if err := m.InvariantPolicies[len(m.InvariantPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledModules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -352,7 +1578,124 @@ func (m *MsgVerifyInvariant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			m.DisabledModules = append(m.DisabledModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantPolicyRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantPolicyRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantPolicyRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = InvariantPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
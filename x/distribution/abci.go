@@ -7,8 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 )
 
-// BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
+// BeginBlocker sets the proposer for determining distribution during endblock,
+// distribute rewards for the previous block and auto-compounds delegation rewards
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	// determine the total power signing the block
 	var previousTotalPower, sumPreviousPrecommitPower int64
@@ -29,4 +29,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// re-delegate the rewards of delegators who opted in to auto-compounding
+	k.ProcessAutoCompounding(ctx)
 }
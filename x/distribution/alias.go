@@ -23,8 +23,11 @@ const (
 	QueryDelegatorValidators         = types.QueryDelegatorValidators
 	QueryWithdrawAddr                = types.QueryWithdrawAddr
 	QueryCommunityPool               = types.QueryCommunityPool
+	QueryAutoCompoundValidators      = types.QueryAutoCompoundValidators
 	DefaultParamspace                = types.DefaultParamspace
 	TypeMsgFundCommunityPool         = types.TypeMsgFundCommunityPool
+	TypeMsgSetAutoCompound           = types.TypeMsgSetAutoCompound
+	TypeMsgUpdateParams              = types.TypeMsgUpdateParams
 )
 
 var (
@@ -39,6 +42,7 @@ var (
 	GetValidatorOutstandingRewardsAddress      = types.GetValidatorOutstandingRewardsAddress
 	GetDelegatorWithdrawInfoAddress            = types.GetDelegatorWithdrawInfoAddress
 	GetDelegatorStartingInfoAddresses          = types.GetDelegatorStartingInfoAddresses
+	GetDelegatorAutoCompoundAddresses          = types.GetDelegatorAutoCompoundAddresses
 	GetValidatorHistoricalRewardsAddressPeriod = types.GetValidatorHistoricalRewardsAddressPeriod
 	GetValidatorCurrentRewardsAddress          = types.GetValidatorCurrentRewardsAddress
 	GetValidatorAccumulatedCommissionAddress   = types.GetValidatorAccumulatedCommissionAddress
@@ -46,6 +50,8 @@ var (
 	GetValidatorOutstandingRewardsKey          = types.GetValidatorOutstandingRewardsKey
 	GetDelegatorWithdrawAddrKey                = types.GetDelegatorWithdrawAddrKey
 	GetDelegatorStartingInfoKey                = types.GetDelegatorStartingInfoKey
+	GetDelegatorAutoCompoundPrefix             = types.GetDelegatorAutoCompoundPrefix
+	GetDelegatorAutoCompoundKey                = types.GetDelegatorAutoCompoundKey
 	GetValidatorHistoricalRewardsPrefix        = types.GetValidatorHistoricalRewardsPrefix
 	GetValidatorHistoricalRewardsKey           = types.GetValidatorHistoricalRewardsKey
 	GetValidatorCurrentRewardsKey              = types.GetValidatorCurrentRewardsKey
@@ -79,6 +85,8 @@ var (
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
 	MsgFundCommunityPool                       = types.NewMsgFundCommunityPool
+	NewMsgSetAutoCompound                      = types.NewMsgSetAutoCompound
+	NewMsgUpdateParams                         = types.NewMsgUpdateParams
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
@@ -103,10 +111,16 @@ var (
 	ValidatorCurrentRewardsPrefix        = types.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = types.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = types.ValidatorSlashEventPrefix
+	DelegatorAutoCompoundPrefix          = types.DelegatorAutoCompoundPrefix
+	AutoCompoundCursorKey                = types.AutoCompoundCursorKey
+	ParamsKey                            = types.ParamsKey
 	ParamStoreKeyCommunityTax            = types.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = types.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = types.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = types.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoCompoundInterval    = types.ParamStoreKeyAutoCompoundInterval
+	ParamStoreKeyMaxAutoCompoundEntries  = types.ParamStoreKeyMaxAutoCompoundEntries
+	ParamStoreKeyMaxAutoCompoundGas      = types.ParamStoreKeyMaxAutoCompoundGas
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
 	EventTypeRewards                     = types.EventTypeRewards
@@ -114,8 +128,12 @@ var (
 	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
 	EventTypeProposerReward              = types.EventTypeProposerReward
+	EventTypeSetAutoCompound             = types.EventTypeSetAutoCompound
+	EventTypeAutoCompound                = types.EventTypeAutoCompound
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
+	AttributeKeyDelegator                = types.AttributeKeyDelegator
+	AttributeKeyEnabled                  = types.AttributeKeyEnabled
 	AttributeValueCategory               = types.AttributeValueCategory
 	ProposalHandler                      = client.ProposalHandler
 )
@@ -126,6 +144,7 @@ type (
 	DelegatorStartingInfo                  = types.DelegatorStartingInfo
 	FeePool                                = types.FeePool
 	DelegatorWithdrawInfo                  = types.DelegatorWithdrawInfo
+	DelegatorAutoCompoundInfo              = types.DelegatorAutoCompoundInfo
 	ValidatorOutstandingRewardsRecord      = types.ValidatorOutstandingRewardsRecord
 	ValidatorAccumulatedCommissionRecord   = types.ValidatorAccumulatedCommissionRecord
 	ValidatorHistoricalRewardsRecord       = types.ValidatorHistoricalRewardsRecord
@@ -137,6 +156,8 @@ type (
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgSetAutoCompound                     = types.MsgSetAutoCompound
+	MsgUpdateParams                        = types.MsgUpdateParams
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
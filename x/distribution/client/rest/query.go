@@ -15,54 +15,77 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
-	// Get the total rewards balance from all delegations
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/rewards",
 		delegatorRewardsHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the total rewards balance from all delegations",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.QueryDelegatorTotalRewardsResponse{},
+	})
 
-	// Query a delegation reward
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
 		delegationRewardsHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query a delegation reward",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.DecCoins{},
+	})
 
-	// Get the rewards withdrawal address
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/withdraw_address",
 		delegatorWithdrawalAddrHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the rewards withdrawal address",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.AccAddress{},
+	})
 
-	// Validator distribution information
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
 		validatorInfoHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Validator distribution information",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      ValidatorDistInfo{},
+	})
 
-	// Commission and self-delegation rewards of a single a validator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
 		validatorRewardsHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Commission and self-delegation rewards of a single a validator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.DecCoins{},
+	})
 
-	// Outstanding rewards of a single validator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/outstanding_rewards",
 		outstandingRewardsHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Outstanding rewards of a single validator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.ValidatorOutstandingRewards{},
+	})
 
-	// Get the current distribution parameter values
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/parameters",
 		paramsHandlerFn(cliCtx, queryRoute),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current distribution parameter values",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Params{},
+	})
 
-	// Get the amount held in the community pool
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/community_pool",
 		communityPoolHandler(cliCtx, queryRoute),
-	).Methods("GET")
-
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the amount held in the community pool",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.DecCoins{},
+	})
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
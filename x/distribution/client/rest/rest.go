@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
@@ -25,6 +26,11 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 	return govrest.ProposalRESTHandler{
 		SubRoute: "community_pool_spend",
 		Handler:  postProposalHandlerFn(cliCtx),
+		Doc: rest.RouteDoc{
+			Summary:  "Generate an unsigned transaction submitting a community pool spend proposal",
+			Request:  CommunityPoolSpendProposalReq{},
+			Response: authtypes.StdTx{},
+		},
 	}
 }
 
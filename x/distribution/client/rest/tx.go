@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 
@@ -15,36 +16,50 @@ import (
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
-	// Withdraw all delegator rewards
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/rewards",
 		withdrawDelegatorRewardsHandlerFn(cliCtx, queryRoute),
-	).Methods("POST")
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Withdraw all delegator rewards",
+		Request:  withdrawRewardsReq{},
+		Response: authtypes.StdTx{},
+	})
 
-	// Withdraw delegation rewards
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
 		withdrawDelegationRewardsHandlerFn(cliCtx),
-	).Methods("POST")
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Withdraw delegation rewards",
+		Request:  withdrawRewardsReq{},
+		Response: authtypes.StdTx{},
+	})
 
-	// Replace the rewards withdrawal address
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/withdraw_address",
 		setDelegatorWithdrawalAddrHandlerFn(cliCtx),
-	).Methods("POST")
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Replace the rewards withdrawal address",
+		Request:  setWithdrawalAddrReq{},
+		Response: authtypes.StdTx{},
+	})
 
-	// Withdraw validator rewards and commission
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
 		withdrawValidatorRewardsHandlerFn(cliCtx),
-	).Methods("POST")
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Withdraw validator rewards",
+		Request:  withdrawRewardsReq{},
+		Response: authtypes.StdTx{},
+	})
 
-	// Fund the community pool
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/distribution/community_pool",
 		fundCommunityPoolHandlerFn(cliCtx),
-	).Methods("POST")
-
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Fund the community pool",
+		Request:  fundCommunityPoolReq{},
+		Response: authtypes.StdTx{},
+	})
 }
 
 type (
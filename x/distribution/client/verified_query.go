@@ -0,0 +1,40 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// distribution module with verified store reads.
+func VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return map[string]context.VerifiedQuerier{
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryWithdrawAddr): QueryWithdrawAddrVerified,
+	}
+}
+
+// QueryWithdrawAddrVerified answers the withdraw address query with a
+// verified read of the withdraw address in the distribution store.
+func QueryWithdrawAddrVerified(cliCtx context.CLIContext, data []byte) ([]byte, error) {
+	var params types.QueryDelegatorWithdrawAddrParams
+	if err := types.ModuleCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, err
+	}
+
+	bz, _, err := cliCtx.QueryStore(types.GetDelegatorWithdrawAddrKey(params.DelegatorAddress), types.StoreKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// rewards are withdrawn to the delegator unless set otherwise
+	withdrawAddr := params.DelegatorAddress
+	if bz != nil {
+		withdrawAddr = sdk.AccAddress(bz)
+	}
+
+	return codec.MarshalJSONIndent(types.ModuleCdc, withdrawAddr)
+}
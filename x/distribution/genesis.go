@@ -37,6 +37,9 @@ func InitGenesis(ctx sdk.Context, bk types.BankKeeper, supplyKeeper types.Supply
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, daci := range data.DelegatorAutoCompoundInfos {
+		keeper.SetDelegatorAutoCompound(ctx, daci.DelegatorAddress, daci.ValidatorAddress)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -140,5 +143,14 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	dacis := make([]types.DelegatorAutoCompoundInfo, 0)
+	keeper.IterateDelegatorAutoCompounds(ctx, func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+		dacis = append(dacis, types.DelegatorAutoCompoundInfo{
+			DelegatorAddress: del,
+			ValidatorAddress: val,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, dacis)
 }
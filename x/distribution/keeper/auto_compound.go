@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a
+// delegation.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if !enabled {
+		k.DeleteDelegatorAutoCompound(ctx, delAddr, valAddr)
+	} else {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+
+		k.SetDelegatorAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprintf("%t", enabled)),
+		),
+	)
+
+	return nil
+}
+
+// CompoundDelegationRewards withdraws the rewards of a delegation and delegates
+// the ones in the bond denom back to the validator. Rewards in other denoms are
+// sent to the delegator withdraw address. It returns the re-delegated amount.
+func (k Keeper) CompoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorExists
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		return sdk.Coin{}, types.ErrNoDelegationExists
+	}
+
+	rewards, err := k.claimDelegationRewards(ctx, validator, del)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	compounded := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	remaining := rewards.Sub(sdk.NewCoins(compounded))
+
+	// the compounded rewards are delegated from the delegator account
+	if compounded.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(compounded))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if !remaining.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, remaining)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// reinitialize the delegation, the staking hooks keep the starting info
+	// and periods up to date when the compounded rewards are delegated
+	k.initializeDelegation(ctx, valAddr, delAddr)
+
+	if compounded.IsPositive() {
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, compounded.Amount, sdk.Unbonded, validator, true); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return compounded, nil
+}
+
+// ProcessAutoCompounding compounds the rewards of the delegations with
+// auto-compounding enabled. A pass over all of them is started every
+// AutoCompoundInterval blocks and is processed in batches of at most
+// MaxAutoCompoundEntries delegations per block. A batch stops early once it
+// has consumed MaxAutoCompoundGas, the delegation running out of gas is
+// retried in the next block unless it was the first of the batch.
+func (k Keeper) ProcessAutoCompounding(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.MaxAutoCompoundEntries == 0 {
+		return
+	}
+
+	cursor, found := k.getAutoCompoundCursor(ctx)
+	if !found {
+		if uint64(ctx.BlockHeight())%params.AutoCompoundInterval != 0 {
+			return
+		}
+
+		cursor = types.DelegatorAutoCompoundPrefix
+	}
+
+	// collect the batch first as the store cannot be written while iterating
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(cursor, sdk.PrefixEndBytes(types.DelegatorAutoCompoundPrefix))
+
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) <= int(params.MaxAutoCompoundEntries); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	if len(keys) > int(params.MaxAutoCompoundEntries) {
+		// the remaining delegations are processed in the next block
+		k.setAutoCompoundCursor(ctx, keys[len(keys)-1])
+		keys = keys[:len(keys)-1]
+	} else {
+		k.deleteAutoCompoundCursor(ctx)
+	}
+
+	gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(params.MaxAutoCompoundGas))
+	for i, key := range keys {
+		delAddr, valAddr := types.GetDelegatorAutoCompoundAddresses(key)
+		if k.autoCompound(gasCtx, delAddr, valAddr) {
+			continue
+		}
+
+		next := i
+		if i == 0 {
+			// the delegation alone exceeds the gas budget, skip it so that
+			// it does not stall the pass
+			k.Logger(ctx).Info(fmt.Sprintf(
+				"skipped auto-compounding rewards of delegator %s to validator %s exceeding the gas budget", delAddr, valAddr,
+			))
+			next++
+		}
+
+		if next < len(keys) {
+			k.setAutoCompoundCursor(ctx, keys[next])
+		}
+		return
+	}
+}
+
+// compound the rewards of a single delegation, discarding the state changes
+// if it fails. It returns false if the gas meter of ctx ran out of gas.
+func (k Keeper) autoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			ok = false
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	compounded, err := k.CompoundDelegationRewards(cacheCtx, delAddr, valAddr)
+	switch {
+	case err == nil:
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	case errors.Is(err, types.ErrNoValidatorExists), errors.Is(err, types.ErrNoDelegationExists):
+		// the delegation is gone, stop compounding it
+		k.DeleteDelegatorAutoCompound(ctx, delAddr, valAddr)
+		return true
+
+	default:
+		k.Logger(ctx).Info(
+			fmt.Sprintf("failed to auto-compound rewards of delegator %s to validator %s", delAddr, valAddr),
+			"err", err,
+		)
+		return true
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return true
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestSetAutoCompound(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	// cannot enable auto-compounding without a delegation
+	err = app.DistrKeeper.SetAutoCompound(ctx, addr[1], valAddrs[0], true)
+	require.True(t, types.ErrNoDelegationExists.Is(err))
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[1], valAddrs[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], valAddrs[0], true))
+	require.True(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[0], valAddrs[0]))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, app.DistrKeeper.GetDelegatorAutoCompoundValidators(ctx, addr[0]))
+
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], valAddrs[0], false))
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[0], valAddrs[0]))
+
+	// removing the delegation removes the auto-compounding entry
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], valAddrs[0], true))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	undelegate := staking.NewMsgUndelegate(addr[0], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)))
+	_, err = sh(ctx, undelegate)
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[0], valAddrs[0]))
+}
+
+func TestCompoundDelegationRewards(t *testing.T) {
+	balanceTokens := sdk.TokensFromConsensusPower(1000)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.SupplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addr[0])
+
+	// compound rewards
+	compounded, err := app.DistrKeeper.CompoundDelegationRewards(ctx, addr[0], valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)), compounded)
+
+	// the rewards are delegated instead of being added to the balance
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addr[0]))
+
+	del := app.StakingKeeper.Delegation(ctx, addr[0], valAddrs[0])
+	require.Equal(t, valTokens.Add(initial.QuoRaw(2)).ToDec(), del.GetShares())
+
+	// no rewards are left for the delegation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	require.True(t, app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod).IsZero())
+}
+
+// setupAutoCompounding creates a validator with a second delegation, enables
+// the auto-compounding of both delegations and allocates them rewards. It
+// returns the delegators and the count of their compounded delegations.
+func setupAutoCompounding(t *testing.T, params types.Params) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, func(sdk.Context) int) {
+	balanceTokens := sdk.TokensFromConsensusPower(1000)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.SupplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create validator with 50% commission and a second delegation
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = sh(ctx, staking.NewMsgDelegate(addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	for _, del := range addr {
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, del, valAddrs[0], true))
+	}
+
+	// allocate some rewards
+	ctx = ctx.WithBlockHeight(1)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))})
+
+	compoundedDelegations := func(ctx sdk.Context) int {
+		count := 0
+		for _, del := range addr {
+			if app.StakingKeeper.Delegation(ctx, del, valAddrs[0]).GetShares().GT(valTokens.ToDec()) {
+				count++
+			}
+		}
+		return count
+	}
+
+	return app, ctx, addr, compoundedDelegations
+}
+
+func TestProcessAutoCompounding(t *testing.T) {
+	// compound every 10 blocks, one delegation per block
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = 10
+	params.MaxAutoCompoundEntries = 1
+
+	app, ctx, addr, compoundedDelegations := setupAutoCompounding(t, params)
+	valAddr := sdk.ValAddress(addr[0])
+
+	// nothing is compounded outside of the interval
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, 0, compoundedDelegations(ctx))
+
+	// a pass is started at the interval and compounds the first batch
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, 1, compoundedDelegations(ctx))
+
+	// the pass continues in the next block
+	ctx = ctx.WithBlockHeight(11)
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, 2, compoundedDelegations(ctx))
+
+	valTokens := sdk.TokensFromConsensusPower(100)
+	for _, del := range addr {
+		require.Equal(t,
+			valTokens.Add(sdk.TokensFromConsensusPower(10).QuoRaw(4)).ToDec(),
+			app.StakingKeeper.Delegation(ctx, del, valAddr).GetShares(),
+		)
+	}
+}
+
+func TestProcessAutoCompoundingGasBudget(t *testing.T) {
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = 10
+
+	app, ctx, addr, compoundedDelegations := setupAutoCompounding(t, params)
+	ctx = ctx.WithBlockHeight(10)
+
+	// measure the gas consumed by compounding a single delegation
+	gasMeter := sdk.NewInfiniteGasMeter()
+	cacheCtx, _ := ctx.WithGasMeter(gasMeter).CacheContext()
+	_, err := app.DistrKeeper.CompoundDelegationRewards(cacheCtx, addr[0], sdk.ValAddress(addr[0]))
+	require.NoError(t, err)
+	gasUsed := gasMeter.GasConsumed()
+
+	// the budget only fits one delegation, the other one is retried in the
+	// next block
+	budgetCtx, _ := ctx.CacheContext()
+	cursorStore := budgetCtx.KVStore(app.GetKey(types.StoreKey))
+	params.MaxAutoCompoundGas = gasUsed * 3 / 2
+	app.DistrKeeper.SetParams(budgetCtx, params)
+
+	app.DistrKeeper.ProcessAutoCompounding(budgetCtx)
+	require.Equal(t, 1, compoundedDelegations(budgetCtx))
+	require.True(t, cursorStore.Has(types.AutoCompoundCursorKey))
+
+	app.DistrKeeper.ProcessAutoCompounding(budgetCtx.WithBlockHeight(11))
+	require.Equal(t, 2, compoundedDelegations(budgetCtx))
+	require.False(t, cursorStore.Has(types.AutoCompoundCursorKey))
+
+	// delegations exceeding the budget on their own are skipped instead of
+	// stalling the pass
+	params.MaxAutoCompoundGas = 1
+	app.DistrKeeper.SetParams(ctx, params)
+	cursorStore = ctx.KVStore(app.GetKey(types.StoreKey))
+
+	app.DistrKeeper.ProcessAutoCompounding(ctx)
+	require.Equal(t, 0, compoundedDelegations(ctx))
+	require.True(t, cursorStore.Has(types.AutoCompoundCursorKey))
+
+	app.DistrKeeper.ProcessAutoCompounding(ctx.WithBlockHeight(11))
+	require.Equal(t, 0, compoundedDelegations(ctx))
+	require.False(t, cursorStore.Has(types.AutoCompoundCursorKey))
+}
@@ -24,13 +24,16 @@ type Keeper struct {
 	blacklistedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	authority sdk.AccAddress // address allowed to update the module parameters
 }
 
-// NewKeeper creates a new distribution Keeper instance
+// NewKeeper creates a new distribution Keeper instance. The authority is the
+// only address allowed to update the module parameters through MsgUpdateParams.
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper,
 	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
-	blacklistedAddrs map[string]bool,
+	blacklistedAddrs map[string]bool, authority sdk.AccAddress,
 ) Keeper {
 
 	// ensure distribution module account is set
@@ -52,9 +55,15 @@ func NewKeeper(
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
 		blacklistedAddrs: blacklistedAddrs,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -2,42 +2,80 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// GetParams returns the total set of distribution parameters.
+// paramStore returns the store of the distribution parameters.
+func (k Keeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(k.cdc, k.storeKey, types.ParamsKey, k.paramSpace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams returns the total set of distribution parameters. Parameters that
+// have not been migrated to the module store yet are read from the legacy
+// param space.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramStore().Get(ctx, &params)
 	return params
 }
 
-// SetParams sets the distribution parameters to the param space.
+// SetParams sets the distribution parameters in the module store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	k.paramStore().Set(ctx, &params)
+}
+
+// UpdateParams validates and sets the distribution parameters on behalf of the
+// given authority, which must be the authority of the module.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(k.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, authority)
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the distribution parameters from the legacy x/params
+// subspace to the module store.
+func (k Keeper) MigrateParams(ctx sdk.Context) error {
+	return k.paramStore().Migrate(ctx)
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return k.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return k.paramStore().SetParamSet(ctx, ps)
 }
 
 // GetCommunityTax returns the current distribution community tax.
 func (k Keeper) GetCommunityTax(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyCommunityTax, &percent)
-	return percent
+	return k.GetParams(ctx).CommunityTax
 }
 
 // GetBaseProposerReward returns the current distribution base proposer rate.
 func (k Keeper) GetBaseProposerReward(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyBaseProposerReward, &percent)
-	return percent
+	return k.GetParams(ctx).BaseProposerReward
 }
 
 // GetBonusProposerReward returns the current distribution bonus proposer reward
 // rate.
 func (k Keeper) GetBonusProposerReward(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyBonusProposerReward, &percent)
-	return percent
+	return k.GetParams(ctx).BonusProposerReward
 }
 
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
 func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
-	return enabled
+	return k.GetParams(ctx).WithdrawAddrEnabled
 }
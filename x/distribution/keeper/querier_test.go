@@ -123,10 +123,13 @@ func TestQueries(t *testing.T) {
 
 	// test param queries
 	params := types.Params{
-		CommunityTax:        sdk.NewDecWithPrec(3, 1),
-		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-		WithdrawAddrEnabled: true,
+		CommunityTax:           sdk.NewDecWithPrec(3, 1),
+		BaseProposerReward:     sdk.NewDecWithPrec(2, 1),
+		BonusProposerReward:    sdk.NewDecWithPrec(1, 1),
+		WithdrawAddrEnabled:    true,
+		AutoCompoundInterval:   10,
+		MaxAutoCompoundEntries: 5,
+		MaxAutoCompoundGas:     1000000,
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
@@ -136,6 +139,9 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, params.BaseProposerReward, paramsRes.BaseProposerReward)
 	require.Equal(t, params.BonusProposerReward, paramsRes.BonusProposerReward)
 	require.Equal(t, params.WithdrawAddrEnabled, paramsRes.WithdrawAddrEnabled)
+	require.Equal(t, params.AutoCompoundInterval, paramsRes.AutoCompoundInterval)
+	require.Equal(t, params.MaxAutoCompoundEntries, paramsRes.MaxAutoCompoundEntries)
+	require.Equal(t, params.MaxAutoCompoundGas, paramsRes.MaxAutoCompoundGas)
 
 	// test outstanding rewards query
 	outstandingRewards := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(3)}, {Denom: "myothertoken", Amount: sdk.NewDecWithPrec(3, 7)}}
@@ -43,6 +43,70 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// check whether the rewards of a delegation are auto-compounded
+func (k Keeper) HasDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorAutoCompoundKey(delAddr, valAddr))
+}
+
+// enable the auto-compounding of the rewards of a delegation
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegatorAutoCompoundKey(delAddr, valAddr), []byte{})
+}
+
+// disable the auto-compounding of the rewards of a delegation
+func (k Keeper) DeleteDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorAutoCompoundKey(delAddr, valAddr))
+}
+
+// iterate over the delegations with auto-compounding enabled
+func (k Keeper) IterateDelegatorAutoCompounds(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorAutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetDelegatorAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the validators a delegator auto-compounds the rewards of
+func (k Keeper) GetDelegatorAutoCompoundValidators(ctx sdk.Context, delAddr sdk.AccAddress) []sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAutoCompoundPrefix(delAddr))
+	defer iter.Close()
+
+	validators := []sdk.ValAddress{}
+	for ; iter.Valid(); iter.Next() {
+		_, val := types.GetDelegatorAutoCompoundAddresses(iter.Key())
+		validators = append(validators, val)
+	}
+	return validators
+}
+
+// get the key the next auto-compounding batch starts from, if a pass is in progress
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) (cursor []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor = store.Get(types.AutoCompoundCursorKey)
+	return cursor, cursor != nil
+}
+
+// set the key the next auto-compounding batch starts from
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, cursor)
+}
+
+// delete the auto-compounding cursor once a pass is complete
+func (k Keeper) deleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,26 @@
+package v039
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// MigrateAutoCompounding sets the auto-compounding distribution params to their
+// default values. No delegation has auto-compounding enabled yet, so
+// distribution remains unchanged until delegators opt in.
+func MigrateAutoCompounding(ctx sdk.Context, k keeper.Keeper) error {
+	defaults := types.DefaultParams()
+
+	params := k.GetParams(ctx)
+	params.AutoCompoundInterval = defaults.AutoCompoundInterval
+	params.MaxAutoCompoundEntries = defaults.MaxAutoCompoundEntries
+	params.MaxAutoCompoundGas = defaults.MaxAutoCompoundGas
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,37 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v039distr "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrateAutoCompounding(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// params stored in the subspace before auto-compounding existed
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.ParamStoreKeyCommunityTax, sdk.NewDecWithPrec(3, 2))
+	subspace.Set(ctx, types.ParamStoreKeyBaseProposerReward, sdk.NewDecWithPrec(1, 2))
+	subspace.Set(ctx, types.ParamStoreKeyBonusProposerReward, sdk.NewDecWithPrec(4, 2))
+	subspace.Set(ctx, types.ParamStoreKeyWithdrawAddrEnabled, true)
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	require.NoError(t, app.DistrKeeper.MigrateParams(ctx))
+	require.NoError(t, v039distr.MigrateAutoCompounding(ctx, app.DistrKeeper))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	require.NoError(t, params.ValidateBasic())
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), params.CommunityTax)
+	require.True(t, params.WithdrawAddrEnabled)
+	require.Equal(t, types.DefaultParams().AutoCompoundInterval, params.AutoCompoundInterval)
+	require.Equal(t, types.DefaultParams().MaxAutoCompoundEntries, params.MaxAutoCompoundEntries)
+	require.Equal(t, types.DefaultParams().MaxAutoCompoundGas, params.MaxAutoCompoundGas)
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	distributionclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
+	v039 "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
@@ -23,9 +25,10 @@ import (
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                     = AppModule{}
+	_ module.AppModuleBasic                = AppModuleBasic{}
+	_ module.AppModuleSimulation           = AppModule{}
+	_ module.AppModuleBasicVerifiedQueries = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the distribution module.
@@ -72,6 +75,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(StoreKey, cdc)
 }
 
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// distribution module with verified store reads.
+func (AppModuleBasic) VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return distributionclient.VerifiedQueriers()
+}
+
 //____________________________________________________________________________
 
 // AppModule implements an application module for the distribution module.
@@ -157,6 +166,27 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterMigrations registers the in-place store migrations of the
+// distribution module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 adds the auto-compounding params
+	err = cfg.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return v039.MigrateAutoCompounding(ctx, am.keeper)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -62,6 +62,19 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &eventB)
 		return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+	case bytes.Equal(kvA.Key[:1], types.DelegatorAutoCompoundPrefix):
+		delAddr, valAddr := types.GetDelegatorAutoCompoundAddresses(kvA.Key)
+		return fmt.Sprintf("%v\n%v", delAddr, valAddr)
+
+	case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+		return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+	case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+		var paramsA, paramsB types.Params
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &paramsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &paramsB)
+		return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
 	default:
 		panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 	}
@@ -52,6 +52,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		tmkv.Pair{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(currentRewards)},
 		tmkv.Pair{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(commission)},
 		tmkv.Pair{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		tmkv.Pair{Key: types.GetDelegatorAutoCompoundKey(delAddr1, valAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.AutoCompoundCursorKey, Value: types.GetDelegatorAutoCompoundKey(delAddr1, valAddr1)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -68,6 +70,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorAutoCompound", fmt.Sprintf("%v\n%v", delAddr1, valAddr1)},
+		{"AutoCompoundCursor", fmt.Sprintf("%X\n%X", types.GetDelegatorAutoCompoundKey(delAddr1, valAddr1), types.GetDelegatorAutoCompoundKey(delAddr1, valAddr1))},
 		{"other", ""},
 	}
 	for i, tt := range tests {
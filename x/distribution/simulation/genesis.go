@@ -11,14 +11,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 // Simulation parameter constants
 const (
-	CommunityTax        = "community_tax"
-	BaseProposerReward  = "base_proposer_reward"
-	BonusProposerReward = "bonus_proposer_reward"
-	WithdrawEnabled     = "withdraw_enabled"
+	CommunityTax           = "community_tax"
+	BaseProposerReward     = "base_proposer_reward"
+	BonusProposerReward    = "bonus_proposer_reward"
+	WithdrawEnabled        = "withdraw_enabled"
+	AutoCompoundInterval   = "auto_compound_interval"
+	MaxAutoCompoundEntries = "max_auto_compound_entries"
+	MaxAutoCompoundGas     = "max_auto_compound_gas"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -41,6 +45,21 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundInterval randomized AutoCompoundInterval
+func GenAutoCompoundInterval(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenMaxAutoCompoundEntries randomized MaxAutoCompoundEntries
+func GenMaxAutoCompoundEntries(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 1, 20))
+}
+
+// GenMaxAutoCompoundGas randomized MaxAutoCompoundGas
+func GenMaxAutoCompoundGas(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 100000, 5000000))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -67,13 +86,34 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundInterval, &autoCompoundInterval, simState.Rand,
+		func(r *rand.Rand) { autoCompoundInterval = GenAutoCompoundInterval(r) },
+	)
+
+	var maxAutoCompoundEntries uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundEntries, &maxAutoCompoundEntries, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundEntries = GenMaxAutoCompoundEntries(r) },
+	)
+
+	var maxAutoCompoundGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundGas, &maxAutoCompoundGas, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundGas = GenMaxAutoCompoundGas(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:           communityTax,
+			BaseProposerReward:     baseProposerReward,
+			BonusProposerReward:    bonusProposerReward,
+			WithdrawAddrEnabled:    withdrawEnabled,
+			AutoCompoundInterval:   autoCompoundInterval,
+			MaxAutoCompoundEntries: maxAutoCompoundEntries,
+			MaxAutoCompoundGas:     maxAutoCompoundGas,
 		},
 	}
 
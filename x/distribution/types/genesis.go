@@ -11,6 +11,13 @@ type DelegatorWithdrawInfo struct {
 	WithdrawAddress  sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
 }
 
+// a delegation whose rewards are auto-compounded
+// this struct is only used at genesis to feed in auto-compounded delegations
+type DelegatorAutoCompoundInfo struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
 // used for import/export via genesis json
 type ValidatorOutstandingRewardsRecord struct {
 	ValidatorAddress   sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
@@ -63,12 +70,14 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	DelegatorAutoCompoundInfos      []DelegatorAutoCompoundInfo            `json:"delegator_auto_compound_infos" yaml:"delegator_auto_compound_infos"`
 }
 
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	dacis []DelegatorAutoCompoundInfo,
 ) GenesisState {
 
 	return GenesisState{
@@ -82,6 +91,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorAutoCompoundInfos:      dacis,
 	}
 }
 
@@ -98,6 +108,7 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorAutoCompoundInfos:      []DelegatorAutoCompoundInfo{},
 	}
 }
 
@@ -40,6 +40,12 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: []byte{}
+//
+// - 0x0A: []byte
+//
+// - 0x0B: Params
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +57,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoCompoundPrefix          = []byte{0x09} // key for delegations with auto-compounding enabled
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the next delegation of the auto-compounding pass
+	ParamsKey                            = []byte{0x0B} // key for the distribution parameters
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -119,6 +128,21 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// gets the addresses from a delegator's auto-compound key
+func GetDelegatorAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
 // gets the height from a validator's slash event key
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -142,6 +166,16 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the prefix key for the auto-compounded delegations of a delegator
+func GetDelegatorAutoCompoundPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundPrefix, d.Bytes()...)
+}
+
+// gets the key for a delegation with auto-compounding enabled
+func GetDelegatorAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoCompoundPrefix(d), v.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
@@ -16,10 +16,13 @@ const (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax           = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward     = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward    = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled    = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoCompoundInterval   = []byte("autocompoundinterval")
+	ParamStoreKeyMaxAutoCompoundEntries = []byte("maxautocompoundentries")
+	ParamStoreKeyMaxAutoCompoundGas     = []byte("maxautocompoundgas")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -30,10 +33,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:           sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:     sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:    sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:    true,
+		AutoCompoundInterval:   1000,
+		MaxAutoCompoundEntries: 100,
+		MaxAutoCompoundGas:     20000000,
 	}
 }
 
@@ -49,6 +55,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, &p.AutoCompoundInterval, validateAutoCompoundInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAutoCompoundEntries, &p.MaxAutoCompoundEntries, validateMaxAutoCompoundEntries),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAutoCompoundGas, &p.MaxAutoCompoundGas, validateMaxAutoCompoundGas),
 	}
 }
 
@@ -74,10 +83,21 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if p.AutoCompoundInterval == 0 {
+		return fmt.Errorf("auto-compound interval must be positive")
+	}
+	if p.MaxAutoCompoundGas == 0 {
+		return fmt.Errorf("max auto-compound gas must be positive")
+	}
 
 	return nil
 }
 
+// Validate implements the x/params ModuleParamSet interface.
+func (p Params) Validate() error {
+	return p.ValidateBasic()
+}
+
 func validateCommunityTax(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
@@ -143,3 +163,38 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("auto-compound interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundEntries(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max auto-compound gas must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryAutoCompoundValidators      = "auto_compound_validators"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -69,7 +70,8 @@ func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress, validatorAddr
 	}
 }
 
-// params for query 'custom/distr/delegator_total_rewards' and 'custom/distr/delegator_validators'
+// params for query 'custom/distr/delegator_total_rewards', 'custom/distr/delegator_validators'
+// and 'custom/distr/auto_compound_validators'
 type QueryDelegatorParams struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 }
@@ -231,18 +231,140 @@ func (m *MsgFundCommunityPool) GetDepositor() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// MsgSetAutoCompound defines a Msg type that allows a delegator to opt in or
+// out of re-delegating the rewards of a delegation to its validator.
+type MsgSetAutoCompound struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Enabled          bool                                          `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fddf2a8e4a90b09, []int{4}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoCompound) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgUpdateParams defines an SDK message for replacing the parameters of the
+// distribution module. It must be signed by the module authority.
+type MsgUpdateParams struct {
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+	Params    Params                                        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fddf2a8e4a90b09, []int{5}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // Params defines the set of distribution parameters.
 type Params struct {
 	CommunityTax        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// number of blocks between two auto-compounding passes
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty" yaml:"auto_compound_interval"`
+	// maximum number of auto-compounding entries processed per block
+	MaxAutoCompoundEntries uint32 `protobuf:"varint,6,opt,name=max_auto_compound_entries,json=maxAutoCompoundEntries,proto3" json:"max_auto_compound_entries,omitempty" yaml:"max_auto_compound_entries"`
+	// maximum gas consumed by the auto-compounding entries processed per block
+	MaxAutoCompoundGas uint64 `protobuf:"varint,7,opt,name=max_auto_compound_gas,json=maxAutoCompoundGas,proto3" json:"max_auto_compound_gas,omitempty" yaml:"max_auto_compound_gas"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{4}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,6 +400,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundEntries() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundGas() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundGas
+	}
+	return 0
+}
+
 // historical rewards for a validator
 // height is implicit within the store key
 // cumulative reward ratio is the sum from the zeroeth period
@@ -286,10 +429,11 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // which might need to reference this historical entry
 // at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and might need to read
-//    that record)
-//  + number of slashes which ended the associated period (and might need to read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and might need to read
+//	  that record)
+//	+ number of slashes which ended the associated period (and might need to read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -299,7 +443,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{5}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{7}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +498,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{6}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{8}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +551,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{7}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{9}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +597,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{8}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{10}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +646,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{9}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{11}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +690,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{10}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{12}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +735,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{11}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{13}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +782,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{12}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{14}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +828,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{13}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{15}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +876,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos_sdk.x.distribution.v1.MsgFundCommunityPool")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos_sdk.x.distribution.v1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos_sdk.x.distribution.v1.MsgUpdateParams")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.distribution.v1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos_sdk.x.distribution.v1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos_sdk.x.distribution.v1.ValidatorCurrentRewards")
@@ -747,77 +893,88 @@ func init() {
 func init() { proto.RegisterFile("x/distribution/types/types.proto", fileDescriptor_9fddf2a8e4a90b09) }
 
 var fileDescriptor_9fddf2a8e4a90b09 = []byte{
-	// 1116 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x6e, 0x9a, 0x4c, 0xd3, 0xa4, 0xd9, 0xd8, 0x49, 0xe4, 0x14, 0xaf, 0x35, 0x12,
-	0x55, 0x24, 0x14, 0x87, 0xd0, 0x5b, 0x0e, 0x48, 0x71, 0x7e, 0x08, 0x50, 0x43, 0xa2, 0x4d, 0x28,
-	0x12, 0x12, 0x5a, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xeb, 0x9d, 0xd5, 0xcc, 0xd8, 0x4e, 0x7a, 0x41,
-	0xe2, 0x04, 0x02, 0x2a, 0x0e, 0x08, 0x7a, 0xe0, 0xd0, 0x0b, 0x12, 0x54, 0xe2, 0xdf, 0x40, 0x3d,
-	0xf6, 0x06, 0xe2, 0xe0, 0xa2, 0xe4, 0xc6, 0x31, 0x37, 0x38, 0xa1, 0xdd, 0x19, 0xef, 0x6e, 0x1c,
-	0xab, 0x8d, 0x23, 0x95, 0x5e, 0x12, 0xef, 0x9b, 0x99, 0xef, 0xfb, 0xe6, 0x9b, 0x79, 0xef, 0xed,
-	0xc2, 0xf2, 0xd1, 0xb2, 0x4b, 0x85, 0xe4, 0xb4, 0xd6, 0x92, 0x94, 0xf9, 0xcb, 0xf2, 0x38, 0x20,
-	0x42, 0xfd, 0xad, 0x04, 0x9c, 0x49, 0x66, 0xdc, 0x76, 0x98, 0x68, 0x32, 0x61, 0x0b, 0xf7, 0xb0,
-	0x72, 0x54, 0x49, 0x4f, 0xae, 0xb4, 0x57, 0x8a, 0x77, 0x64, 0x83, 0x72, 0xd7, 0x0e, 0x30, 0x97,
-	0xc7, 0xcb, 0xd1, 0x82, 0xe5, 0x3a, 0xab, 0xb3, 0xe4, 0x97, 0x42, 0x29, 0x4e, 0x5f, 0x00, 0x46,
-	0x5f, 0x67, 0x61, 0x61, 0x5b, 0xd4, 0xf7, 0x88, 0xfc, 0x98, 0xca, 0x86, 0xcb, 0x71, 0x67, 0xcd,
-	0x75, 0x39, 0x11, 0xc2, 0x78, 0x00, 0xa7, 0x5d, 0xe2, 0x91, 0x3a, 0x96, 0x8c, 0xdb, 0x58, 0x05,
-	0xe7, 0x41, 0x19, 0x2c, 0x4e, 0x54, 0xb7, 0xcf, 0xba, 0xe6, 0xfc, 0x31, 0x6e, 0x7a, 0xab, 0xe8,
-	0xc2, 0x14, 0xf4, 0x6f, 0xd7, 0x5c, 0xaa, 0x53, 0xd9, 0x68, 0xd5, 0x2a, 0x0e, 0x6b, 0x2e, 0x2b,
-	0xe1, 0xfa, 0xdf, 0x92, 0x70, 0x0f, 0x35, 0xfd, 0x9a, 0xe3, 0x68, 0x26, 0xeb, 0x56, 0x0c, 0xd2,
-	0xe3, 0xee, 0xc0, 0x5b, 0x1d, 0x2d, 0x27, 0xa6, 0xce, 0x46, 0xd4, 0xf7, 0xce, 0xba, 0xe6, 0x9c,
-	0xa2, 0xee, 0x9f, 0x71, 0x05, 0xe6, 0xa9, 0xce, 0xf9, 0x4d, 0xa3, 0xef, 0xb2, 0xb0, 0xb8, 0x2d,
-	0xea, 0x3d, 0x2f, 0x36, 0x7a, 0xc2, 0x2c, 0xd2, 0xc1, 0xdc, 0x7d, 0xad, 0x9e, 0x3c, 0x80, 0xd3,
-	0x6d, 0xec, 0x51, 0xf7, 0x1c, 0x77, 0xb6, 0x9f, 0xfb, 0xc2, 0x94, 0xcb, 0x72, 0xdf, 0xc7, 0x5e,
-	0xcc, 0x1d, 0x83, 0xf4, 0x6c, 0xf9, 0x11, 0xc0, 0x52, 0xca, 0x96, 0xfb, 0xbd, 0xf1, 0x75, 0xd6,
-	0x6c, 0x52, 0x21, 0x28, 0xf3, 0x07, 0xcb, 0x03, 0xff, 0x8f, 0xbc, 0xdf, 0x00, 0xcc, 0x6f, 0x8b,
-	0xfa, 0x56, 0xcb, 0x77, 0x43, 0x45, 0x2d, 0x9f, 0xca, 0xe3, 0x5d, 0xc6, 0x3c, 0xe3, 0x53, 0x38,
-	0x8a, 0x9b, 0xac, 0xe5, 0xcb, 0x79, 0x50, 0x1e, 0x59, 0xbc, 0xf1, 0xce, 0x4c, 0x25, 0x95, 0x47,
-	0xed, 0x95, 0xca, 0x3a, 0xa3, 0x7e, 0xf5, 0xed, 0xa7, 0x5d, 0x33, 0xf3, 0xe4, 0xb9, 0xb9, 0x78,
-	0x09, 0x19, 0xe1, 0x02, 0x61, 0x69, 0x50, 0x63, 0x07, 0x8e, 0xbb, 0x24, 0x60, 0x82, 0x4a, 0xc6,
-	0xf5, 0x51, 0xac, 0x0c, 0x7f, 0xd4, 0x09, 0x06, 0xfa, 0x7d, 0x04, 0x8e, 0xee, 0x62, 0x8e, 0x9b,
-	0xc2, 0x38, 0x84, 0x37, 0x9d, 0xde, 0x5e, 0x6c, 0x89, 0x8f, 0x22, 0x2f, 0xc7, 0xab, 0x5b, 0xa1,
-	0xd8, 0x3f, 0xbb, 0xe6, 0x9d, 0x4b, 0x70, 0x6c, 0x10, 0xe7, 0xac, 0x6b, 0xe6, 0x95, 0xf3, 0xe7,
-	0xc0, 0x90, 0x35, 0x11, 0x3f, 0xef, 0xe3, 0x23, 0xe3, 0x33, 0x98, 0xaf, 0x61, 0x41, 0xec, 0x80,
-	0xb3, 0x80, 0x09, 0xc2, 0x6d, 0x1e, 0xdd, 0xf7, 0x68, 0x4f, 0xe3, 0xd5, 0xed, 0xa1, 0x39, 0x17,
-	0x14, 0xe7, 0x20, 0x4c, 0x64, 0x19, 0x61, 0x78, 0x57, 0x47, 0x75, 0x62, 0x7d, 0x0e, 0x60, 0xa1,
-	0xc6, 0xfc, 0x96, 0xb8, 0x20, 0x61, 0x24, 0x92, 0xf0, 0xe1, 0xd0, 0x12, 0x6e, 0x6b, 0x09, 0x83,
-	0x40, 0x91, 0x35, 0x13, 0xc5, 0xfb, 0x44, 0xec, 0xc3, 0xc2, 0xb9, 0x9a, 0x62, 0x13, 0x1f, 0xd7,
-	0x3c, 0xe2, 0xce, 0xe7, 0xca, 0x60, 0x71, 0xac, 0x5a, 0x4e, 0x50, 0x07, 0x4e, 0x43, 0xd6, 0x4c,
-	0xba, 0x9c, 0x6c, 0xaa, 0xe8, 0x6a, 0xee, 0xd1, 0x63, 0x33, 0x83, 0xbe, 0xcc, 0xc2, 0x62, 0x9c,
-	0x36, 0xef, 0x51, 0x21, 0x19, 0xa7, 0x0e, 0xf6, 0x14, 0xb3, 0x30, 0x7e, 0x02, 0x70, 0xce, 0x69,
-	0x35, 0x5b, 0x1e, 0x96, 0xb4, 0x4d, 0xb4, 0x4c, 0x9b, 0x63, 0x49, 0x99, 0xbe, 0xba, 0xb3, 0x7d,
-	0x57, 0x77, 0x83, 0x38, 0xd1, 0xed, 0xfd, 0x28, 0x74, 0xe6, 0xac, 0x6b, 0x96, 0xf4, 0x31, 0x0f,
-	0x06, 0x41, 0x4f, 0x9e, 0x9b, 0x6f, 0x5d, 0xce, 0x3b, 0x75, 0xc5, 0x0b, 0x09, 0x90, 0xd2, 0x68,
-	0x85, 0x30, 0xc6, 0x3a, 0x9c, 0xe2, 0xe4, 0x80, 0x70, 0xe2, 0x3b, 0xc4, 0x76, 0xa2, 0xcc, 0x0a,
-	0xef, 0xc8, 0xcd, 0x6a, 0xf1, 0xac, 0x6b, 0xce, 0x2a, 0x09, 0x7d, 0x13, 0x90, 0x35, 0x19, 0x47,
-	0xd6, 0xa3, 0xc0, 0x23, 0x00, 0xe7, 0x92, 0x12, 0xd2, 0xe2, 0x9c, 0xf8, 0xb2, 0x67, 0x04, 0x81,
-	0xd7, 0x95, 0x6e, 0xf1, 0x92, 0x7d, 0xdf, 0xd5, 0x59, 0x3b, 0xd4, 0xae, 0x7a, 0xd8, 0xc6, 0x2c,
-	0x1c, 0x0d, 0x08, 0xa7, 0x4c, 0x5d, 0xf1, 0x9c, 0xa5, 0x9f, 0xd0, 0x37, 0x00, 0x96, 0x62, 0x69,
-	0x6b, 0x8e, 0x36, 0x81, 0xb8, 0xa9, 0x42, 0x77, 0x08, 0xa1, 0x13, 0x3f, 0xbd, 0x0a, 0x91, 0x29,
-	0x78, 0xf4, 0x3d, 0x80, 0x0b, 0xb1, 0x9e, 0x9d, 0x96, 0x14, 0x12, 0xfb, 0x2e, 0xf5, 0xeb, 0x3d,
-	0xbb, 0x3a, 0x97, 0xb5, 0x6b, 0x53, 0x5f, 0x93, 0xc9, 0xde, 0x19, 0x45, 0x8b, 0xd0, 0x55, 0x0d,
-	0x44, 0xbf, 0x00, 0x38, 0x13, 0x0b, 0xdb, 0xf3, 0xb0, 0x68, 0x6c, 0xb6, 0x89, 0x2f, 0x8d, 0x2d,
-	0x98, 0x94, 0x67, 0x5b, 0x5b, 0x1c, 0x56, 0xae, 0x5c, 0x75, 0x21, 0xe9, 0xdc, 0xfd, 0x33, 0x90,
-	0x35, 0x15, 0x87, 0x76, 0xa3, 0x88, 0xf1, 0x01, 0x1c, 0x3b, 0xe0, 0xd8, 0x09, 0xdf, 0x70, 0x74,
-	0x15, 0xaa, 0x0c, 0x57, 0x02, 0xac, 0x78, 0x3d, 0xfa, 0x15, 0xc0, 0xfc, 0x00, 0xad, 0xc2, 0x78,
-	0x08, 0xe0, 0x6c, 0xa2, 0x45, 0x84, 0x23, 0x36, 0x89, 0x86, 0xb4, 0x9b, 0x2b, 0x95, 0x17, 0xbd,
-	0x77, 0x55, 0x06, 0x80, 0x56, 0xdf, 0xd4, 0x46, 0xbf, 0xd1, 0xbf, 0xd5, 0x34, 0x3c, 0xb2, 0xf2,
-	0xed, 0x01, 0x82, 0x74, 0xad, 0xf8, 0x01, 0xc0, 0xeb, 0x5b, 0x84, 0x44, 0x1d, 0xec, 0x2b, 0x00,
-	0x27, 0x93, 0xd2, 0x1d, 0x30, 0xe6, 0xbd, 0xe4, 0xa0, 0xef, 0x69, 0xfe, 0x42, 0x7f, 0xd9, 0x0f,
-	0xd7, 0x0e, 0x7d, 0xde, 0x49, 0x0f, 0x0a, 0xd5, 0xa0, 0x87, 0x59, 0x58, 0x3c, 0xd7, 0x61, 0xf7,
-	0x02, 0xe2, 0xbb, 0xaa, 0x8c, 0x62, 0xcf, 0xc8, 0xc3, 0x6b, 0x92, 0x4a, 0x8f, 0xa8, 0x5e, 0x65,
-	0xa9, 0x07, 0xa3, 0x0c, 0x6f, 0xb8, 0x44, 0x38, 0x9c, 0x06, 0xc9, 0x69, 0x5a, 0xe9, 0x50, 0xd8,
-	0x47, 0x39, 0x71, 0x68, 0x40, 0x89, 0x2f, 0xa3, 0x82, 0x7f, 0xb5, 0x3e, 0x1a, 0x63, 0xa4, 0xfa,
-	0x7e, 0xee, 0x15, 0xf4, 0xfd, 0xd5, 0xb1, 0x2f, 0x1e, 0x9b, 0x99, 0xe8, 0xa8, 0xfe, 0x01, 0xb0,
-	0x10, 0xbf, 0x24, 0xee, 0x49, 0xcc, 0x25, 0xf5, 0xeb, 0xef, 0xfb, 0x07, 0x51, 0xa5, 0x0c, 0x38,
-	0x69, 0x53, 0x16, 0xb6, 0x9f, 0x74, 0x1e, 0xa4, 0x2a, 0x65, 0xdf, 0x04, 0x64, 0x4d, 0xf6, 0x22,
-	0x3a, 0x0b, 0xf6, 0xe1, 0x35, 0x21, 0xf1, 0x21, 0xd1, 0x29, 0xf0, 0xee, 0xd0, 0x5d, 0x70, 0x42,
-	0x11, 0x45, 0x20, 0xc8, 0x52, 0x60, 0xc6, 0x26, 0x1c, 0x6d, 0x10, 0x5a, 0x6f, 0x28, 0xaf, 0x73,
-	0xd5, 0xa5, 0xbf, 0xbb, 0xe6, 0x94, 0xc3, 0x49, 0x58, 0xe1, 0x7d, 0x5b, 0x0d, 0x25, 0x22, 0xfb,
-	0x06, 0x90, 0xa5, 0x17, 0x57, 0x77, 0x7e, 0x3e, 0x29, 0x81, 0xa7, 0x27, 0x25, 0xf0, 0xec, 0xa4,
-	0x04, 0xfe, 0x3a, 0x29, 0x81, 0x6f, 0x4f, 0x4b, 0x99, 0x67, 0xa7, 0xa5, 0xcc, 0x1f, 0xa7, 0xa5,
-	0xcc, 0x27, 0x2b, 0x2f, 0xd4, 0x38, 0xe8, 0x83, 0xa7, 0x36, 0x1a, 0x7d, 0x92, 0xdc, 0xfd, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x34, 0x5a, 0x18, 0x60, 0x0f, 0x0d, 0x00, 0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x38, 0x8e, 0xd3, 0x4e, 0xdb, 0xa4, 0xdd, 0xd8, 0xa9, 0xbf, 0x69, 0xeb, 0xf5, 0x77,
+	0x54, 0xaa, 0x48, 0xa8, 0x0e, 0x69, 0x6f, 0x3d, 0x20, 0xc5, 0x69, 0x02, 0x45, 0x0d, 0x8d, 0x36,
+	0xfd, 0x21, 0x21, 0xa1, 0xd5, 0x64, 0x77, 0x6a, 0x8f, 0xb2, 0xde, 0x59, 0xcd, 0xcc, 0x3a, 0x49,
+	0x2f, 0x48, 0x9c, 0x40, 0x40, 0xc5, 0x01, 0x41, 0x0f, 0x1c, 0x7a, 0xa1, 0x82, 0x4a, 0xfc, 0x1b,
+	0xa8, 0xc7, 0x1e, 0x11, 0x07, 0x17, 0xa5, 0x12, 0x07, 0x8e, 0xbe, 0xc1, 0x09, 0xed, 0xce, 0xec,
+	0x7a, 0xed, 0x98, 0x34, 0x8e, 0x54, 0x38, 0x70, 0x49, 0x3c, 0x6f, 0xde, 0x7c, 0xde, 0x67, 0xde,
+	0xcf, 0x59, 0x58, 0xdd, 0x59, 0x70, 0xa9, 0x90, 0x9c, 0x6e, 0x86, 0x92, 0x32, 0x7f, 0x41, 0xee,
+	0x06, 0x44, 0xa8, 0xbf, 0xb5, 0x80, 0x33, 0xc9, 0x8c, 0xf3, 0x0e, 0x13, 0x2d, 0x26, 0x6c, 0xe1,
+	0x6e, 0xd5, 0x76, 0x6a, 0x59, 0xe5, 0x5a, 0x7b, 0x71, 0xee, 0x92, 0x6c, 0x52, 0xee, 0xda, 0x01,
+	0xe6, 0x72, 0x77, 0x21, 0x3e, 0xb0, 0xd0, 0x60, 0x0d, 0xd6, 0xfb, 0xa5, 0x50, 0xe6, 0xce, 0xec,
+	0x03, 0x46, 0x9f, 0xe7, 0x60, 0x69, 0x4d, 0x34, 0x36, 0x88, 0xbc, 0x47, 0x65, 0xd3, 0xe5, 0x78,
+	0x7b, 0xc9, 0x75, 0x39, 0x11, 0xc2, 0x78, 0x00, 0xcf, 0xb8, 0xc4, 0x23, 0x0d, 0x2c, 0x19, 0xb7,
+	0xb1, 0x12, 0x96, 0x41, 0x15, 0xcc, 0x9f, 0xac, 0xaf, 0x75, 0x3b, 0x66, 0x79, 0x17, 0xb7, 0xbc,
+	0x6b, 0x68, 0x9f, 0x0a, 0xfa, 0xb3, 0x63, 0x5e, 0x6e, 0x50, 0xd9, 0x0c, 0x37, 0x6b, 0x0e, 0x6b,
+	0x2d, 0x28, 0xe2, 0xfa, 0xdf, 0x65, 0xe1, 0x6e, 0x69, 0xf3, 0x4b, 0x8e, 0xa3, 0x2d, 0x59, 0xa7,
+	0x53, 0x90, 0xc4, 0xf6, 0x36, 0x3c, 0xbd, 0xad, 0xe9, 0xa4, 0xa6, 0x73, 0xb1, 0xe9, 0x9b, 0xdd,
+	0x8e, 0x79, 0x56, 0x99, 0x1e, 0xd4, 0x38, 0x82, 0xe5, 0xe9, 0xed, 0xfe, 0x4b, 0xa3, 0xaf, 0x72,
+	0x70, 0x6e, 0x4d, 0x34, 0x12, 0x5f, 0x5c, 0x4f, 0x88, 0x59, 0x64, 0x1b, 0x73, 0xf7, 0x5f, 0xf5,
+	0xc9, 0x03, 0x78, 0xa6, 0x8d, 0x3d, 0xea, 0xf6, 0xd9, 0xce, 0x0d, 0xda, 0xde, 0xa7, 0x72, 0x58,
+	0xdb, 0x77, 0xb1, 0x97, 0xda, 0x4e, 0x41, 0x12, 0xb7, 0x7c, 0x0b, 0x60, 0x25, 0xe3, 0x96, 0xbb,
+	0xc9, 0xfe, 0x32, 0x6b, 0xb5, 0xa8, 0x10, 0x94, 0xf9, 0xc3, 0xe9, 0x81, 0x7f, 0x86, 0xde, 0x4f,
+	0x00, 0x16, 0xd7, 0x44, 0x63, 0x35, 0xf4, 0xdd, 0x88, 0x51, 0xe8, 0x53, 0xb9, 0xbb, 0xce, 0x98,
+	0x67, 0x7c, 0x08, 0x0b, 0xb8, 0xc5, 0x42, 0x5f, 0x96, 0x41, 0x75, 0x7c, 0xfe, 0xc4, 0x95, 0x99,
+	0x5a, 0xa6, 0x8e, 0xda, 0x8b, 0xb5, 0x65, 0x46, 0xfd, 0xfa, 0x5b, 0xcf, 0x3a, 0xe6, 0xd8, 0xd3,
+	0x17, 0xe6, 0xfc, 0x21, 0x68, 0x44, 0x07, 0x84, 0xa5, 0x41, 0x8d, 0x5b, 0xf0, 0xb8, 0x4b, 0x02,
+	0x26, 0xa8, 0x64, 0x5c, 0x87, 0x62, 0x71, 0xf4, 0x50, 0xf7, 0x30, 0xd0, 0x93, 0x1c, 0x34, 0x54,
+	0x35, 0x2e, 0x85, 0x92, 0x2d, 0xb3, 0x56, 0xc0, 0x42, 0xff, 0x3f, 0x9b, 0x76, 0x46, 0x19, 0x4e,
+	0x12, 0x1f, 0x6f, 0x7a, 0xc4, 0x2d, 0x8f, 0x57, 0xc1, 0xfc, 0x31, 0x2b, 0x59, 0xa2, 0x27, 0x00,
+	0x4e, 0xaf, 0x89, 0xc6, 0x9d, 0xc0, 0xc5, 0x92, 0xac, 0x63, 0x8e, 0x5b, 0x22, 0x8a, 0x06, 0x0e,
+	0x65, 0x93, 0x71, 0x2a, 0x77, 0xcb, 0xe0, 0xc8, 0xd1, 0x48, 0x31, 0x8c, 0x3a, 0x2c, 0x04, 0x31,
+	0x74, 0x7c, 0xdf, 0x13, 0x57, 0x2e, 0xd6, 0x0e, 0xea, 0xc2, 0x35, 0x45, 0xa3, 0x9e, 0x8f, 0xd2,
+	0xc9, 0xd2, 0x27, 0xd1, 0x6f, 0x13, 0xb0, 0xa0, 0xf9, 0x6d, 0xc1, 0x53, 0x4e, 0x92, 0x9d, 0xb6,
+	0xc4, 0x3b, 0x31, 0xc7, 0xe3, 0xf5, 0xd5, 0x48, 0xff, 0x97, 0x8e, 0x79, 0xe9, 0x10, 0x3c, 0xaf,
+	0x13, 0xa7, 0xdb, 0x31, 0x8b, 0xca, 0xe7, 0x7d, 0x60, 0xc8, 0x3a, 0x99, 0xae, 0x6f, 0xe3, 0x1d,
+	0xe3, 0x23, 0x58, 0xdc, 0xc4, 0x82, 0xd8, 0x01, 0x67, 0x01, 0x13, 0x84, 0xdb, 0x3c, 0xee, 0x60,
+	0xf1, 0x4d, 0x8e, 0xd7, 0xd7, 0x46, 0xb6, 0x79, 0x4e, 0xd9, 0x1c, 0x86, 0x89, 0x2c, 0x23, 0x12,
+	0xaf, 0x6b, 0xa9, 0x6e, 0x95, 0x1f, 0x03, 0x58, 0xda, 0x64, 0x7e, 0x28, 0xf6, 0x51, 0x18, 0x8f,
+	0x29, 0xbc, 0x3f, 0x32, 0x85, 0xf3, 0x9a, 0xc2, 0x30, 0x50, 0x64, 0xcd, 0xc4, 0xf2, 0x01, 0x12,
+	0xb7, 0x61, 0xa9, 0x6f, 0x4a, 0xd8, 0x49, 0x3a, 0xe5, 0xa3, 0x74, 0xaa, 0x57, 0x7b, 0xa8, 0x43,
+	0xd5, 0x90, 0x35, 0x93, 0x1d, 0x10, 0x2b, 0x4a, 0x6a, 0xdc, 0x83, 0xb3, 0x38, 0x94, 0xcc, 0x76,
+	0x74, 0x7d, 0xda, 0xd4, 0x97, 0x84, 0xb7, 0xb1, 0x57, 0x9e, 0xa8, 0x82, 0xf9, 0x7c, 0xfd, 0xff,
+	0xdd, 0x8e, 0x79, 0x41, 0xc1, 0x0e, 0xd7, 0x43, 0x56, 0x11, 0x67, 0xea, 0xfb, 0x86, 0x16, 0x1b,
+	0x36, 0xfc, 0x5f, 0x0b, 0xef, 0xd8, 0xfd, 0x87, 0x88, 0x2f, 0x39, 0x25, 0xa2, 0x5c, 0xa8, 0x82,
+	0xf9, 0x53, 0xf5, 0x8b, 0xdd, 0x8e, 0x59, 0x55, 0xd8, 0x7f, 0xab, 0x8a, 0xac, 0xd9, 0x16, 0xde,
+	0xc9, 0x76, 0x90, 0x15, 0xb5, 0x61, 0x6c, 0xc0, 0xd2, 0xfe, 0x53, 0x0d, 0x2c, 0xca, 0x93, 0x31,
+	0xf1, 0x8c, 0x3f, 0x86, 0xaa, 0x21, 0xcb, 0x18, 0x00, 0x7e, 0x07, 0x8b, 0x6b, 0xf9, 0x47, 0x8f,
+	0xcd, 0x31, 0xf4, 0x69, 0x0e, 0xce, 0xa5, 0x73, 0xe1, 0x5d, 0x2a, 0x24, 0xe3, 0xd4, 0xc1, 0x9e,
+	0x0a, 0x84, 0x30, 0xbe, 0x03, 0xf0, 0xac, 0x13, 0xb6, 0x42, 0x0f, 0x4b, 0xda, 0x26, 0x3a, 0x6a,
+	0x36, 0xc7, 0x92, 0x32, 0xdd, 0x9b, 0x67, 0x07, 0x7a, 0xf3, 0x75, 0xe2, 0xc4, 0xed, 0xf9, 0x4e,
+	0x94, 0x28, 0xdd, 0x8e, 0x59, 0xd1, 0x59, 0x3f, 0x1c, 0x04, 0x3d, 0x7d, 0x61, 0xbe, 0x79, 0xb8,
+	0x54, 0x52, 0x3d, 0xbc, 0xd4, 0x03, 0x52, 0x1c, 0xad, 0x08, 0xc6, 0x58, 0x86, 0xd3, 0x9c, 0xdc,
+	0x27, 0x9c, 0xf8, 0x0e, 0xb1, 0x9d, 0x78, 0x74, 0xe4, 0x62, 0xc7, 0xcf, 0x75, 0x3b, 0xe6, 0xac,
+	0xa2, 0x30, 0xa0, 0x80, 0xac, 0xa9, 0x54, 0xb2, 0x1c, 0x0b, 0x1e, 0x01, 0x78, 0xb6, 0x37, 0x23,
+	0x43, 0xce, 0x89, 0x2f, 0x13, 0x47, 0x10, 0x38, 0xa9, 0x78, 0x8b, 0x57, 0xdc, 0xfb, 0xaa, 0x1e,
+	0x4b, 0x23, 0xdd, 0x2a, 0xc1, 0x36, 0x66, 0x61, 0x21, 0x20, 0x9c, 0x32, 0x55, 0xf1, 0x79, 0x4b,
+	0xaf, 0xd0, 0x17, 0x00, 0x56, 0x52, 0x6a, 0x4b, 0x8e, 0x76, 0x02, 0x71, 0x33, 0x93, 0x7c, 0x0b,
+	0x42, 0x27, 0x5d, 0xbd, 0x0e, 0x92, 0x19, 0x78, 0xf4, 0x35, 0x80, 0xe7, 0x52, 0x3e, 0xb7, 0x42,
+	0x29, 0x24, 0xf6, 0x5d, 0xea, 0x37, 0x12, 0x77, 0x6d, 0x1f, 0xd6, 0x5d, 0x2b, 0x3a, 0x4d, 0xa6,
+	0x92, 0x18, 0xc5, 0x87, 0xd0, 0x51, 0x1d, 0x88, 0x7e, 0x00, 0x70, 0x26, 0x25, 0xb6, 0xe1, 0x61,
+	0xd1, 0x5c, 0x69, 0x13, 0x5f, 0x1a, 0xab, 0xb0, 0x37, 0xa7, 0x6c, 0xed, 0x62, 0x10, 0x57, 0xcf,
+	0xb9, 0xde, 0xd3, 0x74, 0x50, 0x03, 0x59, 0xd3, 0xa9, 0x68, 0x3d, 0x96, 0x18, 0xef, 0xc1, 0x63,
+	0xf7, 0x39, 0x76, 0xa2, 0xe1, 0xa1, 0x9b, 0x72, 0x6d, 0xb4, 0x8e, 0x68, 0xa5, 0xe7, 0xd1, 0x8f,
+	0x00, 0x16, 0x87, 0x70, 0x15, 0xc6, 0x43, 0x00, 0x67, 0x7b, 0x5c, 0x44, 0xb4, 0x63, 0x93, 0x78,
+	0x4b, 0x7b, 0x73, 0xf1, 0xe0, 0x91, 0x36, 0x04, 0xb4, 0xfe, 0x86, 0x76, 0xf4, 0x85, 0xc1, 0xab,
+	0x66, 0xe1, 0x91, 0x55, 0x6c, 0x0f, 0x21, 0xa4, 0x7b, 0xc5, 0x37, 0x00, 0x4e, 0xae, 0x12, 0x12,
+	0x3f, 0xd1, 0x3e, 0x03, 0x70, 0xaa, 0x37, 0xc9, 0x02, 0xc6, 0xbc, 0x57, 0x04, 0xfa, 0xa6, 0xb6,
+	0x5f, 0x1a, 0x9c, 0x82, 0xd1, 0xd9, 0x91, 0xe3, 0xdd, 0x1b, 0xc9, 0x11, 0x1b, 0xf4, 0x30, 0x07,
+	0xe7, 0xfa, 0x9e, 0x90, 0x1b, 0x01, 0xf1, 0x5d, 0x35, 0x55, 0xb0, 0x67, 0x14, 0xe1, 0x84, 0xa4,
+	0xd2, 0x23, 0x6a, 0x74, 0x5b, 0x6a, 0x61, 0x54, 0xe1, 0x09, 0x97, 0x08, 0x87, 0xd3, 0xa0, 0x17,
+	0x4d, 0x2b, 0x2b, 0x8a, 0x9e, 0x26, 0x9c, 0x38, 0x34, 0xa0, 0xc4, 0x97, 0xe5, 0xf1, 0x23, 0x3f,
+	0x4d, 0x52, 0x8c, 0xcc, 0xc3, 0x36, 0xff, 0x1a, 0x1e, 0xb6, 0xd7, 0x8e, 0x7d, 0xf2, 0xd8, 0x1c,
+	0x8b, 0x43, 0xf5, 0x07, 0x80, 0xa5, 0xf4, 0x2b, 0x68, 0x43, 0x62, 0x2e, 0xa9, 0xdf, 0xb8, 0xe1,
+	0xdf, 0x8f, 0x3b, 0x65, 0xc0, 0x49, 0x9b, 0xb2, 0x68, 0x1a, 0x67, 0xeb, 0x20, 0xd3, 0x29, 0x07,
+	0x14, 0x90, 0x35, 0x95, 0x48, 0x74, 0x15, 0xdc, 0x86, 0x13, 0x42, 0xe2, 0x2d, 0xa2, 0x4b, 0xe0,
+	0xed, 0x91, 0x1f, 0x05, 0x27, 0x95, 0xa1, 0x18, 0x04, 0x59, 0x0a, 0xcc, 0x58, 0x81, 0x85, 0x26,
+	0xa1, 0x8d, 0xa6, 0xf2, 0x75, 0xbe, 0x7e, 0xf9, 0xf7, 0x8e, 0x39, 0xed, 0x70, 0x12, 0x75, 0x78,
+	0xdf, 0x56, 0x5b, 0x3d, 0x92, 0x03, 0x1b, 0xc8, 0xd2, 0x87, 0xeb, 0xb7, 0xbe, 0xdf, 0xab, 0x80,
+	0x67, 0x7b, 0x15, 0xf0, 0x7c, 0xaf, 0x02, 0x7e, 0xdd, 0xab, 0x80, 0x2f, 0x5f, 0x56, 0xc6, 0x9e,
+	0xbf, 0xac, 0x8c, 0xfd, 0xfc, 0xb2, 0x32, 0xf6, 0xc1, 0xe2, 0x81, 0x1c, 0x87, 0x7d, 0xd1, 0x6f,
+	0x16, 0xe2, 0x6f, 0xee, 0xab, 0x7f, 0x0d, 0x00, 0x1b, 0x9d, 0x1d, 0xc8, 0xf0, 0x0f, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -930,6 +1087,63 @@ func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetAutoCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompound)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Authority, that1.Authority) {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -961,6 +1175,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.MaxAutoCompoundEntries != that1.MaxAutoCompoundEntries {
+		return false
+	}
+	if this.MaxAutoCompoundGas != that1.MaxAutoCompoundGas {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1386,6 +1609,93 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1406,6 +1716,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAutoCompoundGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxAutoCompoundEntries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAutoCompoundEntries))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1897,25 +2222,69 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *MsgSetAutoCompound) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.WithdrawAddrEnabled {
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Enabled {
 		n += 2
 	}
 	return n
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovTypes(uint64(m.AutoCompoundInterval))
+	}
+	if m.MaxAutoCompoundEntries != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAutoCompoundEntries))
+	}
+	if m.MaxAutoCompoundGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAutoCompoundGas))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2525,6 +2894,267 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2676,6 +3306,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundEntries", wireType)
+			}
+			m.MaxAutoCompoundEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundGas", wireType)
+			}
+			m.MaxAutoCompoundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
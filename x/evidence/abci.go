@@ -6,20 +6,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by Tendermint. Currently, only equivocation is handled.
+// misbehavior submitted by Tendermint. Evidence is routed by its ABCI type to
+// the handler registered with RegisterABCIEvidenceHandler, invalid or unknown
+// evidence is ignored.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	for _, tmEvidence := range req.ByzantineValidators {
-		switch tmEvidence.Type {
-		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			evidence := ConvertDuplicateVoteEvidence(tmEvidence)
-			k.HandleDoubleSign(ctx, evidence.(Equivocation))
-
-		default:
-			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", tmEvidence.Type))
+		if err := k.HandleABCIEvidence(ctx, tmEvidence); err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("ignored evidence of type %s: %s", tmEvidence.Type, err))
 		}
 	}
 }
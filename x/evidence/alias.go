@@ -16,42 +16,68 @@ const (
 	QueryEvidence            = types.QueryEvidence
 	QueryAllEvidence         = types.QueryAllEvidence
 	QueryParameters          = types.QueryParameters
+	QueryValidateEvidence    = types.QueryValidateEvidence
 	TypeMsgSubmitEvidence    = types.TypeMsgSubmitEvidence
+	TypeMsgUpdateParams      = types.TypeMsgUpdateParams
 	EventTypeSubmitEvidence  = types.EventTypeSubmitEvidence
 	AttributeValueCategory   = types.AttributeValueCategory
 	AttributeKeyEvidenceHash = types.AttributeKeyEvidenceHash
-	DefaultMaxEvidenceAge    = types.DefaultMaxEvidenceAge
+	DefaultMaxAgeNumBlocks   = types.DefaultMaxAgeNumBlocks
+	DefaultMaxAgeDuration    = types.DefaultMaxAgeDuration
+
+	RouteEquivocation                 = types.RouteEquivocation
+	TypeEquivocation                  = types.TypeEquivocation
+	RouteLightClientAttack            = types.RouteLightClientAttack
+	TypeLightClientAttack             = types.TypeLightClientAttack
+	ABCIEvidenceTypeLightClientAttack = types.ABCIEvidenceTypeLightClientAttack
 )
 
 var (
 	NewKeeper  = keeper.NewKeeper
 	NewQuerier = keeper.NewQuerier
 
-	NewMsgSubmitEvidenceBase     = types.NewMsgSubmitEvidenceBase
-	NewRouter                    = types.NewRouter
-	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
-	NewQueryAllEvidenceParams    = types.NewQueryAllEvidenceParams
-	RegisterCodec                = types.RegisterCodec
-	ModuleCdc                    = types.ModuleCdc
-	NewGenesisState              = types.NewGenesisState
-	DefaultGenesisState          = types.DefaultGenesisState
-	ConvertDuplicateVoteEvidence = types.ConvertDuplicateVoteEvidence
-	KeyMaxEvidenceAge            = types.KeyMaxEvidenceAge
-	DoubleSignJailEndTime        = types.DoubleSignJailEndTime
-	ParamKeyTable                = types.ParamKeyTable
-	ErrNoEvidenceHandlerExists   = types.ErrNoEvidenceHandlerExists
-	ErrInvalidEvidence           = types.ErrInvalidEvidence
-	ErrNoEvidenceExists          = types.ErrNoEvidenceExists
-	ErrEvidenceExists            = types.ErrEvidenceExists
+	NewMsgSubmitEvidenceBase              = types.NewMsgSubmitEvidenceBase
+	NewMsgUpdateParams                    = types.NewMsgUpdateParams
+	NewRouter                             = types.NewRouter
+	NewQueryEvidenceParams                = types.NewQueryEvidenceParams
+	NewQueryAllEvidenceParams             = types.NewQueryAllEvidenceParams
+	NewQueryValidateEvidenceParams        = types.NewQueryValidateEvidenceParams
+	NewValidateEvidenceResult             = types.NewValidateEvidenceResult
+	RegisterCodec                         = types.RegisterCodec
+	ModuleCdc                             = types.ModuleCdc
+	NewGenesisState                       = types.NewGenesisState
+	DefaultGenesisState                   = types.DefaultGenesisState
+	ConvertDuplicateVoteEvidence          = types.ConvertDuplicateVoteEvidence
+	ConvertLightClientAttackEvidence      = types.ConvertLightClientAttackEvidence
+	NewParams                             = types.NewParams
+	DefaultParams                         = types.DefaultParams
+	KeyMaxAgeNumBlocks                    = types.KeyMaxAgeNumBlocks
+	KeyMaxAgeDuration                     = types.KeyMaxAgeDuration
+	KeySlashFractionLightClientAttack     = types.KeySlashFractionLightClientAttack
+	DefaultSlashFractionLightClientAttack = types.DefaultSlashFractionLightClientAttack
+	DoubleSignJailEndTime                 = types.DoubleSignJailEndTime
+	ParamKeyTable                         = types.ParamKeyTable
+	ParamsKey                             = types.ParamsKey
+	ErrNoEvidenceHandlerExists            = types.ErrNoEvidenceHandlerExists
+	ErrInvalidEvidence                    = types.ErrInvalidEvidence
+	ErrNoEvidenceExists                   = types.ErrNoEvidenceExists
+	ErrEvidenceExists                     = types.ErrEvidenceExists
+	ErrEvidenceExpired                    = types.ErrEvidenceExpired
 )
 
 type (
 	Keeper = keeper.Keeper
 
-	GenesisState          = types.GenesisState
-	MsgSubmitEvidenceBase = types.MsgSubmitEvidenceBase
-	Handler               = types.Handler
-	Router                = types.Router
-	Equivocation          = types.Equivocation
-	Codec                 = types.Codec
+	GenesisState                = types.GenesisState
+	MsgSubmitEvidenceBase       = types.MsgSubmitEvidenceBase
+	MsgUpdateParams             = types.MsgUpdateParams
+	Handler                     = types.Handler
+	Router                      = types.Router
+	Equivocation                = types.Equivocation
+	LightClientAttack           = types.LightClientAttack
+	Params                      = types.Params
+	Codec                       = types.Codec
+	ABCIEvidenceConverter       = types.ABCIEvidenceConverter
+	QueryValidateEvidenceParams = types.QueryValidateEvidenceParams
+	ValidateEvidenceResult      = types.ValidateEvidenceResult
 )
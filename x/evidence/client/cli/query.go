@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -42,7 +43,7 @@ $ %s query %s --page=2 --limit=50
 	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of evidence to to query for")
 	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of evidence to query for")
 
-	cmd.AddCommand(flags.GetCommands(QueryParamsCmd(cdc))...)
+	cmd.AddCommand(flags.GetCommands(QueryParamsCmd(cdc), QueryValidateEvidenceCmd(cdc))...)
 
 	return flags.GetCommands(cmd)[0]
 }
@@ -76,6 +77,69 @@ $ <appcli> query evidence params
 	}
 }
 
+// QueryValidateEvidenceCmd returns the command handler for the dry run of the
+// handling of evidence.
+func QueryValidateEvidenceCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [evidence-file]",
+		Short: "Check whether evidence would be accepted, without submitting it",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Perform a dry run of the handling of the evidence in the given JSON file against
+the current state. Nothing is slashed and the evidence is not stored. The result
+holds the reason the evidence would be rejected, if any:
+
+$ %s query %s validate evidence.json
+
+Where evidence.json contains:
+
+{
+  "type": "cosmos-sdk/Equivocation",
+  "value": {
+    "height": "100",
+    "time": "2020-05-01T00:00:00Z",
+    "power": "1000",
+    "consensus_address": "cosmosvalcons1..."
+  }
+}
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(contents, &evidence); err != nil {
+				return fmt.Errorf("failed to unmarshal evidence: %w", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidateEvidenceParams(evidence))
+			if err != nil {
+				return fmt.Errorf("failed to marshal query params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidateEvidence)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.ValidateEvidenceResult
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal result: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
 // QueryEvidenceCmd returns the command handler for evidence querying. Evidence
 // can be queried for by hash or paginated evidence can be returned.
 func QueryEvidenceCmd(cdc *codec.Codec) func(*cobra.Command, []string) error {
@@ -7,26 +7,49 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	"github.com/gorilla/mux"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
+		"/evidence/validate",
+		queryValidateEvidenceHandler(cliCtx),
+	).Methods(MethodPost), rest.RouteDoc{
+		Summary:     "Validate evidence without submitting it",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Request:     types.QueryValidateEvidenceParams{},
+		Result:      types.ValidateEvidenceResult{},
+	})
+
+	rest.Document(r.HandleFunc(
 		fmt.Sprintf("/evidence/{%s}", RestParamEvidenceHash),
 		queryEvidenceHandler(cliCtx),
-	).Methods(MethodGet)
+	).Methods(MethodGet), rest.RouteDoc{
+		Summary:     "Get evidence by its hash",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      (*exported.Evidence)(nil),
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/evidence",
 		queryAllEvidenceHandler(cliCtx),
-	).Methods(MethodGet)
+	).Methods(MethodGet), rest.RouteDoc{
+		Summary:     "Get all evidence",
+		QueryParams: []rest.QueryParam{rest.HeightParam, rest.PageParam, rest.LimitParam},
+		Result:      []exported.Evidence{},
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/evidence/params",
 		queryParamsHandler(cliCtx),
-	).Methods(MethodGet)
+	).Methods(MethodGet), rest.RouteDoc{
+		Summary:     "Get the current evidence parameter values",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Params{},
+	})
 }
 
 func queryEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -113,3 +136,40 @@ func queryParamsHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// queryValidateEvidenceHandler performs a dry run of the handling of the
+// evidence in the request body, given as QueryValidateEvidenceParams.
+func queryValidateEvidenceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var params types.QueryValidateEvidenceParams
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &params) {
+			return
+		}
+
+		if params.Evidence == nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "evidence required but not specified")
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidateEvidence)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -1,6 +1,8 @@
 package exported
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -21,6 +23,9 @@ type Evidence interface {
 	// Height at which the infraction occurred
 	GetHeight() int64
 
+	// Time at which the infraction occurred
+	GetTime() time.Time
+
 	// The total power of the malicious validator at time of infraction
 	GetValidatorPower() int64
 
@@ -14,6 +14,9 @@ func NewHandler(k Keeper) sdk.Handler {
 		case MsgSubmitEvidenceBase:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%T must be extended to support evidence", msg)
 
+		case MsgUpdateParams:
+			return handleMsgUpdateParams(ctx, k, msg)
+
 		default:
 			msgSubEv, ok := msg.(exported.MsgSubmitEvidence)
 			if ok {
@@ -44,3 +47,19 @@ func handleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg exported.MsgSubmitEv
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgUpdateParams(ctx sdk.Context, k Keeper, msg MsgUpdateParams) (*sdk.Result, error) {
+	if err := k.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
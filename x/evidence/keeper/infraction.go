@@ -4,33 +4,78 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 // HandleDoubleSign implements an equivocation evidence handler. Assuming the
-// evidence is valid, the validator committing the misbehavior will be slashed,
-// jailed and tombstoned. Once tombstoned, the validator will not be able to
-// recover. Note, the evidence contains the block time and height at the time of
-// the equivocation.
+// evidence is valid, the validator committing the misbehavior will be slashed
+// by the double sign slash fraction of x/slashing, jailed and tombstoned. Once
+// tombstoned, the validator will not be able to recover. Note, the evidence
+// contains the block time and height at the time of the equivocation.
+//
+// Invalid evidence is logged and ignored, see HandleInfraction.
+func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
+	if err := k.handleEquivocation(ctx, evidence); err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("ignored double sign: %s", err))
+	}
+}
+
+// HandleLightClientAttack implements a light client attack evidence handler.
+// Assuming the evidence is valid, the validator which signed the conflicting
+// block will be slashed by the SlashFractionLightClientAttack parameter, jailed
+// and tombstoned.
+//
+// Invalid evidence is logged and ignored, see HandleInfraction.
+func (k Keeper) HandleLightClientAttack(ctx sdk.Context, evidence types.LightClientAttack) {
+	if err := k.handleLightClientAttack(ctx, evidence); err != nil {
+		k.Logger(ctx).Info(fmt.Sprintf("ignored light client attack: %s", err))
+	}
+}
+
+// handleEquivocation is the handler of the duplicate vote evidence reported
+// by Tendermint.
+func (k Keeper) handleEquivocation(ctx sdk.Context, evidence exported.Evidence) error {
+	switch evidence.(type) {
+	case types.Equivocation, *types.Equivocation:
+		return k.HandleInfraction(ctx, evidence, k.slashingKeeper.SlashFractionDoubleSign(ctx))
+
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type %T, expected equivocation", evidence)
+	}
+}
+
+// handleLightClientAttack is the handler of the light client attack evidence
+// reported by Tendermint.
+func (k Keeper) handleLightClientAttack(ctx sdk.Context, evidence exported.Evidence) error {
+	switch evidence.(type) {
+	case types.LightClientAttack, *types.LightClientAttack:
+		return k.HandleInfraction(ctx, evidence, k.SlashFractionLightClientAttack(ctx))
+
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type %T, expected light client attack", evidence)
+	}
+}
+
+// HandleInfraction slashes the validator which committed the infraction of the
+// given evidence by the given fraction, then jails and tombstones it. It is
+// shared by the handlers of the evidence reported by Tendermint, which only
+// differ in their slash fraction.
 //
-// The evidence is considered invalid if:
-// - the evidence is too old
+// The evidence is considered invalid, and an error is returned, if:
+// - the evidence is older than both the maximum age in blocks and duration
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
 //
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
-func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
+// Infractions committed with a consensus key the validator has rotated away
+// from are punished as long as the rotation has not matured.
+func (k Keeper) HandleInfraction(ctx sdk.Context, evidence exported.Evidence, slashFraction sdk.Dec) error {
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
 	infractionHeight := evidence.GetHeight()
 
-	// calculate the age of the evidence
-	blockTime := ctx.BlockHeader().Time
-	age := blockTime.Sub(evidence.GetTime())
-
 	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 		// Ignore evidence that cannot be handled.
 		//
@@ -41,43 +86,45 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 		// allowable but none of the disallowed evidence types.  Instead of
 		// getting this coordination right, it is easier to relax the
 		// constraints and ignore evidence that cannot be handled.
-		return
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unknown validator consensus address %s", consAddr)
 	}
 
-	// reject evidence if the double-sign is too old
-	if age > k.MaxEvidenceAge(ctx) {
-		logger.Info(
-			fmt.Sprintf(
-				"ignored double sign from %s at height %d, age of %d past max age of %d",
-				consAddr, infractionHeight, age, k.MaxEvidenceAge(ctx),
-			),
+	// reject evidence if the infraction is too old
+	params := k.GetParams(ctx)
+	if params.IsEvidenceExpired(ctx.BlockHeight(), ctx.BlockTime(), infractionHeight, evidence.GetTime()) {
+		return sdkerrors.Wrapf(
+			types.ErrEvidenceExpired,
+			"%s from %s at height %d, age of %d blocks and %s past max age of %d blocks and %s",
+			evidence.Type(), consAddr, infractionHeight, ctx.BlockHeight()-infractionHeight,
+			ctx.BlockTime().Sub(evidence.GetTime()), params.MaxAgeNumBlocks, params.MaxAgeDuration,
 		)
-		return
 	}
 
 	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// Tendermint might break this assumption at some point.
-		return
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "validator %s is unbonded or does not exist", consAddr)
 	}
 
-	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
-		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	// The infraction may have been committed with a consensus key the
+	// validator has rotated away from since. The signing info is kept under
+	// the validator's current consensus address.
+	valConsAddr := validator.GetConsAddr()
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, valConsAddr); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", valConsAddr))
 	}
 
 	// ignore if the validator is already tombstoned
-	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
-		logger.Info(
-			fmt.Sprintf(
-				"ignored double sign from %s at height %d, validator already tombstoned",
-				consAddr, infractionHeight,
-			),
+	if k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidEvidence, "%s from %s at height %d, validator already tombstoned",
+			evidence.Type(), consAddr, infractionHeight,
 		)
-		return
 	}
 
-	logger.Info(fmt.Sprintf("confirmed double sign from %s at height %d, age of %d", consAddr, infractionHeight, age))
+	logger.Info(fmt.Sprintf("confirmed %s from %s at height %d", evidence.Type(), consAddr, infractionHeight))
 
 	// We need to retrieve the stake distribution which signed the block, so we
 	// subtract ValidatorUpdateDelay from the evidence height.
@@ -91,19 +138,16 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	k.slashingKeeper.Slash(
-		ctx,
-		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
-		evidence.GetValidatorPower(), distributionHeight,
-	)
+	k.slashingKeeper.Slash(ctx, consAddr, slashFraction, evidence.GetValidatorPower(), distributionHeight)
 
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.Jail(ctx, valConsAddr)
 	}
 
-	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
-	k.slashingKeeper.Tombstone(ctx, consAddr)
+	k.slashingKeeper.JailUntil(ctx, valConsAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.Tombstone(ctx, valConsAddr)
+
+	return nil
 }
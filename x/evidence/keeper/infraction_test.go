@@ -7,7 +7,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func newTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) staking.MsgCreateValidator {
@@ -84,6 +86,51 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.NotNil(res)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_RotatedKey() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	selfDelegation := sdk.TokensFromConsensusPower(power)
+	operatorAddr, oldPubKey, newPubKey := valAddresses[0], pubkeys[0], pubkeys[1]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())
+
+	// create validator
+	handler := staking.NewHandler(suite.app.StakingKeeper)
+	res, err := handler(ctx, newTestMsgCreateValidator(operatorAddr, oldPubKey, selfDelegation))
+	suite.NoError(err)
+	suite.NotNil(res)
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, oldPubKey.Address(), selfDelegation.Int64(), true)
+
+	// rotate the consensus key, moving the signing info over
+	res, err = handler(ctx, staking.NewMsgRotateConsPubKey(operatorAddr, newPubKey))
+	suite.NoError(err)
+	suite.NotNil(res)
+	suite.True(suite.app.SlashingKeeper.HasValidatorSigningInfo(ctx, newConsAddr))
+	suite.False(suite.app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// double sign with the old key
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: oldConsAddr,
+	}
+	suite.app.EvidenceKeeper.HandleDoubleSign(ctx, evidence)
+
+	// the validator should be slashed, jailed and tombstoned under its new key
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, newConsAddr))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
@@ -106,15 +153,91 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	)
 	suite.Equal(amt, suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetBondedTokens())
 
+	// handle a signature to set signing info
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
+
 	evidence := types.Equivocation{
 		Height:           0,
 		Time:             ctx.BlockTime(),
 		Power:            power,
 		ConsensusAddress: sdk.ConsAddress(val.Address()),
 	}
-	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(suite.app.EvidenceKeeper.MaxEvidenceAge(ctx) + 1))
+	params := suite.app.EvidenceKeeper.GetParams(ctx)
+
+	// evidence past the max age duration only is still handled
+	cacheCtx, _ := ctx.WithBlockTime(ctx.BlockTime().Add(params.MaxAgeDuration + 1)).CacheContext()
+	suite.NoError(suite.app.EvidenceKeeper.HandleInfraction(cacheCtx, evidence, sdk.NewDecWithPrec(5, 2)))
+
+	// evidence past the max age in blocks only is still handled
+	cacheCtx, _ = ctx.WithBlockHeight(params.MaxAgeNumBlocks + 1).CacheContext()
+	suite.NoError(suite.app.EvidenceKeeper.HandleInfraction(cacheCtx, evidence, sdk.NewDecWithPrec(5, 2)))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.MaxAgeDuration + 1)).WithBlockHeight(params.MaxAgeNumBlocks + 1)
+	err = suite.app.EvidenceKeeper.HandleInfraction(ctx, evidence, sdk.NewDecWithPrec(5, 2))
+	suite.True(types.ErrEvidenceExpired.Is(err))
 	suite.app.EvidenceKeeper.HandleDoubleSign(ctx, evidence)
 
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) TestHandleABCIEvidence_LightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	selfDelegation := sdk.TokensFromConsensusPower(power)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+
+	res, err := staking.NewHandler(suite.app.StakingKeeper)(ctx, newTestMsgCreateValidator(operatorAddr, val, selfDelegation))
+	suite.NoError(err)
+	suite.NotNil(res)
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	// slash by the light client attack fraction rather than the double sign one
+	params := suite.app.EvidenceKeeper.GetParams(ctx)
+	params.SlashFractionLightClientAttack = sdk.NewDecWithPrec(1, 1)
+	suite.app.EvidenceKeeper.SetParams(ctx, params)
+
+	abciEvidence := abci.Evidence{
+		Type:             types.ABCIEvidenceTypeLightClientAttack,
+		Validator:        abci.Validator{Address: val.Address(), Power: power},
+		Height:           1,
+		Time:             ctx.BlockTime(),
+		TotalVotingPower: power,
+	}
+	suite.NoError(suite.app.EvidenceKeeper.HandleABCIEvidence(ctx, abciEvidence))
+
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+	suite.Equal(
+		selfDelegation.Sub(selfDelegation.QuoRaw(10)),
+		suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens(),
+	)
+
+	// the validator is already tombstoned
+	suite.Error(suite.app.EvidenceKeeper.HandleABCIEvidence(ctx, abciEvidence))
+
+	// unknown ABCI evidence types are rejected
+	abciEvidence.Type = "unknown"
+	err = suite.app.EvidenceKeeper.HandleABCIEvidence(ctx, abciEvidence)
+	suite.True(types.ErrNoEvidenceHandlerExists.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestRegisterABCIEvidenceHandler() {
+	k := suite.app.EvidenceKeeper
+
+	suite.Panics(func() {
+		k.RegisterABCIEvidenceHandler(tmtypes.ABCIEvidenceTypeDuplicateVote, "other", types.ConvertDuplicateVoteEvidence, nil)
+	})
+	suite.Panics(func() {
+		k.RegisterABCIEvidenceHandler("other", types.TypeEquivocation, types.ConvertDuplicateVoteEvidence, nil)
+	})
+
+	// converters must return evidence of the registered type
+	k.RegisterABCIEvidenceHandler("mismatch", "other", types.ConvertDuplicateVoteEvidence, nil)
+	err := k.HandleABCIEvidence(suite.ctx, abci.Evidence{Type: "mismatch"})
+	suite.True(types.ErrInvalidEvidence.Is(err))
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"fmt"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,11 +26,29 @@ type Keeper struct {
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
+	authority      sdk.AccAddress
+
+	// abciEvidence maps ABCI evidence types reported by Tendermint to their
+	// converter and handler, infractionHandlers maps the types of the converted
+	// evidence to the same handlers.
+	abciEvidence       map[string]abciEvidenceRoute
+	infractionHandlers map[string]types.Handler
+}
+
+// abciEvidenceRoute defines how evidence of a given ABCI type is converted and
+// handled.
+type abciEvidenceRoute struct {
+	evidenceType string
+	convert      types.ABCIEvidenceConverter
+	handler      types.Handler
 }
 
+// NewKeeper creates a new evidence Keeper instance. The authority is the only
+// address allowed to update the module parameters through MsgUpdateParams.
 func NewKeeper(
 	cdc types.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace,
 	stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper,
+	authority sdk.AccAddress,
 ) *Keeper {
 
 	// set KeyTable if it has not already been set
@@ -36,13 +56,32 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{
-		cdc:            cdc,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		stakingKeeper:  stakingKeeper,
-		slashingKeeper: slashingKeeper,
+	k := &Keeper{
+		cdc:                cdc,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace,
+		stakingKeeper:      stakingKeeper,
+		slashingKeeper:     slashingKeeper,
+		authority:          authority,
+		abciEvidence:       make(map[string]abciEvidenceRoute),
+		infractionHandlers: make(map[string]types.Handler),
 	}
+
+	k.RegisterABCIEvidenceHandler(
+		tmtypes.ABCIEvidenceTypeDuplicateVote, types.TypeEquivocation,
+		types.ConvertDuplicateVoteEvidence, k.handleEquivocation,
+	)
+	k.RegisterABCIEvidenceHandler(
+		types.ABCIEvidenceTypeLightClientAttack, types.TypeLightClientAttack,
+		types.ConvertLightClientAttackEvidence, k.handleLightClientAttack,
+	)
+
+	return k
+}
+
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
@@ -68,6 +107,71 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 	k.router = rtr
 }
 
+// RegisterABCIEvidenceHandler registers the handler of the evidence of the given
+// ABCI type reported by Tendermint in BeginBlock. The ABCI evidence is converted
+// with the given converter to evidence of the given type, which is handled by
+// the handler. Evidence of the given type can also be validated against the
+// handler with ValidateEvidence. It panics if either type is already registered.
+func (k *Keeper) RegisterABCIEvidenceHandler(
+	abciType, evidenceType string, convert types.ABCIEvidenceConverter, handler types.Handler,
+) {
+	if _, ok := k.abciEvidence[abciType]; ok {
+		panic(fmt.Sprintf("ABCI evidence type %s has already been registered", abciType))
+	}
+	if _, ok := k.infractionHandlers[evidenceType]; ok {
+		panic(fmt.Sprintf("evidence type %s has already been registered", evidenceType))
+	}
+
+	k.abciEvidence[abciType] = abciEvidenceRoute{evidenceType: evidenceType, convert: convert, handler: handler}
+	k.infractionHandlers[evidenceType] = handler
+}
+
+// HandleABCIEvidence converts the evidence reported by Tendermint and executes
+// the handler registered for its ABCI type. An error is returned if no handler
+// is registered for the type or if the evidence is invalid.
+func (k Keeper) HandleABCIEvidence(ctx sdk.Context, abciEvidence abci.Evidence) error {
+	route, ok := k.abciEvidence[abciEvidence.Type]
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrNoEvidenceHandlerExists, "ABCI evidence type %s", abciEvidence.Type)
+	}
+
+	evidence := route.convert(abciEvidence)
+	if evidence.Type() != route.evidenceType {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidEvidence, "ABCI evidence type %s converted to %s, expected %s",
+			abciEvidence.Type, evidence.Type(), route.evidenceType,
+		)
+	}
+	if err := evidence.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+
+	return route.handler(ctx, evidence)
+}
+
+// ValidateEvidence performs a dry run of the handling of the given evidence and
+// returns the error the handling would fail with, if any. Evidence routed by
+// the Evidence Handler router is checked as if it were submitted, other evidence
+// against the handler registered for its type with RegisterABCIEvidenceHandler.
+// No state is written.
+func (k Keeper) ValidateEvidence(ctx sdk.Context, evidence exported.Evidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if k.router != nil && k.router.HasRoute(evidence.Route()) {
+		return k.SubmitEvidence(cacheCtx, evidence)
+	}
+
+	handler, ok := k.infractionHandlers[evidence.Type()]
+	if !ok {
+		return sdkerrors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Type())
+	}
+
+	return handler(cacheCtx, evidence)
+}
+
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
 // no handler exists, an error is returned.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
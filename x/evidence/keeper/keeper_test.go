@@ -85,6 +85,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	evidenceKeeper := evidence.NewKeeper(
 		codecstd.NewAppCodec(app.Codec()), app.GetKey(evidence.StoreKey),
 		app.GetSubspace(evidence.ModuleName), app.StakingKeeper, app.SlashingKeeper,
+		app.EvidenceKeeper.GetAuthority(),
 	)
 	router := evidence.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper))
@@ -4,22 +4,101 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// MaxEvidenceAge returns the maximum age for submitted evidence.
-func (k Keeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
-	k.paramSpace.Get(ctx, types.KeyMaxEvidenceAge, &res)
-	return
+// MaxAgeNumBlocks returns the maximum age in blocks for evidence to be handled.
+func (k Keeper) MaxAgeNumBlocks(ctx sdk.Context) (res int64) {
+	return k.GetParams(ctx).MaxAgeNumBlocks
 }
 
-// GetParams returns the total set of evidence parameters.
+// MaxAgeDuration returns the maximum age duration for evidence to be handled.
+func (k Keeper) MaxAgeDuration(ctx sdk.Context) (res time.Duration) {
+	return k.GetParams(ctx).MaxAgeDuration
+}
+
+// SlashFractionLightClientAttack returns the fraction slashed for a light
+// client attack.
+func (k Keeper) SlashFractionLightClientAttack(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).SlashFractionLightClientAttack
+}
+
+// paramStore returns the store of the evidence parameters.
+func (k Keeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(k.cdc, k.storeKey, types.ParamsKey, k.paramSpace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams returns the total set of evidence parameters. Parameters that have
+// not been migrated to the module store yet are read from the legacy param
+// space.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramStore().Get(ctx, &params)
 	return params
 }
 
-// SetParams sets the evidence parameters to the param space.
+// SetParams sets the evidence parameters in the module store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	k.paramStore().Set(ctx, &params)
+}
+
+// UpdateParams validates and sets the evidence parameters on behalf of the
+// given authority, which must be the authority of the module.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(k.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the evidence parameters from the legacy x/params
+// subspace to the module store.
+func (k Keeper) MigrateParams(ctx sdk.Context) error {
+	return k.paramStore().Migrate(ctx)
+}
+
+// legacyKeyMaxEvidenceAge is the subspace key of the maximum evidence age param
+// of the first version of the module, replaced by the MaxAgeDuration param.
+var legacyKeyMaxEvidenceAge = []byte("MaxEvidenceAge")
+
+// MigrateMaxAge sets the evidence age and light client attack params. The
+// maximum age duration is set to the legacy maximum evidence age, and the other
+// params to their default values.
+func (k Keeper) MigrateMaxAge(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	params.MaxAgeNumBlocks = types.DefaultMaxAgeNumBlocks
+	params.MaxAgeDuration = types.DefaultMaxAgeDuration
+	params.SlashFractionLightClientAttack = types.DefaultSlashFractionLightClientAttack
+
+	if bz := k.paramSpace.GetRaw(ctx, legacyKeyMaxEvidenceAge); bz != nil {
+		if err := k.cdc.UnmarshalJSON(bz, &params.MaxAgeDuration); err != nil {
+			return err
+		}
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return k.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return k.paramStore().SetParamSet(ctx, ps)
 }
@@ -1,11 +1,35 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 func (suite *KeeperTestSuite) TestParams() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	suite.Equal(types.DefaultParams(), suite.app.EvidenceKeeper.GetParams(ctx))
-	suite.Equal(types.DefaultMaxEvidenceAge, suite.app.EvidenceKeeper.MaxEvidenceAge(ctx))
+	suite.Equal(types.DefaultMaxAgeNumBlocks, suite.app.EvidenceKeeper.MaxAgeNumBlocks(ctx))
+	suite.Equal(types.DefaultMaxAgeDuration, suite.app.EvidenceKeeper.MaxAgeDuration(ctx))
+	suite.Equal(types.DefaultSlashFractionLightClientAttack, suite.app.EvidenceKeeper.SlashFractionLightClientAttack(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateMaxAge() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	// params stored in the subspace by the first version of the module
+	paramsStore := prefix.NewStore(ctx.KVStore(suite.app.GetKey(params.StoreKey)), []byte(types.ModuleName+"/"))
+	paramsStore.Set([]byte("MaxEvidenceAge"), types.ModuleCdc.MustMarshalJSON(time.Hour))
+	ctx.KVStore(suite.app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	suite.NoError(suite.app.EvidenceKeeper.MigrateParams(ctx))
+	suite.NoError(suite.app.EvidenceKeeper.MigrateMaxAge(ctx))
+
+	migrated := suite.app.EvidenceKeeper.GetParams(ctx)
+	suite.NoError(migrated.Validate())
+	suite.Equal(time.Hour, migrated.MaxAgeDuration)
+	suite.Equal(types.DefaultMaxAgeNumBlocks, migrated.MaxAgeNumBlocks)
+	suite.Equal(types.DefaultSlashFractionLightClientAttack, migrated.SlashFractionLightClientAttack)
 }
@@ -30,6 +30,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAllEvidence:
 			res, err = queryAllEvidence(ctx, req, k)
 
+		case types.QueryValidateEvidence:
+			res, err = queryValidateEvidence(ctx, req, k)
+
 		default:
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -99,3 +102,25 @@ func queryAllEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 
 	return res, nil
 }
+
+func queryValidateEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidateEvidenceParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Evidence == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing evidence")
+	}
+
+	result := types.NewValidateEvidenceResult(params.Evidence, k.ValidateEvidence(ctx, params.Evidence))
+
+	res, err := codec.MarshalJSONIndent(k.cdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -2,12 +2,15 @@ package keeper_test
 
 import (
 	"strings"
+	"time"
 
 	codecstd "github.com/cosmos/cosmos-sdk/codec/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 const (
@@ -96,5 +99,45 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	bz, err := suite.querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
 	suite.Nil(err)
 	suite.NotNil(bz)
-	suite.Equal("{\n  \"max_evidence_age\": \"120000000000\"\n}", string(bz))
+	suite.Equal("{\n  \"max_age_num_blocks\": \"100000\",\n  \"max_age_duration\": \"172800000000000\",\n  \"slash_fraction_light_client_attack\": \"0.050000000000000000\"\n}", string(bz))
+}
+
+func (suite *KeeperTestSuite) TestQueryValidateEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockTime(time.Now())
+	cdc := codecstd.NewAppCodec(suite.app.Codec())
+	path := strings.Join([]string{custom, types.QuerierRoute, types.QueryValidateEvidence}, "/")
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		msg      string
+		evidence exported.Evidence
+		valid    bool
+	}{
+		// routed to the test handler, which rejects even heights
+		{"routed valid", types.Equivocation{Height: 11, Power: 100, Time: ctx.BlockTime(), ConsensusAddress: consAddr}, true},
+		{"routed invalid", types.Equivocation{Height: 12, Power: 100, Time: ctx.BlockTime(), ConsensusAddress: consAddr}, false},
+		// handled by the light client attack handler, for which the validator is unknown
+		{"unknown validator", types.LightClientAttack{Height: 11, Power: 100, TotalPower: 100, Time: ctx.BlockTime(), ConsensusAddress: consAddr}, false},
+		{"invalid basic", types.LightClientAttack{Height: 11, Power: 100, Time: ctx.BlockTime(), ConsensusAddress: consAddr}, false},
+	}
+
+	for _, tc := range testCases {
+		query := abci.RequestQuery{
+			Path: path,
+			Data: cdc.MustMarshalJSON(types.NewQueryValidateEvidenceParams(tc.evidence)),
+		}
+
+		bz, err := suite.querier(ctx, []string{types.QueryValidateEvidence}, query)
+		suite.Require().NoError(err, tc.msg)
+
+		var result types.ValidateEvidenceResult
+		suite.Require().NoError(cdc.UnmarshalJSON(bz, &result), tc.msg)
+		suite.Equal(tc.valid, result.Valid, tc.msg)
+		suite.Equal(tc.evidence.Hash().String(), result.Hash, tc.msg)
+		suite.Equal(tc.valid, result.Error == "", tc.msg)
+
+		// the dry run doesn't store the evidence
+		_, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, tc.evidence.Hash())
+		suite.False(ok, tc.msg)
+	}
 }
@@ -166,3 +166,23 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterMigrations registers the in-place store migrations of the evidence
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 replaces the maximum evidence age by the age params of
+	// Tendermint and adds the light client attack slash fraction
+	err = cfg.RegisterMigration(ModuleName, 2, am.keeper.MigrateMaxAge)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -20,7 +20,9 @@ type Codec interface {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
 	cdc.RegisterConcrete(MsgSubmitEvidenceBase{}, "cosmos-sdk/MsgSubmitEvidenceBase", nil)
+	cdc.RegisterConcrete(MsgUpdateParams{}, "cosmos-sdk/MsgUpdateEvidenceParams", nil)
 	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+	cdc.RegisterConcrete(LightClientAttack{}, "cosmos-sdk/LightClientAttack", nil)
 }
 
 var (
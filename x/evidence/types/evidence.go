@@ -15,11 +15,21 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "lightclientattack"
 )
 
-var _ exported.Evidence = (*Equivocation)(nil)
+// ABCIEvidenceTypeLightClientAttack is the ABCI type of the evidence reported
+// by Tendermint for validators which signed a conflicting block to deceive
+// light clients.
+const ABCIEvidenceTypeLightClientAttack = "light_client/attack"
+
+var (
+	_ exported.Evidence = (*Equivocation)(nil)
+	_ exported.Evidence = (*LightClientAttack)(nil)
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e Equivocation) Route() string { return RouteEquivocation }
@@ -90,3 +100,81 @@ func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) exported.Evidence {
 		Time:             dupVote.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e LightClientAttack) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(&e))
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack
+// object.
+func (e LightClientAttack) ValidateBasic() error {
+	if e.Time.IsZero() {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress.Empty() {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight returns the height at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the LightClientAttack infraction.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total power of the validator set at time of the
+// LightClientAttack infraction.
+func (e LightClientAttack) GetTotalPower() int64 {
+	return e.TotalPower
+}
+
+// ConvertLightClientAttackEvidence converts a Tendermint light client attack
+// Evidence type to SDK Evidence using LightClientAttack as the concrete type.
+func ConvertLightClientAttackEvidence(attack abci.Evidence) exported.Evidence {
+	return LightClientAttack{
+		Height:           attack.Height,
+		Power:            attack.Validator.Power,
+		TotalPower:       attack.TotalVotingPower,
+		ConsensusAddress: sdk.ConsAddress(attack.Validator.Address),
+		Time:             attack.Time,
+	}
+}
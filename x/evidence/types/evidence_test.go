@@ -54,3 +54,28 @@ func TestEquivocationValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, 3000000, sdk.ConsAddress("foo")}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, 3000000, sdk.ConsAddress("foo")}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, 3000000, sdk.ConsAddress("foo")}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, 3000000, sdk.ConsAddress("foo")}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, 100, sdk.ConsAddress("foo")}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, 3000000, nil}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
@@ -39,9 +39,13 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	maxEvidence := gs.Params.MaxEvidenceAge
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	maxEvidence := gs.Params.MaxAgeDuration
 	if maxEvidence < 1*time.Minute {
-		return fmt.Errorf("max evidence age must be at least 1 minute, is %s", maxEvidence.String())
+		return fmt.Errorf("max evidence age duration must be at least 1 minute, is %s", maxEvidence.String())
 	}
 
 	return nil
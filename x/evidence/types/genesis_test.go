@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
@@ -50,3 +51,30 @@ func TestGenesisStateValidate_Invalid(t *testing.T) {
 	gs := types.NewGenesisState(types.DefaultParams(), evidence)
 	require.Error(t, gs.Validate())
 }
+
+func TestGenesisStateValidate_InvalidParams(t *testing.T) {
+	testCases := []struct {
+		msg    string
+		params types.Params
+	}{
+		{"zero max age num blocks", types.NewParams(0, types.DefaultMaxAgeDuration, types.DefaultSlashFractionLightClientAttack)},
+		{"max age duration below a minute", types.NewParams(types.DefaultMaxAgeNumBlocks, time.Second, types.DefaultSlashFractionLightClientAttack)},
+		{"negative slash fraction", types.NewParams(types.DefaultMaxAgeNumBlocks, types.DefaultMaxAgeDuration, sdk.NewDec(-1))},
+		{"slash fraction above one", types.NewParams(types.DefaultMaxAgeNumBlocks, types.DefaultMaxAgeDuration, sdk.NewDec(2))},
+	}
+
+	for _, tc := range testCases {
+		gs := types.NewGenesisState(tc.params, []exported.Evidence{})
+		require.Error(t, gs.Validate(), tc.msg)
+	}
+}
+
+func TestParamsIsEvidenceExpired(t *testing.T) {
+	params := types.NewParams(10, time.Minute, types.DefaultSlashFractionLightClientAttack)
+	now := time.Now()
+
+	// evidence is only expired once both the max age in blocks and duration are exceeded
+	require.False(t, params.IsEvidenceExpired(20, now, 10, now.Add(-time.Hour)))
+	require.False(t, params.IsEvidenceExpired(21, now, 10, now))
+	require.True(t, params.IsEvidenceExpired(21, now, 10, now.Add(-time.Hour)))
+}
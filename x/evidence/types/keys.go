@@ -17,4 +17,5 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixEvidence = []byte{0x00}
+	ParamsKey         = []byte{0x01}
 )
@@ -8,10 +8,12 @@ import (
 // Message types for the evidence module
 const (
 	TypeMsgSubmitEvidence = "submit_evidence"
+	TypeMsgUpdateParams   = "update_params"
 )
 
 var (
 	_ sdk.Msg = MsgSubmitEvidenceBase{}
+	_ sdk.Msg = MsgUpdateParams{}
 )
 
 // NewMsgSubmitEvidenceBase returns a new MsgSubmitEvidenceBase with a signer/submitter.
@@ -46,3 +48,35 @@ func (m MsgSubmitEvidenceBase) GetSignBytes() []byte {
 func (m MsgSubmitEvidenceBase) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Submitter}
 }
+
+// NewMsgUpdateParams returns a new MsgUpdateParams which replaces the evidence
+// parameters on behalf of the given authority.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) MsgUpdateParams {
+	return MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// Route returns the MsgUpdateParams's route.
+func (m MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the MsgUpdateParams's type.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgUpdateParams.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if m.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgUpdateParams message.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners returns the single expected signer for a MsgUpdateParams.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Authority}
+}
@@ -65,3 +65,26 @@ func TestMsgSubmitEvidence(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+
+	invalidParams := types.DefaultParams()
+	invalidParams.MaxAgeNumBlocks = 0
+
+	testCases := []struct {
+		msg       types.MsgUpdateParams
+		expectErr bool
+	}{
+		{types.NewMsgUpdateParams(authority, types.DefaultParams()), false},
+		{types.NewMsgUpdateParams(nil, types.DefaultParams()), true},
+		{types.NewMsgUpdateParams(authority, invalidParams), true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, types.RouterKey, tc.msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, types.TypeMsgUpdateParams, tc.msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, tc.expectErr, tc.msg.ValidateBasic() != nil, "unexpected result for tc #%d", i)
+		require.Equal(t, []sdk.AccAddress{tc.msg.Authority}, tc.msg.GetSigners(), "unexpected result for tc #%d", i)
+	}
+}
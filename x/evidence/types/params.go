@@ -6,6 +6,7 @@ import (
 
 	"gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,13 +14,18 @@ import (
 
 // Default parameter values
 const (
-	DefaultParamspace     = ModuleName
-	DefaultMaxEvidenceAge = 60 * 2 * time.Second
+	DefaultParamspace      = ModuleName
+	DefaultMaxAgeNumBlocks = int64(100000)
+	DefaultMaxAgeDuration  = 48 * time.Hour
 )
 
 // Parameter store keys
 var (
-	KeyMaxEvidenceAge = []byte("MaxEvidenceAge")
+	KeyMaxAgeNumBlocks                = []byte("MaxAgeNumBlocks")
+	KeyMaxAgeDuration                 = []byte("MaxAgeDuration")
+	KeySlashFractionLightClientAttack = []byte("SlashFractionLightClientAttack")
+
+	DefaultSlashFractionLightClientAttack = sdk.NewDec(1).Quo(sdk.NewDec(20))
 
 	// The Double Sign Jail period ends at Max Time supported by Amino
 	// (Dec 31, 9999 - 23:59:59 GMT).
@@ -31,6 +37,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams creates a new Params object
+func NewParams(maxAgeNumBlocks int64, maxAgeDuration time.Duration, slashFractionLightClientAttack sdk.Dec) Params {
+	return Params{
+		MaxAgeNumBlocks:                maxAgeNumBlocks,
+		MaxAgeDuration:                 maxAgeDuration,
+		SlashFractionLightClientAttack: slashFractionLightClientAttack,
+	}
+}
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -39,25 +54,75 @@ func (p Params) String() string {
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateMaxEvidenceAge),
+		paramtypes.NewParamSetPair(KeyMaxAgeNumBlocks, &p.MaxAgeNumBlocks, validateMaxAgeNumBlocks),
+		paramtypes.NewParamSetPair(KeyMaxAgeDuration, &p.MaxAgeDuration, validateMaxAgeDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionLightClientAttack, &p.SlashFractionLightClientAttack, validateSlashFraction),
 	}
 }
 
 // DefaultParams returns the default parameters for the evidence module.
 func DefaultParams() Params {
-	return Params{
-		MaxEvidenceAge: DefaultMaxEvidenceAge,
+	return NewParams(DefaultMaxAgeNumBlocks, DefaultMaxAgeDuration, DefaultSlashFractionLightClientAttack)
+}
+
+// Validate performs basic validation of the evidence parameters.
+func (p Params) Validate() error {
+	if err := validateMaxAgeNumBlocks(p.MaxAgeNumBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxAgeDuration(p.MaxAgeDuration); err != nil {
+		return err
 	}
+
+	return validateSlashFraction(p.SlashFractionLightClientAttack)
+}
+
+// IsEvidenceExpired returns true if evidence of an infraction committed at the
+// given height and time is too old to be handled at the given block height and
+// time. As in Tendermint, evidence is only expired once it exceeds both the
+// maximum age in blocks and the maximum age duration.
+func (p Params) IsEvidenceExpired(blockHeight int64, blockTime time.Time, height int64, t time.Time) bool {
+	return blockHeight-height > p.MaxAgeNumBlocks && blockTime.Sub(t) > p.MaxAgeDuration
 }
 
-func validateMaxEvidenceAge(i interface{}) error {
+func validateMaxAgeNumBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max age num blocks must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxAgeDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
 	if v <= 0 {
-		return fmt.Errorf("max evidence age must be positive: %s", v)
+		return fmt.Errorf("max age duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("slash fraction must be non-negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction too large: %s", v)
 	}
 
 	return nil
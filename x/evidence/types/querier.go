@@ -1,10 +1,15 @@
 package types
 
+import (
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
 // Querier routes for the evidence module
 const (
-	QueryParameters  = "parameters"
-	QueryEvidence    = "evidence"
-	QueryAllEvidence = "all_evidence"
+	QueryParameters       = "parameters"
+	QueryEvidence         = "evidence"
+	QueryAllEvidence      = "all_evidence"
+	QueryValidateEvidence = "validate_evidence"
 )
 
 // QueryEvidenceParams defines the parameters necessary for querying Evidence.
@@ -25,3 +30,30 @@ type QueryAllEvidenceParams struct {
 func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
 	return QueryAllEvidenceParams{Page: page, Limit: limit}
 }
+
+// QueryValidateEvidenceParams defines the parameters necessary for validating
+// Evidence without submitting it.
+type QueryValidateEvidenceParams struct {
+	Evidence exported.Evidence `json:"evidence" yaml:"evidence"`
+}
+
+func NewQueryValidateEvidenceParams(evidence exported.Evidence) QueryValidateEvidenceParams {
+	return QueryValidateEvidenceParams{Evidence: evidence}
+}
+
+// ValidateEvidenceResult defines the result of the validation of Evidence. If
+// the Evidence is invalid, Error holds the reason.
+type ValidateEvidenceResult struct {
+	Hash  string `json:"hash" yaml:"hash"`
+	Valid bool   `json:"valid" yaml:"valid"`
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func NewValidateEvidenceResult(evidence exported.Evidence, err error) ValidateEvidenceResult {
+	res := ValidateEvidenceResult{Hash: evidence.Hash().String(), Valid: err == nil}
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res
+}
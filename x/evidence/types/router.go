@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type (
@@ -14,6 +16,10 @@ type (
 	// slashing and potential jailing.
 	Handler func(sdk.Context, exported.Evidence) error
 
+	// ABCIEvidenceConverter converts evidence of misbehavior of a given ABCI type
+	// reported by Tendermint to its concrete Evidence type.
+	ABCIEvidenceConverter func(abci.Evidence) exported.Evidence
+
 	// Router defines a contract for which any Evidence handling module must
 	// implement in order to route Evidence to registered Handlers.
 	Router interface {
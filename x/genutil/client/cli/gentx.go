@@ -93,8 +93,7 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 			}
 
 			inBuf := bufio.NewReader(cmd.InOrStdin())
-			kb, err := keys.NewKeyring(sdk.KeyringServiceName(),
-				viper.GetString(flags.FlagKeyringBackend), viper.GetString(flagClientHome), inBuf)
+			kb, err := flags.NewKeyringFromFlags(viper.GetString(flagClientHome), inBuf)
 			if err != nil {
 				return errors.Wrap(err, "failed to initialize keybase")
 			}
@@ -187,8 +186,12 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 	cmd.Flags().String(flags.FlagOutputDocument, "",
 		"write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
-	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test|remote)")
+	cmd.Flags().String(flags.FlagKeyringRemoteAddr, "", "Address of the remote signer of the remote keyring backend (default unix://<home>/remote-signer.sock)")
+	cmd.Flags().String(flags.FlagKeyringRemoteAuth, "", "File holding the key authenticating the remote keyring backend to the remote signer (default <home>/remote-signer.key)")
 	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
+	viper.BindPFlag(flags.FlagKeyringRemoteAddr, cmd.Flags().Lookup(flags.FlagKeyringRemoteAddr))
+	viper.BindPFlag(flags.FlagKeyringRemoteAuth, cmd.Flags().Lookup(flags.FlagKeyringRemoteAuth))
 
 	cmd.MarkFlagRequired(flags.FlagName)
 	return cmd
@@ -14,11 +14,13 @@ const (
 	ModuleName            = types.ModuleName
 	StoreKey              = types.StoreKey
 	RouterKey             = types.RouterKey
+	ExecRouterKey         = types.ExecRouterKey
 	QuerierRoute          = types.QuerierRoute
 	DefaultParamspace     = types.DefaultParamspace
 	TypeMsgDeposit        = types.TypeMsgDeposit
 	TypeMsgVote           = types.TypeMsgVote
 	TypeMsgSubmitProposal = types.TypeMsgSubmitProposal
+	TypeMsgUpdateParams   = types.TypeMsgUpdateParams
 	StatusNil             = types.StatusNil
 	StatusDepositPeriod   = types.StatusDepositPeriod
 	StatusVotingPeriod    = types.StatusVotingPeriod
@@ -26,6 +28,7 @@ const (
 	StatusRejected        = types.StatusRejected
 	StatusFailed          = types.StatusFailed
 	ProposalTypeText      = types.ProposalTypeText
+	ProposalTypeExec      = types.ProposalTypeExec
 	QueryParams           = types.QueryParams
 	QueryProposals        = types.QueryProposals
 	QueryProposal         = types.QueryProposal
@@ -53,6 +56,7 @@ var (
 	NewQuerier                    = keeper.NewQuerier
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
+	RegisterProposalTypeInterface = types.RegisterProposalTypeInterface
 	ValidateAbstract              = types.ValidateAbstract
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
@@ -63,6 +67,7 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidProposalMsg         = types.ErrInvalidProposalMsg
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -86,6 +91,7 @@ var (
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgUpdateParams            = types.NewMsgUpdateParams
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -120,6 +126,7 @@ var (
 	ProposalIDKey               = types.ProposalIDKey
 	DepositsKeyPrefix           = types.DepositsKeyPrefix
 	VotesKeyPrefix              = types.VotesKeyPrefix
+	ParamsKey                   = types.ParamsKey
 	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
 	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
 	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
@@ -128,6 +135,7 @@ var (
 type (
 	Keeper                = keeper.Keeper
 	Content               = types.Content
+	ExecContent           = types.ExecContent
 	Handler               = types.Handler
 	Deposit               = types.Deposit
 	Deposits              = types.Deposits
@@ -137,6 +145,7 @@ type (
 	MsgSubmitProposalBase = types.MsgSubmitProposalBase
 	MsgDeposit            = types.MsgDeposit
 	MsgVote               = types.MsgVote
+	MsgUpdateParams       = types.MsgUpdateParams
 	DepositParams         = types.DepositParams
 	TallyParams           = types.TallyParams
 	VotingParams          = types.VotingParams
@@ -15,15 +15,60 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc(fmt.Sprintf("/gov/parameters/{%s}", RestParamsType), queryParamsHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc("/gov/proposals", queryProposalsWithParameterFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}", RestProposalID), queryProposalHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/proposer", RestProposalID), queryProposerHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), queryDepositsHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits/{%s}", RestProposalID, RestDepositor), queryDepositHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/tally", RestProposalID), queryTallyOnProposalHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), queryVotesOnProposalHandlerFn(cliCtx)).Methods("GET")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}", RestProposalID, RestVoter), queryVoteHandlerFn(cliCtx)).Methods("GET")
+	height := []rest.QueryParam{rest.HeightParam}
+
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/parameters/{%s}", RestParamsType), queryParamsHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the governance parameters of a type",
+		Description: "The type is one of deposit, voting and tallying, whose parameters are the result.",
+		QueryParams: height,
+	})
+	rest.Document(r.HandleFunc("/gov/proposals", queryProposalsWithParameterFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary: "Get the proposals, filtered by their status, voter and depositor",
+		QueryParams: []rest.QueryParam{
+			rest.HeightParam,
+			rest.PageParam,
+			rest.LimitParam,
+			{Name: RestVoter, Description: "bech32 address of a voter of the proposals"},
+			{Name: RestDepositor, Description: "bech32 address of a depositor of the proposals"},
+			{Name: RestProposalStatus, Description: "status of the proposals, e.g. voting_period"},
+		},
+		Result: types.Proposals{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}", RestProposalID), queryProposalHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get a proposal",
+		QueryParams: height,
+		Result:      types.Proposal{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/proposer", RestProposalID), queryProposerHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the proposer of a proposal",
+		QueryParams: height,
+		Result:      gcutils.Proposer{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), queryDepositsHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the deposits on a proposal",
+		QueryParams: height,
+		Result:      types.Deposits{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits/{%s}", RestProposalID, RestDepositor), queryDepositHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the deposit of a depositor on a proposal",
+		QueryParams: height,
+		Result:      types.Deposit{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/tally", RestProposalID), queryTallyOnProposalHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the tally of the votes on a proposal",
+		QueryParams: height,
+		Result:      types.TallyResult{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), queryVotesOnProposalHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the votes on a proposal",
+		QueryParams: []rest.QueryParam{rest.HeightParam, rest.PageParam, rest.LimitParam},
+		Result:      types.Votes{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}", RestProposalID, RestVoter), queryVoteHandlerFn(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the vote of a voter on a proposal",
+		QueryParams: height,
+		Result:      types.Vote{},
+	})
 }
 
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
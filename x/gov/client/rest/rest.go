@@ -22,10 +22,11 @@ const (
 )
 
 // ProposalRESTHandler defines a REST handler implemented in another module. The
-// sub-route is mounted on the governance REST handler.
+// sub-route is mounted on the governance REST handler and documented with Doc.
 type ProposalRESTHandler struct {
 	SubRoute string
 	Handler  func(http.ResponseWriter, *http.Request)
+	Doc      rest.RouteDoc
 }
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
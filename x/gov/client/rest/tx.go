@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gcutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -17,12 +18,27 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, phs []ProposalRESTHandler) {
 	propSubRtr := r.PathPrefix("/gov/proposals").Subrouter()
 	for _, ph := range phs {
-		propSubRtr.HandleFunc(fmt.Sprintf("/%s", ph.SubRoute), ph.Handler).Methods("POST")
+		route := propSubRtr.HandleFunc(fmt.Sprintf("/%s", ph.SubRoute), ph.Handler).Methods("POST")
+		if ph.Doc.Summary != "" {
+			rest.Document(route, ph.Doc)
+		}
 	}
 
-	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cliCtx)).Methods("POST")
+	rest.Document(r.HandleFunc("/gov/proposals", postProposalHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction submitting a text proposal",
+		Request:  PostProposalReq{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction depositing on a proposal",
+		Request:  DepositReq{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction voting on a proposal",
+		Request:  VoteReq{},
+		Response: authtypes.StdTx{},
+	})
 }
 
 func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
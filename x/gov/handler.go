@@ -23,6 +23,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgUpdateParams:
+			return handleMsgUpdateParams(ctx, keeper, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -105,3 +108,65 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) (*sdk.Result, er
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgUpdateParams(ctx sdk.Context, keeper Keeper, msg MsgUpdateParams) (*sdk.Result, error) {
+	if err := keeper.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// NewExecProposalHandler returns a proposal Handler executing the messages of
+// an ExecContent through the given message router. Every message must be
+// signed by the authority only, which is expected to be the governance module
+// account, and pass the filter, which is expected to be the MsgFilter set on
+// BaseApp, so that the messages disabled for transactions cannot be executed
+// by a proposal either. A nil filter accepts every message.
+func NewExecProposalHandler(router sdk.Router, filter sdk.MsgFilter, authority sdk.AccAddress) Handler {
+	return func(ctx sdk.Context, content Content) error {
+		c, ok := content.(types.ExecContent)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized exec proposal content type: %T", content)
+		}
+
+		for i, msg := range c.GetMsgs() {
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "message %d", i)
+			}
+
+			signers := msg.GetSigners()
+			if len(signers) != 1 || !signers[0].Equals(authority) {
+				return sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "message %d must be signed by %s only", i, authority)
+			}
+
+			if filter != nil {
+				if err := filter(ctx, msg); err != nil {
+					return sdkerrors.Wrapf(err, "message %d", i)
+				}
+			}
+
+			handler := router.Route(ctx, msg.Route())
+			if handler == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+			}
+
+			res, err := handler(ctx, msg)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "message %d", i)
+			}
+
+			ctx.EventManager().EmitEvents(res.Events)
+		}
+
+		return nil
+	}
+}
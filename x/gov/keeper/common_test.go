@@ -24,6 +24,7 @@ func createValidators(ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sd
 		app.BankKeeper,
 		app.SupplyKeeper,
 		app.GetSubspace(staking.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 
 	val1 := staking.NewValidator(valAddrs[0], pks[0], staking.Description{})
@@ -30,6 +30,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// The address allowed to update the module parameters
+	authority sdk.AccAddress
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -38,10 +41,13 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
+// The authority is the only address allowed to update the module parameters
+// through MsgUpdateParams.
+//
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc types.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
-	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, rtr types.Router,
+	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, rtr types.Router, authority sdk.AccAddress,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -61,9 +67,15 @@ func NewKeeper(
 		sk:           sk,
 		cdc:          cdc,
 		router:       rtr,
+		authority:    authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (keeper Keeper) GetAuthority() sdk.AccAddress {
+	return keeper.authority
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
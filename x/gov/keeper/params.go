@@ -2,41 +2,93 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
+// paramStore returns the store of the governance parameters.
+func (keeper Keeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(keeper.cdc, keeper.storeKey, types.ParamsKey, keeper.paramSpace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams returns all the governance params. Params that have not been
+// migrated to the module store yet are read from the global param store.
+func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	keeper.paramStore().Get(ctx, &params)
+	return params
+}
+
+// SetParams sets all the governance params in the module store
+func (keeper Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramStore().Set(ctx, &params)
+}
+
+// UpdateParams validates and sets the governance params on behalf of the given
+// authority, which must be the authority of the module
+func (keeper Keeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(keeper.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", keeper.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	keeper.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the governance parameters from the legacy x/params
+// subspace to the module store.
+func (keeper Keeper) MigrateParams(ctx sdk.Context) error {
+	return keeper.paramStore().Migrate(ctx)
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (keeper Keeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return keeper.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (keeper Keeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return keeper.paramStore().SetParamSet(ctx, ps)
+}
+
+// GetDepositParams returns the current DepositParams
 func (keeper Keeper) GetDepositParams(ctx sdk.Context) types.DepositParams {
-	var depositParams types.DepositParams
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
-	return depositParams
+	return keeper.GetParams(ctx).DepositParams
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
+// GetVotingParams returns the current VotingParams
 func (keeper Keeper) GetVotingParams(ctx sdk.Context) types.VotingParams {
-	var votingParams types.VotingParams
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
-	return votingParams
+	return keeper.GetParams(ctx).VotingParams
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
+// GetTallyParams returns the current TallyParam
 func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
-	var tallyParams types.TallyParams
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
-	return tallyParams
+	return keeper.GetParams(ctx).TallyParams
 }
 
-// SetDepositParams sets DepositParams to the global param store
+// SetDepositParams sets DepositParams
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	params := keeper.GetParams(ctx)
+	params.DepositParams = depositParams
+	keeper.SetParams(ctx, params)
 }
 
-// SetVotingParams sets VotingParams to the global param store
+// SetVotingParams sets VotingParams
 func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingParams) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	params := keeper.GetParams(ctx)
+	params.VotingParams = votingParams
+	keeper.SetParams(ctx, params)
 }
 
-// SetTallyParams sets TallyParams to the global param store
+// SetTallyParams sets TallyParams
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	params := keeper.GetParams(ctx)
+	params.TallyParams = tallyParams
+	keeper.SetParams(ctx, params)
 }
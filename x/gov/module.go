@@ -173,6 +173,18 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the in-place store migrations of the gov module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -39,6 +39,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+	case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+		var paramsA, paramsB types.Params
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &paramsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &paramsB)
+		return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
 	default:
 		panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 	}
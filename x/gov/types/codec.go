@@ -20,6 +20,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgUpdateParams{}, "cosmos-sdk/MsgUpdateGovParams", nil)
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
 }
 
@@ -33,6 +34,17 @@ func RegisterProposalTypeCodec(o interface{}, name string) {
 	amino.RegisterConcrete(o, name, nil)
 }
 
+// RegisterProposalTypeInterface registers an interface used by the fields of an
+// external proposal content type for the internal ModuleCdc. The concrete
+// implementations of the interface must be registered with
+// RegisterProposalTypeCodec.
+//
+// NOTE: This should only be used for applications that are still using a concrete
+// Amino codec for serialization.
+func RegisterProposalTypeInterface(ptr interface{}) {
+	amino.RegisterInterface(ptr, nil)
+}
+
 var (
 	amino = codec.New()
 
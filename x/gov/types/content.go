@@ -26,6 +26,15 @@ type Content interface {
 	String() string
 }
 
+// ExecContent defines a proposal Content executing messages once the proposal
+// has passed. The messages are executed on behalf of the governance module
+// account, which must be their only signer.
+type ExecContent interface {
+	Content
+
+	GetMsgs() []sdk.Msg
+}
+
 // Handler defines a function that handles a proposal after it has passed the
 // governance process.
 type Handler func(ctx sdk.Context, content Content) error
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
@@ -11,6 +12,7 @@ import (
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
 }
 
 // SupplyKeeper defines the expected supply keeper for module accounts (noalias)
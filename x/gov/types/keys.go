@@ -18,6 +18,9 @@ const (
 	// RouterKey is the message route for gov
 	RouterKey = ModuleName
 
+	// ExecRouterKey is the proposal route for proposals executing messages
+	ExecRouterKey = "exec"
+
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
@@ -39,6 +42,8 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30: Params
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -48,6 +53,8 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	ParamsKey = []byte{0x30}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -12,9 +12,10 @@ const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgUpdateParams   = "update_params"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposalBase{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _ sdk.Msg = MsgSubmitProposalBase{}, MsgDeposit{}, MsgVote{}, MsgUpdateParams{}
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
 // implement in order to process governance proposals. The concrete MsgSubmitProposal
@@ -156,6 +157,44 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
 
+// NewMsgUpdateParams creates a message to replace the governance parameters on
+// behalf of the given authority
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) MsgUpdateParams {
+	return MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// Route implements Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// String implements the Stringer interface
+func (msg MsgUpdateParams) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
 // ---------------------------------------------------------------------------
 // Deprecated
 //
@@ -172,6 +211,8 @@ type MsgSubmitProposal struct {
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
 }
 
+var _ sdk.MsgWithNestedMsgs = MsgSubmitProposal{}
+
 // NewMsgSubmitProposal returns a (deprecated) MsgSubmitProposal message.
 //
 // TODO: Remove once client-side Protobuf migration has been completed.
@@ -213,3 +254,13 @@ func (msg MsgSubmitProposal) Type() string                 { return TypeMsgSubmi
 func (msg MsgSubmitProposal) GetContent() Content          { return msg.Content }
 func (msg MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return msg.InitialDeposit }
 func (msg MsgSubmitProposal) GetProposer() sdk.AccAddress  { return msg.Proposer }
+
+// GetNestedMsgs implements sdk.MsgWithNestedMsgs, returning the messages
+// executed by the content of the proposal if it is an ExecContent.
+func (msg MsgSubmitProposal) GetNestedMsgs() []sdk.Msg {
+	if c, ok := msg.Content.(ExecContent); ok {
+		return c.GetMsgs()
+	}
+
+	return nil
+}
@@ -115,3 +115,17 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	msg := NewMsgUpdateParams(addrs[0], DefaultParams())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgUpdateParams, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.Error(t, NewMsgUpdateParams(sdk.AccAddress{}, DefaultParams()).ValidateBasic())
+
+	invalid := DefaultParams()
+	invalid.TallyParams.Quorum = sdk.NewDec(2)
+	require.Error(t, NewMsgUpdateParams(addrs[0], invalid).ValidateBasic())
+}
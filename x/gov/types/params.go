@@ -32,17 +32,7 @@ var (
 
 // ParamKeyTable - Key declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
-		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
-		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
-	)
-}
-
-// DepositParams defines the params around deposits for governance
-type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewDepositParams creates a new DepositParams object
@@ -88,13 +78,6 @@ func validateDepositParams(i interface{}) error {
 	return nil
 }
 
-// TallyParams defines the params around Tallying votes in governance
-type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
-}
-
 // NewTallyParams creates a new TallyParams object
 func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
 	return TallyParams{
@@ -148,11 +131,6 @@ func validateTallyParams(i interface{}) error {
 	return nil
 }
 
-// VotingParams defines the params around Voting in governance
-type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
-}
-
 // NewVotingParams creates a new VotingParams object
 func NewVotingParams(votingPeriod time.Duration) VotingParams {
 	return VotingParams{
@@ -189,13 +167,6 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
-}
-
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
 		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
@@ -214,3 +185,30 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
 }
+
+// Equal checks equality of Params
+func (gp Params) Equal(other Params) bool {
+	return gp.VotingParams.Equal(other.VotingParams) && gp.TallyParams.Equal(other.TallyParams) &&
+		gp.DepositParams.Equal(other.DepositParams)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (gp *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, &gp.DepositParams, validateDepositParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, &gp.VotingParams, validateVotingParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, &gp.TallyParams, validateTallyParams),
+	}
+}
+
+// Validate validates the governance params
+func (gp Params) Validate() error {
+	if err := validateDepositParams(gp.DepositParams); err != nil {
+		return err
+	}
+	if err := validateVotingParams(gp.VotingParams); err != nil {
+		return err
+	}
+
+	return validateTallyParams(gp.TallyParams)
+}
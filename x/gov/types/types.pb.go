@@ -12,6 +12,7 @@ import (
 	github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -434,6 +435,204 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// MsgUpdateParams defines an sdk.Msg type for replacing the parameters of the
+// governance module. It must be signed by the module authority.
+type MsgUpdateParams struct {
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+	Params    Params                                        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()      { *m = MsgUpdateParams{} }
+func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// Params defines all the parameters of the governance module.
+type Params struct {
+	VotingParams  VotingParams  `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
+	TallyParams   TallyParams   `protobuf:"bytes,2,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	DepositParams DepositParams `protobuf:"bytes,3,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params" yaml:"deposit_parmas"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{9}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// DepositParams defines the params around deposits for governance
+type DepositParams struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"`
+}
+
+func (m *DepositParams) Reset()      { *m = DepositParams{} }
+func (*DepositParams) ProtoMessage() {}
+func (*DepositParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{10}
+}
+func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositParams.Merge(m, src)
+}
+func (m *DepositParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+
+// TallyParams defines the params around Tallying votes in governance
+type TallyParams struct {
+	// Minimum percentage of total stake needed to vote for a result to be considered valid
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty" yaml:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty" yaml:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Veto github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto,omitempty" yaml:"veto,omitempty"`
+}
+
+func (m *TallyParams) Reset()      { *m = TallyParams{} }
+func (*TallyParams) ProtoMessage() {}
+func (*TallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{11}
+}
+func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyParams.Merge(m, src)
+}
+func (m *TallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+
+// VotingParams defines the params around Voting in governance
+type VotingParams struct {
+	// Length of the voting period.
+	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period,omitempty"`
+}
+
+func (m *VotingParams) Reset()      { *m = VotingParams{} }
+func (*VotingParams) ProtoMessage() {}
+func (*VotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{12}
+}
+func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingParams.Merge(m, src)
+}
+func (m *VotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -445,89 +644,118 @@ func init() {
 	proto.RegisterType((*ProposalBase)(nil), "cosmos_sdk.x.gov.v1.ProposalBase")
 	proto.RegisterType((*TallyResult)(nil), "cosmos_sdk.x.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos_sdk.x.gov.v1.Vote")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos_sdk.x.gov.v1.MsgUpdateParams")
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.gov.v1.Params")
+	proto.RegisterType((*DepositParams)(nil), "cosmos_sdk.x.gov.v1.DepositParams")
+	proto.RegisterType((*TallyParams)(nil), "cosmos_sdk.x.gov.v1.TallyParams")
+	proto.RegisterType((*VotingParams)(nil), "cosmos_sdk.x.gov.v1.VotingParams")
 }
 
 func init() { proto.RegisterFile("x/gov/types/types.proto", fileDescriptor_a5ae5e91b5b3fb03) }
 
 var fileDescriptor_a5ae5e91b5b3fb03 = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x8f, 0xd3, 0x46,
-	0x14, 0x8e, 0xb3, 0xbf, 0xd8, 0x49, 0x36, 0x6b, 0x66, 0x29, 0x9b, 0xba, 0xaa, 0x6d, 0x02, 0x42,
-	0x2b, 0x04, 0x5e, 0x58, 0x0e, 0x55, 0xa9, 0x54, 0x35, 0x26, 0x06, 0x8c, 0xd8, 0x38, 0xb2, 0xcd,
-	0x22, 0x5a, 0x55, 0x96, 0x77, 0x6d, 0xbc, 0x2e, 0x8e, 0x27, 0xcd, 0xcc, 0xa6, 0xec, 0xad, 0xea,
-	0xa1, 0x42, 0x39, 0x71, 0xe4, 0x12, 0x09, 0xa9, 0x1c, 0x50, 0x4f, 0xfd, 0x33, 0xf6, 0x56, 0x0e,
-	0xad, 0x84, 0x7a, 0x08, 0x65, 0x39, 0xb4, 0xea, 0xa1, 0x07, 0x2e, 0x95, 0x7a, 0xaa, 0xe2, 0x19,
-	0xb3, 0x4e, 0x76, 0xe9, 0xb2, 0xa2, 0x95, 0xaa, 0x5e, 0x92, 0xf8, 0xf9, 0xfb, 0xbe, 0x37, 0xef,
-	0xcd, 0x9b, 0x6f, 0x14, 0x30, 0x7f, 0x67, 0x31, 0x40, 0x9d, 0x45, 0xb2, 0xd9, 0xf2, 0x31, 0xfd,
-	0x54, 0x5a, 0x6d, 0x44, 0x10, 0x9c, 0x5b, 0x43, 0xb8, 0x89, 0xb0, 0x83, 0xbd, 0xdb, 0xca, 0x1d,
-	0x25, 0x40, 0x1d, 0xa5, 0x73, 0x4e, 0x38, 0xbc, 0x0b, 0x27, 0x9c, 0x24, 0xeb, 0x61, 0xdb, 0x73,
-	0x5a, 0x6e, 0x9b, 0x6c, 0x2e, 0x26, 0xa1, 0xc5, 0x00, 0x05, 0x68, 0xe7, 0x17, 0xc3, 0x49, 0x01,
-	0x42, 0x41, 0xe4, 0x53, 0xc8, 0xea, 0xc6, 0xad, 0x45, 0x12, 0x36, 0x7d, 0x4c, 0xdc, 0x66, 0x8b,
-	0x02, 0x2a, 0x7f, 0x70, 0xe0, 0xad, 0x65, 0x1c, 0x58, 0x1b, 0xab, 0xcd, 0x90, 0x34, 0xda, 0xa8,
-	0x85, 0xb0, 0x1b, 0xa9, 0x2e, 0xf6, 0xe1, 0x5d, 0x0e, 0xcc, 0x86, 0x71, 0x48, 0x42, 0x37, 0x72,
-	0x3c, 0xbf, 0x85, 0x70, 0x48, 0xca, 0x9c, 0x3c, 0xb6, 0x50, 0x58, 0x9a, 0x53, 0x32, 0xab, 0xec,
-	0x9c, 0x53, 0x2e, 0xa2, 0x30, 0x56, 0xaf, 0x6e, 0xf5, 0xa5, 0xdc, 0x8b, 0xbe, 0x74, 0x74, 0xd3,
-	0x6d, 0x46, 0x17, 0x2a, 0x23, 0xcc, 0xca, 0xb7, 0x4f, 0xa5, 0x85, 0x20, 0x24, 0xeb, 0x1b, 0xab,
-	0xca, 0x1a, 0x6a, 0x2e, 0x52, 0x01, 0xf6, 0x75, 0x06, 0x7b, 0xb7, 0x59, 0x75, 0x03, 0x29, 0x6c,
-	0x96, 0x18, 0xbb, 0x46, 0xc9, 0x70, 0x19, 0x1c, 0x6a, 0x25, 0x4b, 0xf3, 0xdb, 0xe5, 0xbc, 0xcc,
-	0x2d, 0x14, 0xd5, 0x73, 0x7f, 0xf6, 0xa5, 0x33, 0xaf, 0xa1, 0x57, 0x5d, 0x5b, 0xab, 0x7a, 0x5e,
-	0xdb, 0xc7, 0xd8, 0x7c, 0x29, 0x71, 0x61, 0xfc, 0xd7, 0x07, 0x12, 0x57, 0xf9, 0x85, 0x03, 0x53,
-	0xcb, 0x38, 0x58, 0x41, 0xc4, 0x87, 0x36, 0x28, 0xb4, 0x58, 0xed, 0x4e, 0xe8, 0x95, 0x39, 0x99,
-	0x5b, 0x18, 0x57, 0xcf, 0x6f, 0xf7, 0x25, 0x90, 0xb6, 0x44, 0xaf, 0xfd, 0xd6, 0x97, 0xb2, 0xa0,
-	0x17, 0x7d, 0x09, 0xd2, 0x52, 0x33, 0xc1, 0x8a, 0x09, 0xd2, 0x27, 0xdd, 0x83, 0x97, 0xc1, 0x44,
-	0x07, 0x91, 0x37, 0x59, 0x33, 0xe5, 0xc3, 0xf7, 0xc0, 0x24, 0x6a, 0x91, 0x10, 0xc5, 0xe5, 0x31,
-	0x99, 0x5b, 0x28, 0x2d, 0x49, 0xca, 0x1e, 0x63, 0xa2, 0x0c, 0x2a, 0x31, 0x12, 0x98, 0xc9, 0xe0,
-	0xac, 0xd2, 0xfb, 0x79, 0x00, 0x96, 0x71, 0x90, 0x76, 0xf3, 0xdf, 0x29, 0xd6, 0x00, 0xd3, 0x6c,
-	0xaf, 0xd1, 0x1b, 0x14, 0xbc, 0xa3, 0x01, 0x3f, 0x05, 0x93, 0x6e, 0x13, 0x6d, 0xc4, 0xa4, 0x3c,
-	0xf6, 0xea, 0xa9, 0x3b, 0x3b, 0x98, 0xba, 0x03, 0xcd, 0x16, 0x13, 0x65, 0xad, 0xb9, 0x06, 0x8a,
-	0xb6, 0x7f, 0xe7, 0xe5, 0xe0, 0xc3, 0x23, 0x60, 0x82, 0x84, 0x24, 0xf2, 0x93, 0xae, 0x4c, 0x9b,
-	0xf4, 0x01, 0xca, 0xa0, 0xe0, 0xf9, 0x78, 0xad, 0x1d, 0xd2, 0x4d, 0xc8, 0x27, 0xef, 0xb2, 0x21,
-	0xa6, 0xf6, 0x75, 0x1e, 0x4c, 0xa5, 0x5d, 0xd6, 0xf6, 0xea, 0xf2, 0x89, 0xe1, 0x2e, 0xff, 0x6f,
-	0xdb, 0xfa, 0xfd, 0x24, 0x28, 0x0e, 0x99, 0x89, 0xba, 0x57, 0x37, 0x8e, 0xed, 0x9a, 0xb9, 0x7c,
-	0x32, 0x6a, 0xd3, 0xcc, 0x42, 0x46, 0x5a, 0x71, 0x03, 0x4c, 0x62, 0xe2, 0x92, 0x0d, 0x9c, 0xf4,
-	0xa1, 0xb4, 0x74, 0x7c, 0xcf, 0x53, 0x90, 0xea, 0x59, 0x09, 0x54, 0x15, 0x76, 0x2c, 0xe9, 0xe5,
-	0x02, 0xa8, 0x4a, 0xc5, 0x64, 0x72, 0xf0, 0x73, 0x00, 0x6f, 0x85, 0xb1, 0x1b, 0x39, 0xc4, 0x8d,
-	0xa2, 0x4d, 0xa7, 0xed, 0xe3, 0x8d, 0x88, 0x24, 0x47, 0xad, 0xb0, 0x24, 0xef, 0x99, 0xc4, 0x1e,
-	0x00, 0xcd, 0x04, 0xa7, 0x1e, 0x63, 0xc6, 0xf7, 0x36, 0xcd, 0xb2, 0x5b, 0xa9, 0x62, 0xf2, 0x49,
-	0x30, 0x43, 0x82, 0x9f, 0x80, 0x02, 0x4e, 0x2c, 0xd7, 0x19, 0x18, 0x72, 0x79, 0x3c, 0xc9, 0x25,
-	0x28, 0xd4, 0xad, 0x95, 0xd4, 0xad, 0x15, 0x3b, 0x75, 0x6b, 0x55, 0x64, 0x59, 0xd8, 0xbc, 0x64,
-	0xc8, 0x95, 0x7b, 0x4f, 0x25, 0xce, 0x04, 0x34, 0x32, 0x20, 0xc0, 0x10, 0xf0, 0x6c, 0xbf, 0x1d,
-	0x3f, 0xf6, 0x68, 0x86, 0x89, 0x7d, 0x33, 0x1c, 0x67, 0x19, 0xe6, 0x69, 0x86, 0x51, 0x05, 0x9a,
-	0xa6, 0xc4, 0xc2, 0x5a, 0xec, 0x25, 0xa9, 0xbe, 0xe2, 0xc0, 0x0c, 0x41, 0x24, 0x73, 0x45, 0x4c,
-	0xbe, 0x7a, 0xaa, 0xae, 0xb0, 0x0c, 0x47, 0x68, 0x86, 0x21, 0xde, 0xc1, 0x2e, 0x88, 0x62, 0xc2,
-	0x4d, 0x8f, 0x5a, 0x04, 0x0e, 0x77, 0x10, 0x09, 0xe3, 0x60, 0xb0, 0xb3, 0x6d, 0xd6, 0xd2, 0xa9,
-	0x7d, 0x0b, 0x3e, 0xc1, 0x96, 0x53, 0xa6, 0xcb, 0xd9, 0x25, 0x41, 0x2b, 0x9e, 0xa5, 0x71, 0x6b,
-	0x10, 0x4e, 0x4a, 0xbe, 0x05, 0x58, 0x68, 0xa7, 0xb9, 0x87, 0xf6, 0xcd, 0x55, 0x19, 0xbe, 0x1d,
-	0x47, 0x04, 0x68, 0xa6, 0x19, 0x1a, 0x65, 0xad, 0xbd, 0x50, 0xbc, 0xff, 0x40, 0xe2, 0x1e, 0x3d,
-	0x90, 0xb8, 0xe4, 0x44, 0x6d, 0xe5, 0x41, 0x21, 0x3b, 0x40, 0x1f, 0x81, 0xb1, 0x4d, 0x1f, 0x53,
-	0x9b, 0x52, 0x95, 0x81, 0xfa, 0x4f, 0x7d, 0xe9, 0xe4, 0x6b, 0x34, 0x50, 0x8f, 0x89, 0x39, 0xa0,
-	0xc2, 0x2b, 0x60, 0xca, 0x5d, 0xc5, 0xc4, 0x0d, 0x99, 0xa1, 0x1d, 0x58, 0x25, 0xa5, 0xc3, 0x0f,
-	0x41, 0x3e, 0x46, 0xc9, 0x79, 0x39, 0xb8, 0x48, 0x3e, 0x46, 0x30, 0x00, 0xc5, 0x18, 0x39, 0x5f,
-	0x84, 0x64, 0xdd, 0xe9, 0xf8, 0x04, 0x25, 0xa7, 0x61, 0x5a, 0xd5, 0x0e, 0xa6, 0xf4, 0xa2, 0x2f,
-	0xcd, 0xd1, 0xe6, 0x66, 0xb5, 0x2a, 0x26, 0x88, 0xd1, 0x8d, 0x90, 0xac, 0xaf, 0xf8, 0x04, 0x31,
-	0x73, 0xfa, 0x91, 0x03, 0xe3, 0xc9, 0xad, 0xff, 0x0f, 0x59, 0xf4, 0x7f, 0xe4, 0x9a, 0x3f, 0xf5,
-	0x3b, 0x07, 0xc0, 0xce, 0x4b, 0x78, 0x1a, 0xcc, 0xaf, 0x18, 0xb6, 0xe6, 0x18, 0x0d, 0x5b, 0x37,
-	0xea, 0xce, 0xf5, 0xba, 0xd5, 0xd0, 0x2e, 0xea, 0x97, 0x74, 0xad, 0xc6, 0xe7, 0x84, 0xd9, 0x6e,
-	0x4f, 0x2e, 0x50, 0xa0, 0xd6, 0x6c, 0x91, 0x4d, 0x58, 0x01, 0xb3, 0x59, 0xf4, 0x4d, 0xcd, 0xe2,
-	0x39, 0x61, 0xa6, 0xdb, 0x93, 0xa7, 0x29, 0xea, 0xa6, 0x8f, 0xe1, 0x29, 0x30, 0x97, 0xc5, 0x54,
-	0x55, 0xcb, 0xae, 0xea, 0x75, 0x3e, 0x2f, 0x1c, 0xee, 0xf6, 0xe4, 0x19, 0x8a, 0xab, 0xb2, 0x99,
-	0x90, 0x41, 0x29, 0x8b, 0xad, 0x1b, 0xfc, 0x98, 0x50, 0xec, 0xf6, 0xe4, 0x43, 0x14, 0x56, 0x47,
-	0x70, 0x09, 0x94, 0x87, 0x11, 0xce, 0x0d, 0xdd, 0xbe, 0xe2, 0xac, 0x68, 0xb6, 0xc1, 0x8f, 0x0b,
-	0x47, 0xba, 0x3d, 0x99, 0x4f, 0xb1, 0xe9, 0x06, 0x0a, 0xc5, 0xbb, 0xdf, 0x88, 0xb9, 0x47, 0x0f,
-	0xc5, 0xdc, 0x77, 0x0f, 0xc5, 0xdc, 0xa9, 0x1f, 0xf2, 0xa0, 0x34, 0x6c, 0xf7, 0x50, 0x01, 0xef,
-	0x34, 0x4c, 0xa3, 0x61, 0x58, 0xd5, 0x6b, 0x8e, 0x65, 0x57, 0xed, 0xeb, 0xd6, 0x48, 0xe1, 0x49,
-	0x49, 0x14, 0x5c, 0x0f, 0x23, 0xf8, 0x01, 0x10, 0x47, 0xf1, 0x35, 0xad, 0x61, 0x58, 0xba, 0xed,
-	0x34, 0x34, 0x53, 0x37, 0x6a, 0x3c, 0x27, 0xcc, 0x77, 0x7b, 0xf2, 0x1c, 0xa5, 0x30, 0xc7, 0x69,
-	0xf8, 0xed, 0x10, 0x79, 0xf0, 0x7d, 0xf0, 0xee, 0x28, 0x79, 0xc5, 0xb0, 0xf5, 0xfa, 0xe5, 0x94,
-	0x9b, 0x17, 0x8e, 0x76, 0x7b, 0x32, 0xa4, 0xdc, 0x95, 0xe4, 0x74, 0x33, 0xea, 0x69, 0x70, 0x74,
-	0x94, 0xda, 0xa8, 0x5a, 0x96, 0x56, 0xe3, 0xc7, 0x04, 0xbe, 0xdb, 0x93, 0x8b, 0x94, 0xd3, 0x70,
-	0x31, 0xf6, 0x3d, 0x78, 0x16, 0x94, 0x47, 0xd1, 0xa6, 0x76, 0x55, 0xbb, 0x68, 0x6b, 0x35, 0x7e,
-	0x5c, 0x80, 0xdd, 0x9e, 0x5c, 0xa2, 0x78, 0xd3, 0xff, 0xcc, 0x5f, 0x23, 0xfe, 0x9e, 0xfa, 0x97,
-	0xaa, 0xfa, 0x35, 0xad, 0xc6, 0x4f, 0x64, 0xf5, 0x2f, 0xb9, 0x61, 0xe4, 0x7b, 0xc3, 0x6d, 0x55,
-	0xeb, 0x5b, 0xcf, 0xc4, 0xdc, 0x93, 0x67, 0x62, 0xee, 0xcb, 0x6d, 0x31, 0xb7, 0xb5, 0x2d, 0x72,
-	0x8f, 0xb7, 0x45, 0xee, 0xe7, 0x6d, 0x91, 0xbb, 0xf7, 0x5c, 0xcc, 0x3d, 0x7e, 0x2e, 0xe6, 0x9e,
-	0x3c, 0x17, 0x73, 0x1f, 0xff, 0xbd, 0x59, 0x67, 0xfe, 0xdf, 0xac, 0x4e, 0x26, 0x7e, 0x78, 0xfe,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x53, 0x05, 0xd1, 0xf5, 0x0c, 0x00, 0x00,
+	// 1604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xc1, 0x6f, 0x13, 0xc7,
+	0x1a, 0xf7, 0x3a, 0x8e, 0x43, 0xc6, 0x8e, 0x63, 0x26, 0x40, 0x8c, 0x81, 0xdd, 0x65, 0x41, 0x28,
+	0x42, 0xe0, 0x40, 0x38, 0x3c, 0xc1, 0x93, 0x9e, 0x9e, 0x8d, 0x17, 0x30, 0x22, 0xb6, 0xb5, 0x36,
+	0x41, 0xbc, 0x27, 0xb4, 0x6f, 0xe3, 0x5d, 0xec, 0x7d, 0x78, 0x77, 0xcc, 0xee, 0xd8, 0x2f, 0xb9,
+	0xbd, 0x56, 0x6a, 0x85, 0x7c, 0xe2, 0x54, 0xe5, 0x62, 0x15, 0xa9, 0x1c, 0x50, 0x4f, 0x3d, 0xf7,
+	0x2f, 0xc8, 0xa1, 0x52, 0x39, 0xb4, 0x12, 0xea, 0xc1, 0x94, 0x70, 0x68, 0xc5, 0xa1, 0x87, 0x5c,
+	0x90, 0x7a, 0xaa, 0x76, 0x67, 0x36, 0xde, 0x75, 0x0c, 0xc1, 0xd0, 0x4a, 0x55, 0x2f, 0x10, 0xcf,
+	0xfc, 0xbe, 0xdf, 0xef, 0xfb, 0xbe, 0xf9, 0xe6, 0x37, 0x4e, 0xc0, 0xfc, 0xda, 0x62, 0x1d, 0x75,
+	0x16, 0xf1, 0x7a, 0x4b, 0xb3, 0xc9, 0xbf, 0x99, 0x96, 0x85, 0x30, 0x82, 0x73, 0x35, 0x64, 0x1b,
+	0xc8, 0x96, 0x6d, 0xf5, 0x5e, 0x66, 0x2d, 0x53, 0x47, 0x9d, 0x4c, 0xe7, 0x7c, 0x7a, 0xff, 0x2e,
+	0x5c, 0xfa, 0x14, 0x6e, 0xe8, 0x96, 0x2a, 0xb7, 0x14, 0x0b, 0xaf, 0x2f, 0xba, 0x4b, 0x8b, 0x75,
+	0x54, 0x47, 0x83, 0x9f, 0x28, 0x8e, 0xab, 0x23, 0x54, 0x6f, 0x6a, 0x04, 0xb2, 0xda, 0xbe, 0xbb,
+	0x88, 0x75, 0x43, 0xb3, 0xb1, 0x62, 0xb4, 0x28, 0x80, 0x1d, 0x06, 0xa8, 0x6d, 0x4b, 0xc1, 0x3a,
+	0x32, 0xc9, 0xbe, 0xf0, 0x9a, 0x01, 0x07, 0x97, 0xed, 0x7a, 0xa5, 0xbd, 0x6a, 0xe8, 0xb8, 0x6c,
+	0xa1, 0x16, 0xb2, 0x95, 0x66, 0x4e, 0xb1, 0x35, 0xf8, 0x80, 0x01, 0xb3, 0xba, 0xa9, 0x63, 0x5d,
+	0x69, 0xca, 0xaa, 0xd6, 0x42, 0xb6, 0x8e, 0x53, 0x0c, 0x3f, 0xb1, 0x10, 0x5b, 0x9a, 0xcb, 0xf8,
+	0xaa, 0xe8, 0x9c, 0xcf, 0x5c, 0x46, 0xba, 0x99, 0xbb, 0xbe, 0xd9, 0xe7, 0x42, 0xdb, 0x7d, 0xee,
+	0xd0, 0xba, 0x62, 0x34, 0x2f, 0x09, 0x43, 0x91, 0xc2, 0x97, 0xcf, 0xb9, 0x85, 0xba, 0x8e, 0x1b,
+	0xed, 0xd5, 0x4c, 0x0d, 0x19, 0x8b, 0x84, 0x80, 0xfe, 0x77, 0xd6, 0x56, 0xef, 0xd1, 0xea, 0x1d,
+	0x2a, 0x5b, 0x4a, 0xd0, 0xe8, 0x3c, 0x09, 0x86, 0xcb, 0x60, 0x5f, 0xcb, 0x4d, 0x4d, 0xb3, 0x52,
+	0x61, 0x9e, 0x59, 0x88, 0xe7, 0xce, 0xff, 0xda, 0xe7, 0xce, 0xbe, 0x03, 0x5f, 0xb6, 0x56, 0xcb,
+	0xaa, 0xaa, 0xa5, 0xd9, 0xb6, 0xb4, 0x43, 0x71, 0x29, 0xf2, 0xf3, 0x23, 0x8e, 0x11, 0x7e, 0x62,
+	0xc0, 0xd4, 0xb2, 0x5d, 0x5f, 0x41, 0x58, 0x83, 0x55, 0x10, 0x6b, 0xd1, 0xda, 0x65, 0x5d, 0x4d,
+	0x31, 0x3c, 0xb3, 0x10, 0xc9, 0x5d, 0xd8, 0xea, 0x73, 0xc0, 0x6b, 0x49, 0x21, 0xff, 0xaa, 0xcf,
+	0xf9, 0x41, 0xdb, 0x7d, 0x0e, 0x92, 0x52, 0x7d, 0x8b, 0x82, 0x04, 0xbc, 0x4f, 0x05, 0x15, 0x5e,
+	0x05, 0x93, 0x1d, 0x84, 0x3f, 0x24, 0x67, 0x12, 0x0f, 0xff, 0x06, 0xa2, 0xa8, 0xe5, 0x1c, 0x5a,
+	0x6a, 0x82, 0x67, 0x16, 0x12, 0x4b, 0x5c, 0x66, 0xc4, 0x18, 0x65, 0x9c, 0x4a, 0x4a, 0x2e, 0x4c,
+	0xa2, 0x70, 0x5a, 0xe9, 0x46, 0x18, 0x80, 0x65, 0xbb, 0xee, 0x75, 0xf3, 0x8f, 0x29, 0xb6, 0x04,
+	0xa6, 0xe9, 0x59, 0xa3, 0x0f, 0x28, 0x78, 0xc0, 0x01, 0xef, 0x80, 0xa8, 0x62, 0xa0, 0xb6, 0x89,
+	0x53, 0x13, 0x6f, 0x9e, 0xba, 0x73, 0xce, 0xd4, 0x8d, 0x35, 0x5b, 0x94, 0x94, 0xb6, 0xe6, 0x06,
+	0x88, 0x57, 0xb5, 0xb5, 0x9d, 0xc1, 0x87, 0x07, 0xc0, 0x24, 0xd6, 0x71, 0x53, 0x73, 0xbb, 0x32,
+	0x2d, 0x91, 0x0f, 0x90, 0x07, 0x31, 0x55, 0xb3, 0x6b, 0x96, 0x4e, 0x0e, 0x21, 0xec, 0xee, 0xf9,
+	0x97, 0x28, 0xdb, 0xa7, 0x61, 0x30, 0xe5, 0x75, 0x59, 0x1c, 0xd5, 0xe5, 0x93, 0xc1, 0x2e, 0xff,
+	0x65, 0xdb, 0xfa, 0x6d, 0x14, 0xc4, 0x03, 0x66, 0x92, 0x1b, 0xd5, 0x8d, 0xe3, 0xbb, 0x66, 0x2e,
+	0xec, 0x8e, 0xda, 0x34, 0xb5, 0x90, 0xa1, 0x56, 0xdc, 0x02, 0x51, 0x1b, 0x2b, 0xb8, 0x6d, 0xbb,
+	0x7d, 0x48, 0x2c, 0x9d, 0x18, 0x79, 0x0b, 0x3c, 0xbe, 0x8a, 0x0b, 0xcd, 0xa5, 0x07, 0x96, 0xb4,
+	0x93, 0x00, 0x61, 0x11, 0x24, 0x4a, 0x07, 0xef, 0x03, 0x78, 0x57, 0x37, 0x95, 0xa6, 0x8c, 0x95,
+	0x66, 0x73, 0x5d, 0xb6, 0x34, 0xbb, 0xdd, 0xc4, 0xee, 0x55, 0x8b, 0x2d, 0xf1, 0x23, 0x45, 0xaa,
+	0x0e, 0x50, 0x72, 0x71, 0xb9, 0xe3, 0xd4, 0xf8, 0x0e, 0x13, 0x95, 0xdd, 0x4c, 0x82, 0x94, 0x74,
+	0x17, 0x7d, 0x41, 0xf0, 0xdf, 0x20, 0x66, 0xbb, 0x96, 0x2b, 0x3b, 0x86, 0x9d, 0x8a, 0xb8, 0x5a,
+	0xe9, 0x0c, 0x31, 0xeb, 0x8c, 0x67, 0xd6, 0x99, 0xaa, 0xe7, 0xe6, 0x39, 0x96, 0xaa, 0xd0, 0x79,
+	0xf1, 0x05, 0x0b, 0x0f, 0x9f, 0x73, 0x8c, 0x04, 0xc8, 0x8a, 0x13, 0x00, 0x75, 0x90, 0xa4, 0xe7,
+	0x2d, 0x6b, 0xa6, 0x4a, 0x14, 0x26, 0xf7, 0x54, 0x38, 0x41, 0x15, 0xe6, 0x89, 0xc2, 0x30, 0x03,
+	0x91, 0x49, 0xd0, 0x65, 0xd1, 0x54, 0x5d, 0xa9, 0x8f, 0x19, 0x30, 0x83, 0x11, 0xf6, 0x3d, 0x11,
+	0xd1, 0x37, 0x4f, 0xd5, 0x35, 0xaa, 0x70, 0x80, 0x28, 0x04, 0xe2, 0xc6, 0x7b, 0x20, 0xe2, 0x6e,
+	0xac, 0x77, 0xd5, 0x9a, 0x60, 0x7f, 0x07, 0x61, 0xdd, 0xac, 0x3b, 0x27, 0x6b, 0xd1, 0x96, 0x4e,
+	0xed, 0x59, 0xf0, 0x49, 0x9a, 0x4e, 0x8a, 0xa4, 0xb3, 0x8b, 0x82, 0x54, 0x3c, 0x4b, 0xd6, 0x2b,
+	0xce, 0xb2, 0x5b, 0xf2, 0x5d, 0x40, 0x97, 0x06, 0xcd, 0xdd, 0xb7, 0xa7, 0x96, 0x10, 0x7c, 0x1d,
+	0x87, 0x08, 0x88, 0xd2, 0x0c, 0x59, 0xa5, 0xad, 0xbd, 0x14, 0xdf, 0x78, 0xc4, 0x31, 0x4f, 0x1e,
+	0x71, 0x8c, 0x7b, 0xa3, 0x36, 0xc3, 0x20, 0xe6, 0x1f, 0xa0, 0x7f, 0x82, 0x89, 0x75, 0xcd, 0x26,
+	0x36, 0x95, 0xcb, 0x38, 0xec, 0x3f, 0xf4, 0xb9, 0x53, 0xef, 0xd0, 0xc0, 0x82, 0x89, 0x25, 0x27,
+	0x14, 0x5e, 0x03, 0x53, 0xca, 0xaa, 0x8d, 0x15, 0x9d, 0x1a, 0xda, 0xd8, 0x2c, 0x5e, 0x38, 0xfc,
+	0x07, 0x08, 0x9b, 0x28, 0x35, 0xf1, 0x5e, 0x24, 0x61, 0x13, 0xc1, 0x3a, 0x88, 0x9b, 0x48, 0xfe,
+	0x9f, 0x8e, 0x1b, 0x72, 0x47, 0xc3, 0xc8, 0xbd, 0x0d, 0xd3, 0x39, 0x71, 0x3c, 0xa6, 0xed, 0x3e,
+	0x37, 0x47, 0x9a, 0xeb, 0xe7, 0x12, 0x24, 0x60, 0xa2, 0x5b, 0x3a, 0x6e, 0xac, 0x68, 0x18, 0x51,
+	0x73, 0xfa, 0x9e, 0x01, 0x11, 0xf7, 0xd5, 0xff, 0x9d, 0x2c, 0xfa, 0xcf, 0xf2, 0xcc, 0xf7, 0x18,
+	0x30, 0xbb, 0x6c, 0xd7, 0x6f, 0xb6, 0x54, 0x05, 0x6b, 0x65, 0xc5, 0x52, 0x0c, 0xdb, 0x79, 0x3e,
+	0x94, 0x36, 0x6e, 0x20, 0x4b, 0xc7, 0xeb, 0x29, 0xe6, 0x7d, 0xf3, 0x1b, 0x70, 0xc0, 0x8b, 0x20,
+	0xda, 0x72, 0xa9, 0xdd, 0x6a, 0x63, 0x4b, 0x47, 0x46, 0x9b, 0xb0, 0x0b, 0xc9, 0x45, 0x9c, 0x23,
+	0x94, 0x68, 0x80, 0xf0, 0x75, 0x18, 0x44, 0x69, 0x5a, 0x2a, 0xa0, 0xc3, 0x2e, 0x53, 0x32, 0xc6,
+	0x25, 0x3b, 0xfe, 0xa6, 0x82, 0x75, 0xb3, 0x4e, 0x29, 0x8f, 0x06, 0x3d, 0x24, 0xc0, 0x22, 0x48,
+	0xf1, 0x8e, 0x0f, 0x0b, 0xff, 0x03, 0xe2, 0xc4, 0x87, 0x03, 0x19, 0xbf, 0xc5, 0xd1, 0xa9, 0xc6,
+	0x11, 0xaa, 0x41, 0xe7, 0xc9, 0xcf, 0x21, 0x48, 0x31, 0x3c, 0x40, 0xc2, 0x06, 0xf0, 0x0c, 0xd1,
+	0xd3, 0x20, 0xaf, 0x86, 0x30, 0x52, 0x83, 0xfa, 0x15, 0x55, 0x39, 0x46, 0x55, 0x0e, 0x06, 0xfd,
+	0xb6, 0xa5, 0x58, 0x86, 0x62, 0x0b, 0xd2, 0x8c, 0xea, 0x47, 0x0b, 0xdf, 0x84, 0xc1, 0x4c, 0x20,
+	0x1e, 0x7e, 0xce, 0x80, 0x98, 0xa1, 0x9b, 0xef, 0xf2, 0xdd, 0x5c, 0x77, 0xa4, 0x5e, 0xf5, 0xb9,
+	0x83, 0x3e, 0xfc, 0x19, 0x64, 0xe8, 0x58, 0x33, 0x5a, 0x78, 0x7d, 0xbb, 0xcf, 0x1d, 0x25, 0x39,
+	0x8c, 0xdc, 0x1e, 0xcf, 0x99, 0x81, 0xa1, 0x9b, 0x9e, 0x2f, 0x6f, 0x30, 0x00, 0x1a, 0xca, 0x9a,
+	0xbc, 0x53, 0x9a, 0x66, 0xe9, 0x48, 0xa5, 0xc7, 0x70, 0x78, 0x97, 0x5b, 0xe6, 0xe9, 0x6f, 0x26,
+	0xb9, 0x12, 0x4d, 0xf7, 0xe8, 0xee, 0xe0, 0x40, 0xd6, 0x27, 0x68, 0xd6, 0x6f, 0x41, 0x09, 0x1b,
+	0x8e, 0xb3, 0x26, 0x0d, 0x65, 0xcd, 0x6b, 0x9e, 0x0b, 0x10, 0x5e, 0x7b, 0x76, 0x4a, 0x9b, 0xd9,
+	0x06, 0xd1, 0xfb, 0x6d, 0x64, 0xb5, 0x0d, 0xea, 0xa8, 0x77, 0xc6, 0x30, 0x9f, 0xbc, 0x56, 0x7b,
+	0xd5, 0xe7, 0x92, 0x24, 0x3e, 0x90, 0x20, 0x7d, 0x4a, 0x87, 0x77, 0x04, 0x89, 0x8a, 0xc1, 0x8f,
+	0x18, 0x30, 0x8d, 0x1b, 0x96, 0x66, 0x37, 0x50, 0x53, 0xa5, 0x36, 0x5c, 0x1b, 0x5b, 0x7a, 0x6e,
+	0x87, 0x22, 0xa0, 0x9e, 0xa6, 0xe3, 0xbb, 0x7b, 0x53, 0x90, 0x06, 0xaa, 0xd0, 0x00, 0x11, 0xd7,
+	0x75, 0x89, 0x7f, 0xdf, 0x1e, 0x5b, 0x3d, 0xe1, 0x44, 0x07, 0x84, 0xe9, 0x44, 0x07, 0xd7, 0x05,
+	0xc9, 0x95, 0x11, 0x3e, 0x63, 0x40, 0xdc, 0x7f, 0xa3, 0xe1, 0x27, 0xcc, 0xc0, 0x0c, 0xc8, 0x80,
+	0x30, 0x7b, 0x0d, 0x88, 0x48, 0x07, 0x64, 0x3e, 0x10, 0x17, 0xc8, 0x81, 0x0d, 0xfa, 0xc3, 0xc8,
+	0xb1, 0xf0, 0xdc, 0xc2, 0xdd, 0x3c, 0xfd, 0x0b, 0x03, 0xc0, 0xc0, 0x5b, 0xe1, 0x19, 0x30, 0xbf,
+	0x52, 0xaa, 0x8a, 0x72, 0xa9, 0x5c, 0x2d, 0x94, 0x8a, 0xf2, 0xcd, 0x62, 0xa5, 0x2c, 0x5e, 0x2e,
+	0x5c, 0x29, 0x88, 0xf9, 0x64, 0x28, 0x3d, 0xdb, 0xed, 0xf1, 0x31, 0x02, 0x14, 0x1d, 0x3e, 0x28,
+	0x80, 0x59, 0x3f, 0xfa, 0xb6, 0x58, 0x49, 0x32, 0xe9, 0x99, 0x6e, 0x8f, 0x9f, 0x26, 0xa8, 0xdb,
+	0x9a, 0x0d, 0x4f, 0x83, 0x39, 0x3f, 0x26, 0x9b, 0xab, 0x54, 0xb3, 0x85, 0x62, 0x32, 0x9c, 0xde,
+	0xdf, 0xed, 0xf1, 0x33, 0x04, 0x97, 0xa5, 0x4f, 0x2a, 0x0f, 0x12, 0x7e, 0x6c, 0xb1, 0x94, 0x9c,
+	0x48, 0xc7, 0xbb, 0x3d, 0x7e, 0x1f, 0x81, 0x15, 0x11, 0x5c, 0x02, 0xa9, 0x20, 0x42, 0xbe, 0x55,
+	0xa8, 0x5e, 0x93, 0x57, 0xc4, 0x6a, 0x29, 0x19, 0x49, 0x1f, 0xe8, 0xf6, 0xf8, 0xa4, 0x87, 0xf5,
+	0xde, 0xbf, 0x74, 0xfc, 0xc1, 0x17, 0x6c, 0xe8, 0xc9, 0x63, 0x36, 0xf4, 0xd5, 0x63, 0x36, 0x74,
+	0xfa, 0xbb, 0x30, 0x48, 0x04, 0xbf, 0x2d, 0xc3, 0x0c, 0x38, 0x52, 0x96, 0x4a, 0xe5, 0x52, 0x25,
+	0x7b, 0x43, 0xae, 0x54, 0xb3, 0xd5, 0x9b, 0x95, 0xa1, 0xc2, 0xdd, 0x92, 0x08, 0xb8, 0xa8, 0x37,
+	0xe1, 0xdf, 0x01, 0x3b, 0x8c, 0xcf, 0x8b, 0xe5, 0x52, 0xa5, 0x50, 0x95, 0xcb, 0xa2, 0x54, 0x28,
+	0xe5, 0x93, 0x4c, 0x7a, 0xbe, 0xdb, 0xe3, 0xe7, 0x48, 0x48, 0xe0, 0x0e, 0xc2, 0x8b, 0xe0, 0xd8,
+	0x70, 0xf0, 0x4a, 0xa9, 0x5a, 0x28, 0x5e, 0xf5, 0x62, 0xc3, 0xe9, 0x43, 0xdd, 0x1e, 0x0f, 0x49,
+	0xec, 0x8a, 0xef, 0xac, 0xe0, 0x19, 0x70, 0x68, 0x38, 0xb4, 0x9c, 0xad, 0x54, 0xc4, 0x7c, 0x72,
+	0x22, 0x9d, 0xec, 0xf6, 0xf8, 0x38, 0x89, 0x29, 0x2b, 0xb6, 0xad, 0xa9, 0xf0, 0x1c, 0x48, 0x0d,
+	0xa3, 0x25, 0xf1, 0xba, 0x78, 0xb9, 0x2a, 0xe6, 0x93, 0x91, 0x34, 0xec, 0xf6, 0xf8, 0x04, 0xc1,
+	0x4b, 0xda, 0x7f, 0xb5, 0x1a, 0xd6, 0x46, 0xf2, 0x5f, 0xc9, 0x16, 0x6e, 0x88, 0xf9, 0xe4, 0xa4,
+	0x9f, 0xff, 0x8a, 0xa2, 0x37, 0x35, 0x35, 0xd8, 0xd6, 0x5c, 0x71, 0xf3, 0x05, 0x1b, 0x7a, 0xf6,
+	0x82, 0x0d, 0xfd, 0x7f, 0x8b, 0x0d, 0x6d, 0x6e, 0xb1, 0xcc, 0xd3, 0x2d, 0x96, 0xf9, 0x71, 0x8b,
+	0x65, 0x1e, 0xbe, 0x64, 0x43, 0x4f, 0x5f, 0xb2, 0xa1, 0x67, 0x2f, 0xd9, 0xd0, 0xbf, 0xde, 0xee,
+	0xa8, 0xbe, 0x3f, 0x1f, 0xad, 0x46, 0xdd, 0xf9, 0xbf, 0xf0, 0xdb, 0x00, 0xbd, 0x24, 0xbf, 0x11,
+	0x54, 0x12, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposalBase) Equal(that interface{}) bool {
@@ -1279,80 +1507,302 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitProposalBase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InitialDeposit) > 0 {
-		for _, e := range m.InitialDeposit {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTypes(uint64(m.ProposalID))
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if m.Option != 0 {
-		n += 1 + sovTypes(uint64(m.Option))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalID != 0 {
-		n += 1 + sovTypes(uint64(m.ProposalID))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err10 != nil {
+		return 0, err10
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TextProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Veto.Size()
+		i -= size
+		if _, err := m.Veto.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitProposalBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TextProposal) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1456,6 +1906,79 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingParams.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.DepositParams.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Veto.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2709,6 +3232,639 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Veto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Veto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,67 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+)
+
+func TestBeginBlockerFixedInflationModel(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	params := mint.DefaultParams()
+	params.InflationModel = mint.InflationModelFixed
+	params.BlockProvision = sdk.NewInt(100)
+	app.MintKeeper.SetParams(ctx, params)
+	app.MintKeeper.SetMinter(ctx, mint.DefaultInitialMinter())
+
+	feeCollector := app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+
+	mint.BeginBlocker(ctx, app.MintKeeper, mint.DefaultInflationCalculationFn)
+
+	feesAfter := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+	require.Equal(t, sdk.NewInt(100), feesAfter.Sub(feesBefore))
+
+	// the querier reports the annual provisions of the fixed model
+	querier := mint.NewQuerier(app.MintKeeper)
+	res, err := querier(ctx, []string{mint.QueryAnnualProvisions}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var annualProvisions sdk.Dec
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &annualProvisions))
+	require.Equal(t, sdk.NewDec(int64(params.BlocksPerYear)*100), annualProvisions)
+}
+
+func TestBeginBlockerMaxSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	simapp.AddTestAddrs(app, ctx, 1, sdk.TokensFromConsensusPower(1000))
+
+	params := mint.DefaultParams()
+	params.MaxSupply = app.StakingKeeper.StakingTokenSupply(ctx)
+	app.MintKeeper.SetParams(ctx, params)
+	app.MintKeeper.SetMinter(ctx, mint.DefaultInitialMinter())
+
+	uncapped := mint.DefaultInflationCalculationFn(
+		ctx, mint.DefaultInitialMinter(), params, app.MintKeeper.BondedRatio(ctx), params.MaxSupply,
+	)
+	mint.BeginBlocker(ctx, app.MintKeeper, mint.DefaultInflationCalculationFn)
+
+	// no tokens are minted once the supply reached the cap
+	require.Equal(t, params.MaxSupply, app.StakingKeeper.StakingTokenSupply(ctx))
+
+	// only the provisions are capped, the inflation of the model is kept
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.True(t, minter.AnnualProvisions.IsZero())
+	require.True(t, uncapped.AnnualProvisions.IsPositive())
+	require.Equal(t, uncapped.Inflation, minter.Inflation)
+}
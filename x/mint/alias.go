@@ -8,39 +8,57 @@ import (
 )
 
 const (
-	ModuleName            = types.ModuleName
-	DefaultParamspace     = types.DefaultParamspace
-	StoreKey              = types.StoreKey
-	QuerierRoute          = types.QuerierRoute
-	QueryParameters       = types.QueryParameters
-	QueryInflation        = types.QueryInflation
-	QueryAnnualProvisions = types.QueryAnnualProvisions
+	ModuleName               = types.ModuleName
+	DefaultParamspace        = types.DefaultParamspace
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	QueryParameters          = types.QueryParameters
+	QueryInflation           = types.QueryInflation
+	QueryAnnualProvisions    = types.QueryAnnualProvisions
+	InflationModelGoalBonded = types.InflationModelGoalBonded
+	InflationModelFixed      = types.InflationModelFixed
+	InflationModelHalving    = types.InflationModelHalving
+	TypeMsgUpdateParams      = types.TypeMsgUpdateParams
+	AttributeValueCategory   = types.AttributeValueCategory
 )
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
-	NewGenesisState      = types.NewGenesisState
-	DefaultGenesisState  = types.DefaultGenesisState
-	ValidateGenesis      = types.ValidateGenesis
-	NewMinter            = types.NewMinter
-	InitialMinter        = types.InitialMinter
-	DefaultInitialMinter = types.DefaultInitialMinter
-	ValidateMinter       = types.ValidateMinter
-	ParamKeyTable        = types.ParamKeyTable
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
+	NewKeeper                        = keeper.NewKeeper
+	RegisterCodec                    = types.RegisterCodec
+	NewMsgUpdateParams               = types.NewMsgUpdateParams
+	NewQuerier                       = keeper.NewQuerier
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+	ValidateGenesis                  = types.ValidateGenesis
+	NewMinter                        = types.NewMinter
+	InitialMinter                    = types.InitialMinter
+	DefaultInitialMinter             = types.DefaultInitialMinter
+	ValidateMinter                   = types.ValidateMinter
+	ParamKeyTable                    = types.ParamKeyTable
+	NewParams                        = types.NewParams
+	DefaultParams                    = types.DefaultParams
+	DefaultInflationCalculationFn    = types.DefaultInflationCalculationFn
+	GoalBondedInflationCalculationFn = types.GoalBondedInflationCalculationFn
+	FixedInflationCalculationFn      = types.FixedInflationCalculationFn
+	HalvingInflationCalculationFn    = types.HalvingInflationCalculationFn
+	MinterFromBlockProvision         = types.MinterFromBlockProvision
 
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
 	MinterKey              = types.MinterKey
+	ParamsKey              = types.ParamsKey
 	KeyMintDenom           = types.KeyMintDenom
 	KeyInflationRateChange = types.KeyInflationRateChange
 	KeyInflationMax        = types.KeyInflationMax
 	KeyInflationMin        = types.KeyInflationMin
 	KeyGoalBonded          = types.KeyGoalBonded
 	KeyBlocksPerYear       = types.KeyBlocksPerYear
+	KeyInflationModel      = types.KeyInflationModel
+	KeyBlockProvision      = types.KeyBlockProvision
+	KeyHalvingInterval     = types.KeyHalvingInterval
+	KeyMaxSupply           = types.KeyMaxSupply
 )
 
 type (
@@ -48,4 +66,8 @@ type (
 	GenesisState = types.GenesisState
 	Minter       = types.Minter
 	Params       = types.Params
+
+	MsgUpdateParams = types.MsgUpdateParams
+
+	InflationCalculationFn = types.InflationCalculationFn
 )
@@ -7,25 +7,38 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/minting/parameters",
 		queryParamsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current minting parameter values",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Params{},
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/minting/inflation",
 		queryInflationHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current minting inflation value",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.Dec{},
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/minting/annual-provisions",
 		queryAnnualProvisionsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current minting annual provisions value",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.Dec{},
+	})
 }
 
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -19,12 +20,15 @@ type Keeper struct {
 	sk               types.StakingKeeper
 	supplyKeeper     types.SupplyKeeper
 	feeCollectorName string
+	authority        sdk.AccAddress
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The authority is the only
+// address allowed to update the module parameters through MsgUpdateParams.
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+	authority sdk.AccAddress,
 ) Keeper {
 
 	// ensure mint module account is set
@@ -39,6 +43,7 @@ func NewKeeper(
 		sk:               sk,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
@@ -70,15 +75,60 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 
 //______________________________________________________________________
 
-// GetParams returns the total set of minting parameters.
+// GetAuthority returns the address allowed to update the minting parameters.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+// paramStore returns the store of the minting parameters.
+func (k Keeper) paramStore() paramtypes.ModuleParamStore {
+	return paramtypes.NewModuleParamStore(k.cdc, k.storeKey, types.ParamsKey, k.paramSpace, func() paramtypes.ModuleParamSet {
+		return &types.Params{}
+	})
+}
+
+// GetParams returns the total set of minting parameters. Parameters that have
+// not been migrated to the module store yet are read from the legacy param
+// space.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramStore().Get(ctx, &params)
 	return params
 }
 
-// SetParams sets the total set of minting parameters.
+// SetParams sets the total set of minting parameters in the module store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	k.paramStore().Set(ctx, &params)
+}
+
+// UpdateParams validates and sets the minting parameters on behalf of the
+// given authority, which must be the authority of the module.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, params types.Params) error {
+	if !authority.Equals(k.authority) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", k.authority, authority)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateParams copies the minting parameters from the legacy x/params
+// subspace to the module store.
+func (k Keeper) MigrateParams(ctx sdk.Context) error {
+	return k.paramStore().Migrate(ctx)
+}
+
+// GetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) GetParamSet(ctx sdk.Context) paramtypes.ParamSet {
+	return k.paramStore().GetParamSet(ctx)
+}
+
+// SetParamSet implements the x/params ParamSetStore interface.
+func (k Keeper) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) error {
+	return k.paramStore().SetParamSet(ctx, ps)
 }
 
 //______________________________________________________________________
@@ -0,0 +1,25 @@
+package v039
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// MigrateInflationModels sets the inflation model and supply cap minting
+// params. The goal bonded inflation model is selected and the supply cap is
+// disabled, so minting remains unchanged until governance changes them.
+func MigrateInflationModels(ctx sdk.Context, k keeper.Keeper) error {
+	params := k.GetParams(ctx)
+	params.InflationModel = types.InflationModelGoalBonded
+	params.BlockProvision = sdk.ZeroInt()
+	params.HalvingInterval = types.DefaultParams().HalvingInterval
+	params.MaxSupply = sdk.ZeroInt()
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
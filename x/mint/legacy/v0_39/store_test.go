@@ -0,0 +1,39 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v039mint "github.com/cosmos/cosmos-sdk/x/mint/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMigrateInflationModels(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// params stored in the subspace before the inflation models existed
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyMintDenom, sdk.DefaultBondDenom)
+	subspace.Set(ctx, types.KeyInflationRateChange, sdk.NewDecWithPrec(13, 2))
+	subspace.Set(ctx, types.KeyInflationMax, sdk.NewDecWithPrec(20, 2))
+	subspace.Set(ctx, types.KeyInflationMin, sdk.NewDecWithPrec(7, 2))
+	subspace.Set(ctx, types.KeyGoalBonded, sdk.NewDecWithPrec(67, 2))
+	subspace.Set(ctx, types.KeyBlocksPerYear, uint64(1000))
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	require.NoError(t, app.MintKeeper.MigrateParams(ctx))
+	require.NoError(t, v039mint.MigrateInflationModels(ctx, app.MintKeeper))
+
+	params := app.MintKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, uint64(1000), params.BlocksPerYear)
+	require.Equal(t, types.InflationModelGoalBonded, params.InflationModel)
+	require.True(t, params.BlockProvision.IsZero())
+	require.True(t, params.MaxSupply.IsZero())
+	require.Equal(t, types.DefaultParams().HalvingInterval, params.HalvingInterval)
+}
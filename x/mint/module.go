@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/mint/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/mint/client/rest"
+	v039 "github.com/cosmos/cosmos-sdk/x/mint/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/mint/simulation"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 )
@@ -39,7 +40,9 @@ func (AppModuleBasic) Name() string {
 }
 
 // RegisterCodec registers the mint module's types for the given codec.
-func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {}
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
 
 // DefaultGenesis returns default genesis state as raw bytes for the mint
 // module.
@@ -78,14 +81,24 @@ type AppModule struct {
 
 	keeper       Keeper
 	supplyKeeper types.SupplyKeeper
+
+	// inflationCalculator is used to calculate the inflation rate during BeginBlock.
+	inflationCalculator types.InflationCalculationFn
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper) AppModule {
+// NewAppModule creates a new AppModule object. If the InflationCalculationFn
+// argument is nil, then the SDK's default inflation function, which uses the
+// model selected by the InflationModel param, will be used.
+func NewAppModule(keeper Keeper, supplyKeeper types.SupplyKeeper, ic types.InflationCalculationFn) AppModule {
+	if ic == nil {
+		ic = types.DefaultInflationCalculationFn
+	}
+
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{},
-		keeper:         keeper,
-		supplyKeeper:   supplyKeeper,
+		AppModuleBasic:      AppModuleBasic{},
+		keeper:              keeper,
+		supplyKeeper:        supplyKeeper,
+		inflationCalculator: ic,
 	}
 }
 
@@ -98,10 +111,10 @@ func (AppModule) Name() string {
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // Route returns the message routing key for the mint module.
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return RouterKey }
 
 // NewHandler returns an sdk.Handler for the mint module.
-func (am AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler { return NewHandler(am.keeper) }
 
 // QuerierRoute returns the mint module's querier route name.
 func (AppModule) QuerierRoute() string {
@@ -133,7 +146,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	BeginBlocker(ctx, am.keeper)
+	BeginBlocker(ctx, am.keeper, am.inflationCalculator)
 }
 
 // EndBlock returns the end blocker for the mint module. It returns no validator
@@ -142,6 +155,27 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// RegisterMigrations registers the in-place store migrations of the mint
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 adds the inflation model and supply cap params
+	err = cfg.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return v039.MigrateInflationModels(ctx, am.keeper)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
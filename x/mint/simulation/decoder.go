@@ -18,6 +18,11 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &minterA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &minterB)
 		return fmt.Sprintf("%v\n%v", minterA, minterB)
+	case bytes.Equal(kvA.Key, types.ParamsKey):
+		var paramsA, paramsB types.Params
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &paramsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &paramsB)
+		return fmt.Sprintf("%v\n%v", paramsA, paramsB)
 	default:
 		panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 	}
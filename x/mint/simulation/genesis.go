@@ -83,7 +83,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(
+		mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear,
+		types.InflationModelGoalBonded, sdk.ZeroInt(), blocksPerYear*4, sdk.ZeroInt(),
+	)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
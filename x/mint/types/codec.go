@@ -4,6 +4,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
+// RegisterCodec registers the necessary x/mint interfaces and concrete types
+// on the provided Amino codec. These types are used for Amino JSON serialization.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgUpdateParams{}, "cosmos-sdk/MsgUpdateMintParams", nil)
+}
+
 var (
 	amino = codec.New()
 
@@ -17,6 +23,7 @@ var (
 )
 
 func init() {
+	RegisterCodec(amino)
 	codec.RegisterCrypto(amino)
 	amino.Seal()
 }
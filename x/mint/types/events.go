@@ -4,6 +4,8 @@ package types
 const (
 	EventTypeMint = ModuleName
 
+	AttributeValueCategory = ModuleName
+
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Inflation models selectable through the InflationModel param
+const (
+	// InflationModelGoalBonded moves the inflation rate towards the GoalBonded
+	// ratio within InflationMin and InflationMax.
+	InflationModelGoalBonded = "goal_bonded"
+	// InflationModelFixed mints BlockProvision tokens every block.
+	InflationModelFixed = "fixed"
+	// InflationModelHalving mints BlockProvision tokens every block, halved
+	// every HalvingInterval blocks.
+	InflationModelHalving = "halving"
+)
+
+// InflationCalculationFn defines the function required to calculate the
+// inflation rate and annual provisions of the minter for the next block.
+type InflationCalculationFn func(
+	ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int,
+) Minter
+
+// DefaultInflationCalculationFn is the default function used to calculate the
+// minter for the next block. It uses the model selected by the InflationModel
+// param.
+func DefaultInflationCalculationFn(
+	ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int,
+) Minter {
+	switch params.InflationModel {
+	case InflationModelFixed:
+		return FixedInflationCalculationFn(ctx, minter, params, bondedRatio, totalSupply)
+
+	case InflationModelHalving:
+		return HalvingInflationCalculationFn(ctx, minter, params, bondedRatio, totalSupply)
+
+	default:
+		return GoalBondedInflationCalculationFn(ctx, minter, params, bondedRatio, totalSupply)
+	}
+}
+
+// GoalBondedInflationCalculationFn calculates the minter for the next block
+// according to the bonded ratio.
+func GoalBondedInflationCalculationFn(
+	_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int,
+) Minter {
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+	return minter
+}
+
+// FixedInflationCalculationFn calculates the minter for the next block so that
+// BlockProvision tokens are minted.
+func FixedInflationCalculationFn(
+	_ sdk.Context, _ Minter, params Params, _ sdk.Dec, totalSupply sdk.Int,
+) Minter {
+	return MinterFromBlockProvision(params, params.BlockProvision, totalSupply)
+}
+
+// HalvingInflationCalculationFn calculates the minter for the next block so that
+// BlockProvision tokens are minted, halved once for every HalvingInterval blocks
+// since genesis.
+func HalvingInflationCalculationFn(
+	ctx sdk.Context, _ Minter, params Params, _ sdk.Dec, totalSupply sdk.Int,
+) Minter {
+	halvings := uint64(ctx.BlockHeight()) / params.HalvingInterval
+	provision := sdk.NewIntFromBigInt(new(big.Int).Rsh(params.BlockProvision.BigInt(), uint(halvings)))
+
+	return MinterFromBlockProvision(params, provision, totalSupply)
+}
+
+// MinterFromBlockProvision returns a Minter whose annual provisions mint the
+// given amount of tokens every block.
+func MinterFromBlockProvision(params Params, blockProvision, totalSupply sdk.Int) Minter {
+	annualProvisions := blockProvision.ToDec().MulInt64(int64(params.BlocksPerYear))
+
+	inflation := sdk.ZeroDec()
+	if totalSupply.IsPositive() {
+		inflation = annualProvisions.QuoInt(totalSupply)
+	}
+
+	return NewMinter(inflation, annualProvisions)
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFixedInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.InflationModel = InflationModelFixed
+	params.BlockProvision = sdk.NewInt(100)
+
+	ctx := sdk.Context{}.WithBlockHeader(abci.Header{Height: 10})
+	totalSupply := sdk.NewInt(int64(params.BlocksPerYear) * 1000)
+
+	minter := DefaultInflationCalculationFn(ctx, DefaultInitialMinter(), params, sdk.ZeroDec(), totalSupply)
+	require.Equal(t, sdk.NewInt(100), minter.BlockProvision(params).Amount)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), minter.Inflation)
+	require.Equal(t, sdk.NewDec(int64(params.BlocksPerYear)*100), minter.AnnualProvisions)
+}
+
+func TestHalvingInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.InflationModel = InflationModelHalving
+	params.BlockProvision = sdk.NewInt(100)
+	params.HalvingInterval = 10
+
+	totalSupply := sdk.NewInt(1000000)
+
+	tests := []struct {
+		height       int64
+		expProvision sdk.Int
+	}{
+		{1, sdk.NewInt(100)},
+		{9, sdk.NewInt(100)},
+		{10, sdk.NewInt(50)},
+		{25, sdk.NewInt(25)},
+		{30, sdk.NewInt(12)},
+		{1000, sdk.ZeroInt()},
+	}
+	for i, tc := range tests {
+		ctx := sdk.Context{}.WithBlockHeader(abci.Header{Height: tc.height})
+		minter := DefaultInflationCalculationFn(ctx, DefaultInitialMinter(), params, sdk.ZeroDec(), totalSupply)
+
+		require.Equal(t, tc.expProvision, minter.BlockProvision(params).Amount, "test index: %d", i)
+	}
+}
+
+func TestCapProvisions(t *testing.T) {
+	params := DefaultParams()
+	params.BlockProvision = sdk.NewInt(100)
+	params.MaxSupply = sdk.NewInt(1000)
+
+	tests := []struct {
+		totalSupply  sdk.Int
+		expProvision sdk.Int
+	}{
+		{sdk.NewInt(500), sdk.NewInt(100)},
+		{sdk.NewInt(950), sdk.NewInt(50)},
+		{sdk.NewInt(1000), sdk.ZeroInt()},
+		{sdk.NewInt(1200), sdk.ZeroInt()},
+	}
+	for i, tc := range tests {
+		minter := MinterFromBlockProvision(params, params.BlockProvision, tc.totalSupply)
+		capped := minter.CapProvisions(params, tc.totalSupply)
+
+		require.Equal(t, tc.expProvision, capped.BlockProvision(params).Amount, "test index: %d", i)
+
+		// the inflation of the model is kept for its next rate computation
+		require.Equal(t, minter.Inflation, capped.Inflation, "test index: %d", i)
+	}
+
+	// a zero max supply disables the cap
+	params.MaxSupply = sdk.ZeroInt()
+	minter := MinterFromBlockProvision(params, params.BlockProvision, sdk.NewInt(1200))
+	require.Equal(t, minter, minter.CapProvisions(params, sdk.NewInt(1200)))
+}
+
+func TestValidateInflationModelParams(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.InflationModel = "foo"
+	require.Error(t, params.Validate())
+
+	params.InflationModel = InflationModelFixed
+	require.Error(t, params.Validate())
+
+	params.BlockProvision = sdk.NewInt(100)
+	require.NoError(t, params.Validate())
+
+	params.MaxSupply = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+}
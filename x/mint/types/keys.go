@@ -1,7 +1,10 @@
 package types
 
-// the one key to use for the keeper store
-var MinterKey = []byte{0x00}
+// keys to use for the keeper store
+var (
+	MinterKey = []byte{0x00} // key for the minter
+	ParamsKey = []byte{0x01} // key for the mint parameters
+)
 
 // nolint
 const (
@@ -14,6 +17,9 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// RouterKey is the message route for mint
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
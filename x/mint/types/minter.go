@@ -78,3 +78,21 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// CapProvisions limits the annual provisions of the minter so that the next
+// block provision does not raise the total supply above MaxSupply. The
+// inflation rate is left to the inflation model, which computes the next rate
+// from it. A zero MaxSupply disables the cap.
+func (m Minter) CapProvisions(params Params, totalSupply sdk.Int) Minter {
+	if params.MaxSupply.IsZero() {
+		return m
+	}
+
+	remaining := sdk.MaxInt(params.MaxSupply.Sub(totalSupply), sdk.ZeroInt())
+	maxAnnualProvisions := remaining.ToDec().MulInt64(int64(params.BlocksPerYear))
+	if m.AnnualProvisions.GT(maxAnnualProvisions) {
+		m.AnnualProvisions = maxAnnualProvisions
+	}
+
+	return m
+}
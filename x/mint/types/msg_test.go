@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+
+	invalidParams := DefaultParams()
+	invalidParams.MintDenom = ""
+
+	tests := []struct {
+		authority  sdk.AccAddress
+		params     Params
+		expectPass bool
+	}{
+		{authority, DefaultParams(), true},
+		{sdk.AccAddress{}, DefaultParams(), false},
+		{authority, invalidParams, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgUpdateParams(tc.authority, tc.params)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -19,6 +19,10 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyInflationModel      = []byte("InflationModel")
+	KeyBlockProvision      = []byte("BlockProvision")
+	KeyHalvingInterval     = []byte("HalvingInterval")
+	KeyMaxSupply           = []byte("MaxSupply")
 )
 
 // ParamTable for minting module.
@@ -28,6 +32,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	inflationModel string, blockProvision sdk.Int, halvingInterval uint64, maxSupply sdk.Int,
 ) Params {
 
 	return Params{
@@ -37,6 +42,10 @@ func NewParams(
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		InflationModel:      inflationModel,
+		BlockProvision:      blockProvision,
+		HalvingInterval:     halvingInterval,
+		MaxSupply:           maxSupply,
 	}
 }
 
@@ -49,6 +58,10 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		InflationModel:      InflationModelGoalBonded,
+		BlockProvision:      sdk.ZeroInt(),
+		HalvingInterval:     uint64(60 * 60 * 8766 / 5 * 4), // halving every 4 years
+		MaxSupply:           sdk.ZeroInt(),
 	}
 }
 
@@ -72,12 +85,30 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateInflationModel(p.InflationModel); err != nil {
+		return err
+	}
+	if err := validateBlockProvision(p.BlockProvision); err != nil {
+		return err
+	}
+	if err := validateHalvingInterval(p.HalvingInterval); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if p.InflationModel != InflationModelGoalBonded && !p.BlockProvision.IsPositive() {
+		return fmt.Errorf(
+			"block provision must be positive for the %s inflation model: %s",
+			p.InflationModel, p.BlockProvision,
+		)
+	}
 
 	return nil
 
@@ -98,6 +129,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyInflationModel, &p.InflationModel, validateInflationModel),
+		paramtypes.NewParamSetPair(KeyBlockProvision, &p.BlockProvision, validateBlockProvision),
+		paramtypes.NewParamSetPair(KeyHalvingInterval, &p.HalvingInterval, validateHalvingInterval),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
 	}
 }
 
@@ -193,3 +228,57 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationModel(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case InflationModelGoalBonded, InflationModelFixed, InflationModelHalving:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown inflation model: %s", v)
+	}
+}
+
+func validateBlockProvision(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("block provision cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateHalvingInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("halving interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -65,6 +65,60 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+// MsgUpdateParams defines an SDK message for replacing the parameters of the
+// mint module. It must be signed by the module authority.
+type MsgUpdateParams struct {
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+	Params    Params                                        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcb8be2eaea25b48, []int{1}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // mint parameters
 type Params struct {
 	// type of coin to mint
@@ -79,12 +133,20 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// model used to calculate the inflation
+	InflationModel string `protobuf:"bytes,7,opt,name=inflation_model,json=inflationModel,proto3" json:"inflation_model,omitempty" yaml:"inflation_model"`
+	// tokens minted per block by the fixed and halving models
+	BlockProvision github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=block_provision,json=blockProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"block_provision" yaml:"block_provision"`
+	// number of blocks after which the halving model halves the block provision
+	HalvingInterval uint64 `protobuf:"varint,9,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty" yaml:"halving_interval"`
+	// supply after which no more tokens are minted, zero for no cap
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fcb8be2eaea25b48, []int{1}
+	return fileDescriptor_fcb8be2eaea25b48, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,43 +189,69 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationModel() string {
+	if m != nil {
+		return m.InflationModel
+	}
+	return ""
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos_sdk.x.mint.v1.Minter")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos_sdk.x.mint.v1.MsgUpdateParams")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.mint.v1.Params")
 }
 
 func init() { proto.RegisterFile("x/mint/types/types.proto", fileDescriptor_fcb8be2eaea25b48) }
 
 var fileDescriptor_fcb8be2eaea25b48 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
-	0x1c, 0xc6, 0x6d, 0x48, 0x23, 0xe5, 0xa0, 0x02, 0x8e, 0x82, 0xac, 0x0a, 0xec, 0xca, 0x43, 0xd5,
-	0x05, 0x5b, 0x88, 0xad, 0xa3, 0x89, 0x18, 0x10, 0x45, 0x91, 0x37, 0x58, 0x4e, 0xff, 0xd8, 0x87,
-	0x73, 0x8a, 0xef, 0xce, 0xba, 0xbb, 0x16, 0x67, 0xe5, 0x09, 0x18, 0x19, 0x79, 0x0b, 0x5e, 0xa1,
-	0x1b, 0x1d, 0x11, 0x43, 0x84, 0x92, 0x37, 0xe8, 0x13, 0x20, 0xdf, 0x45, 0x09, 0x04, 0x96, 0x48,
-	0x5d, 0x6c, 0xff, 0x3f, 0x7d, 0xff, 0xef, 0xf7, 0xf9, 0xa4, 0x43, 0x41, 0x9b, 0x72, 0x26, 0x4c,
-	0x6a, 0x66, 0x0d, 0xd5, 0xee, 0x99, 0x34, 0x4a, 0x1a, 0x89, 0x0f, 0x0a, 0xa9, 0xb9, 0xd4, 0x44,
-	0x97, 0xd3, 0xa4, 0x4d, 0x3a, 0x53, 0x72, 0xf1, 0xfc, 0xf0, 0xd8, 0x4c, 0x98, 0x2a, 0x49, 0x03,
-	0xca, 0xcc, 0x52, 0x6b, 0x4c, 0x2b, 0x59, 0xc9, 0xcd, 0x97, 0xdb, 0x8e, 0xbf, 0xfb, 0xa8, 0x7f,
-	0xc6, 0x84, 0xa1, 0x0a, 0xbf, 0x41, 0x03, 0x26, 0x3e, 0xd4, 0x60, 0x98, 0x14, 0x81, 0x7f, 0xe4,
-	0x9f, 0x0c, 0xb2, 0xe4, 0x72, 0x1e, 0x79, 0x3f, 0xe7, 0xd1, 0x71, 0xc5, 0xcc, 0xe4, 0x7c, 0x9c,
-	0x14, 0x92, 0xa7, 0x0e, 0xb7, 0x7a, 0x3d, 0xd3, 0xe5, 0x74, 0xd5, 0x66, 0x48, 0x8b, 0x7c, 0x13,
-	0x80, 0x3f, 0xa2, 0x07, 0x20, 0xc4, 0x39, 0xd4, 0xa4, 0x51, 0xf2, 0x82, 0x69, 0x26, 0x85, 0x0e,
-	0x6e, 0xd9, 0xd4, 0xd7, 0xbb, 0xa5, 0x5e, 0xcf, 0xa3, 0x60, 0x06, 0xbc, 0x3e, 0x8d, 0xff, 0x09,
-	0x8c, 0xf3, 0xfb, 0x4e, 0x1b, 0x6d, 0xa4, 0x6f, 0x3d, 0xd4, 0x1f, 0x81, 0x02, 0xae, 0xf1, 0x53,
-	0x84, 0xba, 0xf3, 0x20, 0x25, 0x15, 0x92, 0xbb, 0x5f, 0xca, 0x07, 0x9d, 0x32, 0xec, 0x04, 0xfc,
-	0xc9, 0x47, 0x8f, 0xd6, 0x85, 0x89, 0x02, 0x43, 0x49, 0x31, 0x01, 0x51, 0xd1, 0x55, 0xcf, 0xb7,
-	0x3b, 0xf7, 0x7c, 0xe2, 0x7a, 0xfe, 0x37, 0x34, 0xce, 0x1f, 0xae, 0xf5, 0x1c, 0x0c, 0x7d, 0x69,
-	0x55, 0x3c, 0x45, 0xfb, 0x1b, 0x3b, 0x87, 0x36, 0xb8, 0x6d, 0xd9, 0xaf, 0x76, 0x66, 0x1f, 0x6c,
-	0xb3, 0x39, 0xb4, 0x71, 0x7e, 0x77, 0x3d, 0x9f, 0x41, 0xbb, 0x05, 0x63, 0x22, 0xe8, 0xdd, 0x18,
-	0x8c, 0x89, 0xbf, 0x60, 0x4c, 0x60, 0x8a, 0xee, 0x54, 0x12, 0x6a, 0x32, 0x96, 0xa2, 0xa4, 0x65,
-	0xb0, 0x67, 0x51, 0xc3, 0x9d, 0x51, 0xd8, 0xa1, 0xfe, 0x88, 0x8a, 0x73, 0xd4, 0x4d, 0x99, 0x1d,
-	0x70, 0x86, 0xee, 0x8d, 0x6b, 0x59, 0x4c, 0x35, 0x69, 0xa8, 0x22, 0x33, 0x0a, 0x2a, 0xe8, 0x1f,
-	0xf9, 0x27, 0xbd, 0xec, 0xf0, 0x7a, 0x1e, 0x3d, 0x76, 0xcb, 0x5b, 0x86, 0x38, 0xdf, 0x77, 0xca,
-	0x88, 0xaa, 0x77, 0x14, 0xd4, 0x69, 0xef, 0xcb, 0xd7, 0xc8, 0xcb, 0xa2, 0xcb, 0x45, 0xe8, 0x5f,
-	0x2d, 0x42, 0xff, 0xd7, 0x22, 0xf4, 0x3f, 0x2f, 0x43, 0xef, 0x6a, 0x19, 0x7a, 0x3f, 0x96, 0xa1,
-	0xf7, 0x7e, 0xcf, 0x16, 0x1a, 0xf7, 0xed, 0x9d, 0x79, 0xf1, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x9e,
-	0xe3, 0xbd, 0x88, 0x8d, 0x03, 0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x6e, 0xa0, 0x74, 0xd4, 0xfb, 0xd3, 0xcd, 0x0c, 0x88, 0xc6, 0x68, 0xa6, 0x1c, 0xa6, 0x71,
+	0x58, 0xaa, 0xc1, 0x6d, 0xb7, 0xa5, 0xd3, 0xc4, 0x10, 0x83, 0xc9, 0x88, 0x03, 0x5c, 0x22, 0x37,
+	0x31, 0xa9, 0xd5, 0xc4, 0x0e, 0xb6, 0x5b, 0xd2, 0x2b, 0x4f, 0xc0, 0x11, 0x09, 0x09, 0xf1, 0x38,
+	0xbb, 0xb1, 0x23, 0xe2, 0x50, 0xa1, 0xed, 0x0d, 0x7a, 0xe4, 0x84, 0xe2, 0x44, 0x4d, 0x29, 0x13,
+	0xa2, 0x12, 0x97, 0xd6, 0xfe, 0xf4, 0xf9, 0xfb, 0xf3, 0x8b, 0x6c, 0x60, 0xa6, 0xad, 0x98, 0x32,
+	0xd5, 0x52, 0xc3, 0x84, 0xc8, 0xfc, 0xd7, 0x49, 0x04, 0x57, 0x1c, 0xae, 0xfb, 0x5c, 0xc6, 0x5c,
+	0x7a, 0x32, 0xe8, 0x39, 0xa9, 0x93, 0x91, 0x9c, 0xc1, 0xde, 0xc6, 0xb6, 0xea, 0x52, 0x11, 0x78,
+	0x09, 0x16, 0x6a, 0xd8, 0xd2, 0xc4, 0x56, 0xc8, 0x43, 0x5e, 0xae, 0xf2, 0xd3, 0xf6, 0x57, 0x03,
+	0xd4, 0x4e, 0x28, 0x53, 0x44, 0xc0, 0xa7, 0xa0, 0x4e, 0xd9, 0x9b, 0x08, 0x2b, 0xca, 0x99, 0x69,
+	0x6c, 0x19, 0x3b, 0x75, 0xd7, 0x39, 0x1b, 0x59, 0x95, 0xef, 0x23, 0x6b, 0x3b, 0xa4, 0xaa, 0xdb,
+	0xef, 0x38, 0x3e, 0x8f, 0x5b, 0xb9, 0x5d, 0xf1, 0xb7, 0x2b, 0x83, 0x5e, 0x91, 0xe6, 0x90, 0xf8,
+	0xa8, 0x14, 0x80, 0xef, 0xc0, 0x1a, 0x66, 0xac, 0x8f, 0x23, 0x2f, 0x11, 0x7c, 0x40, 0x25, 0xe5,
+	0x4c, 0x9a, 0xd7, 0xb4, 0xea, 0x93, 0xf9, 0x54, 0xc7, 0x23, 0xcb, 0x1c, 0xe2, 0x38, 0xda, 0xb7,
+	0xff, 0x10, 0xb4, 0xd1, 0x6a, 0x8e, 0x9d, 0x96, 0xd0, 0x67, 0x03, 0x34, 0x4e, 0x64, 0xf8, 0x32,
+	0x09, 0xb0, 0x22, 0xa7, 0x58, 0xe0, 0x58, 0xc2, 0xe7, 0xa0, 0x8e, 0xfb, 0xaa, 0xcb, 0x05, 0x55,
+	0x43, 0x5d, 0x6d, 0xc9, 0xdd, 0xfb, 0x39, 0xb2, 0x76, 0xff, 0x21, 0xc0, 0x81, 0xef, 0x1f, 0x04,
+	0x81, 0x20, 0x52, 0xa2, 0x52, 0x03, 0xee, 0x83, 0x5a, 0xa2, 0xa5, 0x75, 0xa5, 0xc5, 0x87, 0x9b,
+	0xce, 0x55, 0x5f, 0xc1, 0xc9, 0xed, 0xdd, 0x6a, 0x56, 0x18, 0x15, 0x27, 0xec, 0x4f, 0x0b, 0xa0,
+	0x56, 0xe4, 0xba, 0x0f, 0x40, 0x46, 0xf5, 0x02, 0xc2, 0x78, 0x9c, 0xcf, 0x1c, 0xd5, 0x33, 0xe4,
+	0x30, 0x03, 0xe0, 0x7b, 0x03, 0xdc, 0x9e, 0x4c, 0xd4, 0x13, 0x58, 0x11, 0xcf, 0xef, 0x62, 0x16,
+	0x92, 0x62, 0x90, 0xcf, 0xe6, 0x1e, 0xe4, 0x66, 0x3e, 0xc8, 0x2b, 0x45, 0x6d, 0x74, 0x6b, 0x82,
+	0x23, 0xac, 0x48, 0x5b, 0xa3, 0xb0, 0x07, 0x96, 0x4b, 0x7a, 0x8c, 0x53, 0xf3, 0xba, 0xf6, 0x3e,
+	0x9a, 0xdb, 0x7b, 0x7d, 0xd6, 0x3b, 0xc6, 0xa9, 0x8d, 0x96, 0x26, 0xfb, 0x13, 0x9c, 0xce, 0x98,
+	0x51, 0x66, 0x56, 0xff, 0x9b, 0x19, 0x65, 0xbf, 0x99, 0x51, 0x06, 0x09, 0x58, 0x0c, 0x39, 0x8e,
+	0xbc, 0x0e, 0x67, 0x01, 0x09, 0xcc, 0x1b, 0xda, 0xea, 0x70, 0x6e, 0x2b, 0x98, 0x5b, 0x4d, 0x49,
+	0xd9, 0x08, 0x64, 0x3b, 0x57, 0x6f, 0xa0, 0x0b, 0x1a, 0x9d, 0x88, 0xfb, 0x3d, 0xe9, 0x25, 0x44,
+	0x78, 0x43, 0x82, 0x85, 0x59, 0xdb, 0x32, 0x76, 0xaa, 0xee, 0xc6, 0x78, 0x64, 0xdd, 0xc9, 0x0f,
+	0xcf, 0x10, 0x6c, 0xb4, 0x9c, 0x23, 0xa7, 0x44, 0xbc, 0x22, 0x58, 0xc0, 0x36, 0x68, 0x4c, 0x55,
+	0xe1, 0x01, 0x89, 0xcc, 0x05, 0x1d, 0x77, 0x4a, 0x63, 0x86, 0x60, 0xa3, 0x95, 0xb2, 0x6d, 0x06,
+	0xc0, 0xb7, 0x45, 0x90, 0xf2, 0x02, 0x99, 0x37, 0xb5, 0xc8, 0xe3, 0x39, 0x3a, 0x1f, 0x33, 0x35,
+	0x13, 0xbb, 0x94, 0xb3, 0xd1, 0x8a, 0x46, 0x26, 0xb7, 0x11, 0x1e, 0x81, 0xd5, 0x2e, 0x8e, 0x06,
+	0x94, 0x85, 0x9e, 0x7e, 0x64, 0x06, 0x38, 0x32, 0xeb, 0xba, 0xfc, 0xbd, 0xf1, 0xc8, 0xba, 0x9b,
+	0xab, 0xcc, 0x32, 0x6c, 0xd4, 0x28, 0xa0, 0xe3, 0x02, 0x81, 0x1d, 0x00, 0x62, 0x9c, 0x7a, 0xb2,
+	0x9f, 0x24, 0xd1, 0xd0, 0x04, 0x3a, 0x75, 0x7b, 0xee, 0xd4, 0x6b, 0xb9, 0x5f, 0xa9, 0x64, 0xa3,
+	0x7a, 0x8c, 0xd3, 0x17, 0x7a, 0xbd, 0x5f, 0xfd, 0xf8, 0xc5, 0xaa, 0xb8, 0xed, 0xb3, 0x8b, 0xa6,
+	0x71, 0x7e, 0xd1, 0x34, 0x7e, 0x5c, 0x34, 0x8d, 0x0f, 0x97, 0xcd, 0xca, 0xf9, 0x65, 0xb3, 0xf2,
+	0xed, 0xb2, 0x59, 0x79, 0xfd, 0xe0, 0xaf, 0x3e, 0xd3, 0xef, 0x73, 0xa7, 0xa6, 0x1f, 0xd7, 0x47,
+	0xbf, 0x06, 0x00, 0x95, 0xab, 0x0c, 0xac, 0xb6, 0x05, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -209,6 +297,46 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -229,6 +357,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.HalvingInterval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.BlockProvision.Size()
+		i -= size
+		if _, err := m.BlockProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.InflationModel) > 0 {
+		i -= len(m.InflationModel)
+		copy(dAtA[i:], m.InflationModel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.InflationModel)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -308,6 +468,21 @@ func (m *Minter) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -329,6 +504,17 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovTypes(uint64(m.BlocksPerYear))
 	}
+	l = len(m.InflationModel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovTypes(uint64(m.HalvingInterval))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -459,6 +645,126 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -675,6 +981,125 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5,16 +5,23 @@ package params
 import (
 	"github.com/cosmos/cosmos-sdk/x/params/keeper"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 const (
-	StoreKey  = types.StoreKey
-	TStoreKey = types.TStoreKey
+	StoreKey     = types.StoreKey
+	TStoreKey    = types.TStoreKey
+	QuerierRoute = proposal.QuerierRoute
+	QueryDryRun  = proposal.QueryDryRun
 )
 
 var (
 	// functions aliases
-	NewKeeper = keeper.NewKeeper
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	NewQueryDryRunParams = proposal.NewQueryDryRunParams
+	NewParamChangeResult = proposal.NewParamChangeResult
+	ErrDuplicateChange   = proposal.ErrDuplicateChange
 )
 
 type (
@@ -25,4 +32,8 @@ type (
 	Subspace         = types.Subspace
 	ReadOnlySubspace = types.ReadOnlySubspace
 	KeyTable         = types.KeyTable
+
+	QueryDryRunParams  = proposal.QueryDryRunParams
+	ParamChangeResult  = proposal.ParamChangeResult
+	ParamChangeResults = proposal.ParamChangeResults
 )
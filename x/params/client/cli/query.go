@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// GetQueryCmd returns the cli query commands for the params module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        proposal.ModuleName,
+		Short:                      "Querying commands for the params module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryDryRun(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryDryRun implements the query command that simulates the changes of
+// a parameter change proposal against the current state.
+func GetCmdQueryDryRun(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dry-run [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the changes of a parameter change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Decode and validate the changes of a parameter change proposal against the
+current state without executing them, and display the old and new value of every
+changed parameter. The proposal file has the same format as the one used by
+"tx gov submit-proposal param-change"; the title, description and deposit are
+ignored.
+
+Example:
+$ %s query %s dry-run <path/to/proposal.json>
+`,
+				version.ClientName, proposal.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			p, err := paramscutils.ParseParamChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(proposal.NewQueryDryRunParams(p.Changes.ToParamChanges()))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", proposal.QuerierRoute, proposal.QueryDryRun)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var results proposal.ParamChangeResults
+			if err := cdc.UnmarshalJSON(res, &results); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(results)
+		},
+	}
+}
@@ -30,13 +30,11 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
-
-Proper vetting of a parameter change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+Every change is decoded and validated against its parameter's registered type
+and validation function when the proposal is submitted, and all changes are
+applied atomically when the proposal passes: if any change fails, none of them
+are applied. Use "%s query params dry-run" to inspect the old and new value of
+every parameter before submitting.
 
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
@@ -61,7 +59,7 @@ Where proposal.json contains:
   ]
 }
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
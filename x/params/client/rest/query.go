@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// DryRunReq defines the request body for simulating a set of parameter changes.
+type DryRunReq struct {
+	Changes paramscutils.ParamChangesJSON `json:"changes" yaml:"changes"`
+}
+
+// RegisterRoutes registers the REST routes for the params module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	rest.Document(r.HandleFunc("/params/dry_run", dryRunHandlerFn(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:     "Simulate a set of parameter changes against the current state",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Request:     DryRunReq{},
+		Result:      proposal.ParamChangeResults{},
+	})
+}
+
+func dryRunHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DryRunReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(proposal.NewQueryDryRunParams(req.Changes.ToParamChanges()))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", proposal.QuerierRoute, proposal.QueryDryRun)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
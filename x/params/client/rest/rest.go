@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
@@ -19,6 +20,11 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 	return govrest.ProposalRESTHandler{
 		SubRoute: "param_change",
 		Handler:  postProposalHandlerFn(cliCtx),
+		Doc: rest.RouteDoc{
+			Summary:  "Generate an unsigned transaction submitting a parameter change proposal",
+			Request:  paramscutils.ParamChangeProposalReq{},
+			Response: authtypes.StdTx{},
+		},
 	}
 }
 
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
@@ -17,15 +19,20 @@ type Keeper struct {
 	key    sdk.StoreKey
 	tkey   sdk.StoreKey
 	spaces map[string]*types.Subspace
+
+	// paramSetStores maps the subspaces of the modules that store their
+	// parameters in their own store to these stores.
+	paramSetStores map[string]types.ParamSetStore
 }
 
 // NewKeeper constructs a params keeper
 func NewKeeper(cdc codec.Marshaler, key, tkey sdk.StoreKey) Keeper {
 	return Keeper{
-		cdc:    cdc,
-		key:    key,
-		tkey:   tkey,
-		spaces: make(map[string]*types.Subspace),
+		cdc:            cdc,
+		key:            key,
+		tkey:           tkey,
+		spaces:         make(map[string]*types.Subspace),
+		paramSetStores: make(map[string]types.ParamSetStore),
 	}
 }
 
@@ -59,3 +66,142 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// RegisterParamSetStore registers the store of a module that keeps its
+// parameters in its own store instead of the given subspace. Parameter changes
+// targeting the subspace are then applied to the module's ParamSet. It panics
+// if the subspace has not been allocated or already has a registered store.
+func (k Keeper) RegisterParamSetStore(s string, pss types.ParamSetStore) {
+	if _, ok := k.spaces[s]; !ok {
+		panic(fmt.Sprintf("subspace %s has not been allocated", s))
+	}
+
+	if _, ok := k.paramSetStores[s]; ok {
+		panic(fmt.Sprintf("param set store for subspace %s already registered", s))
+	}
+
+	k.paramSetStores[s] = pss
+}
+
+// GetParamSetStore returns the store registered for a subspace, if any.
+func (k Keeper) GetParamSetStore(s string) (types.ParamSetStore, bool) {
+	pss, ok := k.paramSetStores[s]
+	return pss, ok
+}
+
+// ApplyParamChanges decodes, validates and stores a set of parameter changes
+// atomically. Every change is decoded on top of the current parameter value and
+// validated against the validation function registered in its subspace's
+// KeyTable. If any change fails, none of the changes are written. The results
+// contain the old and new encoded value of every changed parameter.
+func (k Keeper) ApplyParamChanges(ctx sdk.Context, changes []proposal.ParamChange) (proposal.ParamChangeResults, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	results, err := k.applyParamChanges(cacheCtx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return results, nil
+}
+
+// DryRunParamChanges behaves like ApplyParamChanges but discards all state
+// changes, allowing a set of parameter changes to be inspected before it is
+// executed.
+func (k Keeper) DryRunParamChanges(ctx sdk.Context, changes []proposal.ParamChange) (proposal.ParamChangeResults, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.applyParamChanges(cacheCtx, changes)
+}
+
+func (k Keeper) applyParamChanges(ctx sdk.Context, changes []proposal.ParamChange) (proposal.ParamChangeResults, error) {
+	results := make(proposal.ParamChangeResults, len(changes))
+
+	for i, c := range changes {
+		var (
+			oldValue, newValue []byte
+			err                error
+		)
+
+		if pss, ok := k.paramSetStores[c.Subspace]; ok {
+			oldValue, newValue, err = k.applyParamSetChange(ctx, pss, c)
+		} else {
+			oldValue, newValue, err = k.applySubspaceChange(ctx, c)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = proposal.NewParamChangeResult(c.Subspace, c.Key, oldValue, newValue)
+	}
+
+	return results, nil
+}
+
+// applySubspaceChange decodes, validates and stores a parameter change in its
+// Subspace.
+func (k Keeper) applySubspaceChange(ctx sdk.Context, c proposal.ParamChange) ([]byte, []byte, error) {
+	ss, ok := k.GetSubspace(c.Subspace)
+	if !ok {
+		return nil, nil, sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+	}
+
+	key := []byte(c.Key)
+	oldValue := ss.GetRaw(ctx, key)
+
+	value, err := ss.Parse(ctx, key, []byte(c.Value))
+	if err != nil {
+		return nil, nil, wrapSettingParameterErr(c, err)
+	}
+
+	ss.Set(ctx, key, value)
+	return oldValue, ss.GetRaw(ctx, key), nil
+}
+
+// applyParamSetChange decodes a parameter change on top of the matching field
+// of a module's current ParamSet, validates it and stores the whole ParamSet
+// through the module's ParamSetStore.
+func (k Keeper) applyParamSetChange(ctx sdk.Context, pss types.ParamSetStore, c proposal.ParamChange) ([]byte, []byte, error) {
+	ps := pss.GetParamSet(ctx)
+
+	for _, pair := range ps.ParamSetPairs() {
+		if string(pair.Key) != c.Key {
+			continue
+		}
+
+		oldValue, err := k.cdc.MarshalJSON(pair.Value)
+		if err != nil {
+			return nil, nil, wrapSettingParameterErr(c, err)
+		}
+
+		if err := k.cdc.UnmarshalJSON([]byte(c.Value), pair.Value); err != nil {
+			return nil, nil, wrapSettingParameterErr(c, err)
+		}
+
+		// validation functions operate on the dereferenced field value
+		if err := pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()); err != nil {
+			return nil, nil, wrapSettingParameterErr(c, fmt.Errorf("invalid parameter value: %s", err))
+		}
+
+		if err := pss.SetParamSet(ctx, ps); err != nil {
+			return nil, nil, wrapSettingParameterErr(c, err)
+		}
+
+		newValue, err := k.cdc.MarshalJSON(pair.Value)
+		if err != nil {
+			return nil, nil, wrapSettingParameterErr(c, err)
+		}
+
+		return oldValue, newValue, nil
+	}
+
+	return nil, nil, wrapSettingParameterErr(c, fmt.Errorf("parameter %s not registered", c.Key))
+}
+
+func wrapSettingParameterErr(c proposal.ParamChange, err error) error {
+	return sdkerrors.Wrapf(
+		proposal.ErrSettingParameter, "subspace: %s, key: %s, value: %s, err: %s",
+		c.Subspace, c.Key, c.Value, err.Error(),
+	)
+}
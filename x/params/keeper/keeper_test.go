@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 func validateNoOp(_ interface{}) error { return nil }
@@ -223,3 +225,132 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+func validatePositive(i interface{}) error {
+	if i.(int64) <= 0 {
+		return errors.New("value must be positive")
+	}
+	return nil
+}
+
+func TestApplyParamChanges(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(types.NewKeyTable(
+		types.NewParamSetPair([]byte("key1"), int64(0), validatePositive),
+		types.NewParamSetPair([]byte("key2"), int64(0), validatePositive),
+	))
+	space.Set(ctx, []byte("key1"), int64(1))
+
+	testCases := []struct {
+		name    string
+		changes []proposal.ParamChange
+		expErr  error
+	}{
+		{"unknown subspace", []proposal.ParamChange{proposal.NewParamChange("other", "key1", `"5"`)}, proposal.ErrUnknownSubspace},
+		{"unregistered key", []proposal.ParamChange{proposal.NewParamChange("test", "key3", `"5"`)}, proposal.ErrSettingParameter},
+		{"invalid type", []proposal.ParamChange{proposal.NewParamChange("test", "key1", `true`)}, proposal.ErrSettingParameter},
+		{
+			"invalid value after valid change",
+			[]proposal.ParamChange{
+				proposal.NewParamChange("test", "key1", `"5"`),
+				proposal.NewParamChange("test", "key2", `"-1"`),
+			},
+			proposal.ErrSettingParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := keeper.DryRunParamChanges(ctx, tc.changes)
+			require.True(t, errors.Is(err, tc.expErr), err)
+
+			_, err = keeper.ApplyParamChanges(ctx, tc.changes)
+			require.True(t, errors.Is(err, tc.expErr), err)
+
+			// no change must have been written
+			var v int64
+			space.Get(ctx, []byte("key1"), &v)
+			require.Equal(t, int64(1), v)
+			require.False(t, space.Has(ctx, []byte("key2")))
+		})
+	}
+
+	changes := []proposal.ParamChange{
+		proposal.NewParamChange("test", "key1", `"5"`),
+		proposal.NewParamChange("test", "key2", `"7"`),
+	}
+	expected := proposal.ParamChangeResults{
+		proposal.NewParamChangeResult("test", "key1", []byte(`"1"`), []byte(`"5"`)),
+		proposal.NewParamChangeResult("test", "key2", nil, []byte(`"7"`)),
+	}
+
+	results, err := keeper.DryRunParamChanges(ctx, changes)
+	require.NoError(t, err)
+	require.Equal(t, expected, results)
+	require.False(t, space.Has(ctx, []byte("key2")))
+
+	results, err = keeper.ApplyParamChanges(ctx, changes)
+	require.NoError(t, err)
+	require.Equal(t, expected, results)
+
+	var v1, v2 int64
+	space.Get(ctx, []byte("key1"), &v1)
+	space.Get(ctx, []byte("key2"), &v2)
+	require.Equal(t, int64(5), v1)
+	require.Equal(t, int64(7), v2)
+}
+
+type storeParams struct {
+	Key1 int64
+}
+
+func (p *storeParams) ParamSetPairs() types.ParamSetPairs {
+	return types.ParamSetPairs{
+		types.NewParamSetPair([]byte("key1"), &p.Key1, validatePositive),
+	}
+}
+
+// paramSetStore is a ParamSetStore keeping its parameters in memory.
+type paramSetStore struct {
+	params *storeParams
+}
+
+func (s paramSetStore) GetParamSet(_ sdk.Context) types.ParamSet {
+	params := *s.params
+	return &params
+}
+
+func (s paramSetStore) SetParamSet(_ sdk.Context, ps types.ParamSet) error {
+	*s.params = *ps.(*storeParams)
+	return nil
+}
+
+func TestParamSetStore(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	pss := paramSetStore{&storeParams{Key1: 1}}
+	require.Panics(t, func() { keeper.RegisterParamSetStore("test", pss) })
+
+	space := keeper.Subspace("test").WithKeyTable(types.NewKeyTable().RegisterParamSet(&storeParams{}))
+	keeper.RegisterParamSetStore("test", pss)
+	require.Panics(t, func() { keeper.RegisterParamSetStore("test", pss) })
+
+	_, ok := keeper.GetParamSetStore("test")
+	require.True(t, ok)
+
+	_, err := keeper.ApplyParamChanges(ctx, []proposal.ParamChange{proposal.NewParamChange("test", "key1", `"-1"`)})
+	require.True(t, errors.Is(err, proposal.ErrSettingParameter), err)
+	require.Equal(t, int64(1), pss.params.Key1)
+
+	results, err := keeper.ApplyParamChanges(ctx, []proposal.ParamChange{proposal.NewParamChange("test", "key1", `"5"`)})
+	require.NoError(t, err)
+	require.Equal(t, proposal.ParamChangeResults{
+		proposal.NewParamChangeResult("test", "key1", []byte(`"1"`), []byte(`"5"`)),
+	}, results)
+
+	// the change is applied to the registered store, not to the subspace
+	require.Equal(t, int64(5), pss.params.Key1)
+	require.False(t, space.Has(ctx, []byte("key1")))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// NewQuerier creates a querier for the params module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case proposal.QueryDryRun:
+			res, err = queryDryRun(ctx, req, k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", proposal.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryDryRun(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params proposal.QueryDryRunParams
+	if err := proposal.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := proposal.ValidateChanges(params.Changes); err != nil {
+		return nil, err
+	}
+
+	results, err := k.DryRunParamChanges(ctx, params.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(proposal.ModuleCdc, results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
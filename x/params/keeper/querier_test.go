@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func TestQueryDryRun(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	space := keeper.Subspace("test").WithKeyTable(types.NewKeyTable(
+		types.NewParamSetPair([]byte("key1"), int64(0), validatePositive),
+	))
+	space.Set(ctx, []byte("key1"), int64(1))
+
+	querier := paramskeeper.NewQuerier(keeper)
+
+	_, err := querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.Error(t, err)
+
+	_, err = querier(ctx, []string{proposal.QueryDryRun}, abci.RequestQuery{Data: []byte("invalid")})
+	require.Error(t, err)
+
+	query := func(changes ...proposal.ParamChange) ([]byte, error) {
+		bz, err := proposal.ModuleCdc.MarshalJSON(proposal.NewQueryDryRunParams(changes))
+		require.NoError(t, err)
+		return querier(ctx, []string{proposal.QueryDryRun}, abci.RequestQuery{Data: bz})
+	}
+
+	_, err = query()
+	require.Error(t, err)
+
+	_, err = query(proposal.NewParamChange("test", "key1", `"-1"`))
+	require.Error(t, err)
+
+	res, err := query(proposal.NewParamChange("test", "key1", `"5"`))
+	require.NoError(t, err)
+
+	var results proposal.ParamChangeResults
+	require.NoError(t, proposal.ModuleCdc.UnmarshalJSON(res, &results))
+	require.Equal(t, proposal.ParamChangeResults{
+		proposal.NewParamChangeResult("test", "key1", []byte(`"1"`), []byte(`"5"`)),
+	}, results)
+
+	// the query must not modify the store
+	var v int64
+	space.Get(ctx, []byte("key1"), &v)
+	require.Equal(t, int64(1), v)
+}
@@ -24,19 +24,18 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p proposal.ParameterChangeProposal) error {
-	for _, c := range p.Changes {
-		ss, ok := k.GetSubspace(c.Subspace)
-		if !ok {
-			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
-		}
+	results, err := k.ApplyParamChanges(ctx, p.Changes)
+	if err != nil {
+		return err
+	}
 
+	for _, r := range results {
 		k.Logger(ctx).Info(
-			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
+			fmt.Sprintf(
+				"set new parameter value; subspace: %s, key: %s, old value: %s, new value: %s",
+				r.Subspace, r.Key, r.OldValue, r.NewValue,
+			),
 		)
-
-		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
-			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
-		}
 	}
 
 	return nil
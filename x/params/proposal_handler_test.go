@@ -130,3 +130,37 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerAtomic(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(
+		proposal.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		proposal.NewParamChange(testSubspace, keySlashingRate, `{"downtime": "invalidType"}`),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.Error(t, hdlr(input.ctx, tp))
+
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+}
+
+func TestProposalHandlerUnknownParameter(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(proposal.NewParamChange("unknown", keyMaxValidators, "1"))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	tp = testProposal(proposal.NewParamChange(testSubspace, "unknown", "1"))
+	require.NotPanics(t, func() {
+		require.Error(t, hdlr(input.ctx, tp))
+	})
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleParamSet defines the parameters of a module that stores them in its
+// own store.
+type ModuleParamSet interface {
+	ParamSet
+	codec.ProtoMarshaler
+
+	// Validate performs a stateless validation of the parameters.
+	Validate() error
+}
+
+// LegacySubspace defines the Subspace methods used to read the parameters that
+// have not been migrated to the module store yet.
+type LegacySubspace interface {
+	GetParamSetIfExists(ctx sdk.Context, ps ParamSet)
+}
+
+var (
+	_ LegacySubspace = Subspace{}
+	_ ParamSetStore  = ModuleParamStore{}
+)
+
+// ModuleParamStore stores the parameters of a module under a single key of the
+// module store. Parameters that have not been migrated to the module store yet
+// are read from the legacy Subspace of the module.
+type ModuleParamStore struct {
+	cdc      codec.Marshaler
+	key      sdk.StoreKey
+	paramKey []byte
+	subspace LegacySubspace
+	newSet   func() ModuleParamSet
+}
+
+// NewModuleParamStore returns a ModuleParamStore storing the parameters under
+// paramKey of the store of key. The newSet function returns a pointer to the
+// zero parameters of the module.
+func NewModuleParamStore(
+	cdc codec.Marshaler, key sdk.StoreKey, paramKey []byte, subspace LegacySubspace, newSet func() ModuleParamSet,
+) ModuleParamStore {
+	return ModuleParamStore{
+		cdc:      cdc,
+		key:      key,
+		paramKey: paramKey,
+		subspace: subspace,
+		newSet:   newSet,
+	}
+}
+
+// Get reads the parameters into ps. Parameters missing from both the module
+// store and the Subspace are left untouched.
+func (s ModuleParamStore) Get(ctx sdk.Context, ps ModuleParamSet) {
+	bz := ctx.KVStore(s.key).Get(s.paramKey)
+	if bz == nil {
+		s.subspace.GetParamSetIfExists(ctx, ps)
+		return
+	}
+
+	s.cdc.MustUnmarshalBinaryBare(bz, ps)
+}
+
+// Set stores the parameters in the module store without validating them.
+func (s ModuleParamStore) Set(ctx sdk.Context, ps ModuleParamSet) {
+	ctx.KVStore(s.key).Set(s.paramKey, s.cdc.MustMarshalBinaryBare(ps))
+}
+
+// Migrate copies the parameters from the Subspace to the module store. It is a
+// no-op if the parameters have already been migrated. Parameters missing from
+// the Subspace are left unset, they are set by the migrations of the module
+// versions that added them.
+func (s ModuleParamStore) Migrate(ctx sdk.Context) error {
+	if ctx.KVStore(s.key).Has(s.paramKey) {
+		return nil
+	}
+
+	ps := s.newSet()
+	s.subspace.GetParamSetIfExists(ctx, ps)
+
+	s.Set(ctx, ps)
+	return nil
+}
+
+// GetParamSet implements the ParamSetStore interface.
+func (s ModuleParamStore) GetParamSet(ctx sdk.Context) ParamSet {
+	ps := s.newSet()
+	s.Get(ctx, ps)
+	return ps
+}
+
+// SetParamSet implements the ParamSetStore interface. It fails if ps is not of
+// the parameter type of the module.
+func (s ModuleParamStore) SetParamSet(ctx sdk.Context, ps ParamSet) error {
+	mps, ok := ps.(ModuleParamSet)
+	if !ok || reflect.TypeOf(ps) != reflect.TypeOf(s.newSet()) {
+		return fmt.Errorf("invalid parameter set type: %T", ps)
+	}
+
+	if err := mps.Validate(); err != nil {
+		return err
+	}
+
+	s.Set(ctx, mps)
+	return nil
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type (
 	ValueValidatorFn func(value interface{}) error
 
@@ -24,3 +28,14 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ParamSetStore defines the interface of a module that stores its parameters
+// in its own store instead of a Subspace. Parameter changes targeting the
+// Subspace of such a module are applied to its ParamSet through this interface.
+type ParamSetStore interface {
+	// GetParamSet returns a pointer to the current parameters of the module.
+	GetParamSet(ctx sdk.Context) ParamSet
+
+	// SetParamSet validates and stores the parameters of the module.
+	SetParamSet(ctx sdk.Context, ps ParamSet) error
+}
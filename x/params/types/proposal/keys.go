@@ -6,4 +6,7 @@ const (
 
 	// RouterKey defines the routing key for a ParameterChangeProposal
 	RouterKey = "params"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = "params"
 )
@@ -6,6 +6,7 @@ import (
 
 	"gopkg.in/yaml.v2"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -80,12 +81,14 @@ func (pc ParamChange) String() string {
 }
 
 // ValidateChanges performs basic validation checks over a set of ParamChange. It
-// returns an error if any ParamChange is invalid.
+// returns an error if any ParamChange is invalid or if the same parameter is
+// changed more than once.
 func ValidateChanges(changes []ParamChange) error {
 	if len(changes) == 0 {
 		return ErrEmptyChanges
 	}
 
+	seen := make(map[string]bool, len(changes))
 	for _, pc := range changes {
 		if len(pc.Subspace) == 0 {
 			return ErrEmptySubspace
@@ -96,6 +99,12 @@ func ValidateChanges(changes []ParamChange) error {
 		if len(pc.Value) == 0 {
 			return ErrEmptyValue
 		}
+
+		id := pc.Subspace + "/" + pc.Key
+		if seen[id] {
+			return sdkerrors.Wrap(ErrDuplicateChange, id)
+		}
+		seen[id] = true
 	}
 
 	return nil
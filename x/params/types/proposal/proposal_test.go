@@ -1,6 +1,7 @@
 package proposal
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,4 +25,7 @@ func TestParameterChangeProposal(t *testing.T) {
 	pc4 := NewParamChange("sub", "", "cat")
 	pcp = NewParameterChangeProposal("test title", "test description", []ParamChange{pc4})
 	require.Error(t, pcp.ValidateBasic())
+
+	pcp = NewParameterChangeProposal("test title", "test description", []ParamChange{pc1, pc2, pc1})
+	require.True(t, errors.Is(pcp.ValidateBasic(), ErrDuplicateChange))
 }
@@ -0,0 +1,76 @@
+package proposal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Querier routes for the params module
+const (
+	QueryDryRun = "dry_run"
+)
+
+// QueryDryRunParams defines the parameters necessary for simulating a set of
+// parameter changes against the current state.
+type QueryDryRunParams struct {
+	Changes []ParamChange `json:"changes" yaml:"changes"`
+}
+
+// NewQueryDryRunParams creates a new QueryDryRunParams object.
+func NewQueryDryRunParams(changes []ParamChange) QueryDryRunParams {
+	return QueryDryRunParams{Changes: changes}
+}
+
+// ParamChangeResult defines the outcome of a single ParamChange: the value
+// stored before the change, if any, and the decoded and validated value that
+// is stored after it. Both values are JSON encoded by the subspace codec.
+type ParamChangeResult struct {
+	Subspace string          `json:"subspace" yaml:"subspace"`
+	Key      string          `json:"key" yaml:"key"`
+	OldValue json.RawMessage `json:"old_value" yaml:"old_value"`
+	NewValue json.RawMessage `json:"new_value" yaml:"new_value"`
+}
+
+// NewParamChangeResult creates a new ParamChangeResult object.
+func NewParamChangeResult(subspace, key string, oldValue, newValue []byte) ParamChangeResult {
+	return ParamChangeResult{subspace, key, oldValue, newValue}
+}
+
+// MarshalYAML returns the YAML representation of a ParamChangeResult with the
+// raw JSON values rendered as strings.
+func (r ParamChangeResult) MarshalYAML() (interface{}, error) {
+	return struct {
+		Subspace string `yaml:"subspace"`
+		Key      string `yaml:"key"`
+		OldValue string `yaml:"old_value"`
+		NewValue string `yaml:"new_value"`
+	}{r.Subspace, r.Key, string(r.OldValue), string(r.NewValue)}, nil
+}
+
+// String implements the Stringer interface.
+func (r ParamChangeResult) String() string {
+	oldValue := "<unset>"
+	if len(r.OldValue) != 0 {
+		oldValue = string(r.OldValue)
+	}
+
+	return fmt.Sprintf(`Param Change Result:
+  Subspace:  %s
+  Key:       %s
+  Old Value: %s
+  New Value: %s`, r.Subspace, r.Key, oldValue, string(r.NewValue))
+}
+
+// ParamChangeResults defines a slice of ParamChangeResult objects.
+type ParamChangeResults []ParamChangeResult
+
+// String implements the Stringer interface.
+func (rs ParamChangeResults) String() string {
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		out[i] = r.String()
+	}
+
+	return strings.Join(out, "\n")
+}
@@ -179,30 +179,48 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 	tstore.Set(key, []byte{})
 }
 
-// Update stores an updated raw value for a given parameter key assuming the
-// parameter type has been registered. It will panic if the parameter type has
-// not been registered or if the value cannot be encoded. An error is returned
-// if the raw value is not compatible with the registered type for the parameter
-// key or if the new value is invalid as determined by the registered type's
-// validation function.
-func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
+// Parse decodes a raw value for a given parameter key on top of the currently
+// stored value and validates the result with the registered validation function.
+// It does not modify the store. An error is returned if the parameter key is not
+// registered, if the raw value is not compatible with the registered type or if
+// the resulting value is invalid. The returned value is a pointer to the decoded
+// parameter type.
+func (s Subspace) Parse(ctx sdk.Context, key, value []byte) (interface{}, error) {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
-		panic(fmt.Sprintf("parameter %s not registered", string(key)))
+		return nil, fmt.Errorf("parameter %s not registered", string(key))
 	}
 
-	ty := attr.ty
-	dest := reflect.New(ty).Interface()
+	dest := reflect.New(attr.ty).Interface()
 	s.GetIfExists(ctx, key, dest)
 
 	if err := s.cdc.UnmarshalJSON(value, dest); err != nil {
-		return err
+		return nil, err
 	}
 
 	// destValue contains the dereferenced value of dest so validation function do
 	// not have to operate on pointers.
 	destValue := reflect.Indirect(reflect.ValueOf(dest)).Interface()
 	if err := s.Validate(ctx, key, destValue); err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
+
+// Update stores an updated raw value for a given parameter key assuming the
+// parameter type has been registered. It will panic if the parameter type has
+// not been registered or if the value cannot be encoded. An error is returned
+// if the raw value is not compatible with the registered type for the parameter
+// key or if the new value is invalid as determined by the registered type's
+// validation function.
+func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
+	if _, ok := s.table.m[string(key)]; !ok {
+		panic(fmt.Sprintf("parameter %s not registered", string(key)))
+	}
+
+	dest, err := s.Parse(ctx, key, value)
+	if err != nil {
 		return err
 	}
 
@@ -219,6 +237,16 @@ func (s Subspace) GetParamSet(ctx sdk.Context, ps ParamSet) {
 	}
 }
 
+// GetParamSetIfExists iterates through each ParamSetPair where for each pair, it
+// will retrieve the value and set it to the corresponding value pointer provided
+// in the ParamSetPair by calling Subspace#GetIfExists. Values of parameters that
+// are not set are left untouched.
+func (s Subspace) GetParamSetIfExists(ctx sdk.Context, ps ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+}
+
 // SetParamSet iterates through each ParamSetPair and sets the value with the
 // corresponding parameter key in the Subspace's KVStore.
 func (s Subspace) SetParamSet(ctx sdk.Context, ps ParamSet) {
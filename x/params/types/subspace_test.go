@@ -139,6 +139,29 @@ func (suite *SubspaceTestSuite) TestUpdate() {
 	suite.Require().Equal(good, v)
 }
 
+func (suite *SubspaceTestSuite) TestParse() {
+	_, err := suite.ss.Parse(suite.ctx, []byte("invalid_key"), nil)
+	suite.Require().Error(err)
+
+	bz, err := suite.cdc.MarshalJSON(time.Minute * 5)
+	suite.Require().NoError(err)
+
+	_, err = suite.ss.Parse(suite.ctx, keyUnbondingTime, bz)
+	suite.Require().Error(err)
+
+	good := time.Hour * 360
+	bz, err = suite.cdc.MarshalJSON(good)
+	suite.Require().NoError(err)
+
+	v, err := suite.ss.Parse(suite.ctx, keyUnbondingTime, bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&good, v)
+
+	// parsing must not modify the store
+	suite.Require().False(suite.ss.Has(suite.ctx, keyUnbondingTime))
+	suite.Require().False(suite.ss.Modified(suite.ctx, keyUnbondingTime))
+}
+
 func (suite *SubspaceTestSuite) TestGetParamSet() {
 	a := params{
 		UnbondingTime: time.Hour * 48,
@@ -160,6 +183,18 @@ func (suite *SubspaceTestSuite) TestGetParamSet() {
 	suite.Require().Equal(a.BondDenom, b.BondDenom)
 }
 
+func (suite *SubspaceTestSuite) TestGetParamSetIfExists() {
+	suite.Require().NotPanics(func() {
+		suite.ss.Set(suite.ctx, keyMaxValidators, uint16(100))
+	})
+
+	b := params{BondDenom: "stake"}
+	suite.Require().NotPanics(func() {
+		suite.ss.GetParamSetIfExists(suite.ctx, &b)
+	})
+	suite.Require().Equal(params{MaxValidators: 100, BondDenom: "stake"}, b)
+}
+
 func (suite *SubspaceTestSuite) TestSetParamSet() {
 	testCases := []struct {
 		name string
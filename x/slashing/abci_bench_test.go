@@ -0,0 +1,121 @@
+package slashing_test
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// writeCountingStore counts the writes and deletes made to a KVStore.
+type writeCountingStore struct {
+	sdk.KVStore
+	writes *int
+}
+
+func (s writeCountingStore) Set(key, value []byte) {
+	*s.writes++
+	s.KVStore.Set(key, value)
+}
+
+func (s writeCountingStore) Delete(key []byte) {
+	*s.writes++
+	s.KVStore.Delete(key)
+}
+
+// writeCountingMultiStore counts the writes and deletes made to the KVStore of
+// the given key.
+type writeCountingMultiStore struct {
+	sdk.MultiStore
+	key    sdk.StoreKey
+	writes *int
+}
+
+func (ms writeCountingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	store := ms.MultiStore.GetKVStore(key)
+	if key != ms.key {
+		return store
+	}
+
+	return writeCountingStore{store, ms.writes}
+}
+
+func BenchmarkBeginBlockerSigned(b *testing.B) {
+	benchmarkBeginBlocker(b, func(_, _ int64) bool { return true })
+}
+
+func BenchmarkBeginBlockerDowntime(b *testing.B) {
+	// every validator misses three blocks out of four, so they get jailed
+	// once per window
+	benchmarkBeginBlocker(b, func(height, i int64) bool { return (height+i)%4 == 0 })
+}
+
+// benchmarkBeginBlocker runs the slashing BeginBlocker with 100 validators and
+// a signed blocks window of 1000 blocks, and reports the number of writes made
+// to the slashing store per block. Jailed validators are unjailed right away.
+func benchmarkBeginBlocker(b *testing.B, signed func(height, i int64) bool) {
+	const numValidators = 100
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 1000
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	pks := simapp.CreateTestPubKeys(numValidators)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200))
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	votes := make([]abci.VoteInfo, numValidators)
+	for i, pk := range pks {
+		amt := sdk.TokensFromConsensusPower(100)
+		if _, err := sh(ctx, slashingkeeper.NewTestMsgCreateValidator(sdk.ValAddress(pk.Address()), pk, amt)); err != nil {
+			b.Fatal(err)
+		}
+
+		votes[i] = abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 100}}
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	writes := 0
+	ctx = ctx.WithMultiStore(writeCountingMultiStore{ctx.MultiStore(), app.GetKey(slashing.StoreKey), &writes})
+
+	beginBlock := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		for i := range votes {
+			votes[i].SignedLastBlock = signed(height, int64(i))
+		}
+
+		slashing.BeginBlocker(ctx, abci.RequestBeginBlock{LastCommitInfo: abci.LastCommitInfo{Votes: votes}}, app.SlashingKeeper)
+
+		for _, vote := range votes {
+			consAddr := sdk.ConsAddress(vote.Validator.Address)
+			if app.StakingKeeper.ValidatorByConsAddr(ctx, consAddr).IsJailed() {
+				app.StakingKeeper.Unjail(ctx, consAddr)
+			}
+		}
+	}
+
+	// fill the signed blocks window
+	height := int64(1)
+	for ; height <= params.SignedBlocksWindow; height++ {
+		beginBlock(height)
+	}
+
+	writes = 0
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		beginBlock(height)
+		height++
+	}
+
+	b.ReportMetric(float64(writes)/float64(b.N), "writes/block")
+}
@@ -18,6 +18,7 @@ const (
 	QueryParameters             = types.QueryParameters
 	QuerySigningInfo            = types.QuerySigningInfo
 	QuerySigningInfos           = types.QuerySigningInfos
+	MissedBlockBitmapChunkSize  = types.MissedBlockBitmapChunkSize
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
@@ -52,8 +53,11 @@ var (
 	GetValidatorSigningInfoAddress           = types.GetValidatorSigningInfoAddress
 	GetValidatorMissedBlockBitArrayPrefixKey = types.GetValidatorMissedBlockBitArrayPrefixKey
 	GetValidatorMissedBlockBitArrayKey       = types.GetValidatorMissedBlockBitArrayKey
+	GetValidatorMissedBlockBitmapPrefixKey   = types.GetValidatorMissedBlockBitmapPrefixKey
+	GetValidatorMissedBlockBitmapChunkKey    = types.GetValidatorMissedBlockBitmapChunkKey
 	GetAddrPubkeyRelationKey                 = types.GetAddrPubkeyRelationKey
 	NewMsgUnjail                             = types.NewMsgUnjail
+	NewMsgUpdateParams                       = types.NewMsgUpdateParams
 	ParamKeyTable                            = types.ParamKeyTable
 	NewParams                                = types.NewParams
 	DefaultParams                            = types.DefaultParams
@@ -66,6 +70,8 @@ var (
 	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
 	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
 	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
+	ParamsKey                       = types.ParamsKey
+	ValidatorMissedBlockBitmapKey   = types.ValidatorMissedBlockBitmapKey
 	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
 	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
 	DefaultSlashFractionDowntime    = types.DefaultSlashFractionDowntime
@@ -82,6 +88,7 @@ type (
 	GenesisState            = types.GenesisState
 	MissedBlock             = types.MissedBlock
 	MsgUnjail               = types.MsgUnjail
+	MsgUpdateParams         = types.MsgUpdateParams
 	Params                  = types.Params
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
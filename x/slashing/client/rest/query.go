@@ -13,20 +13,32 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/slashing/validators/{validatorPubKey}/signing_info",
 		signingInfoHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the signing info of a validator by its bech32 consensus public key",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.ValidatorSigningInfo{},
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/slashing/signing_infos",
 		signingInfoHandlerListFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the signing infos of all validators",
+		QueryParams: []rest.QueryParam{rest.HeightParam, rest.PageParam, rest.LimitParam},
+		Result:      []types.ValidatorSigningInfo{},
+	})
 
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/slashing/parameters",
 		queryParamsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current slashing parameter values",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Params{},
+	})
 }
 
 // http request handler to query signing info
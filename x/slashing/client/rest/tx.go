@@ -10,14 +10,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/slashing/validators/{validatorAddr}/unjail",
 		unjailRequestHandlerFn(cliCtx),
-	).Methods("POST")
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction unjailing a validator",
+		Request:  UnjailReq{},
+		Response: authtypes.StdTx{},
+	})
 }
 
 // Unjail TX body
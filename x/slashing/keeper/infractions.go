@@ -13,6 +13,10 @@ import (
 func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) {
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
+	params := k.GetParams(ctx)
+
+	// NOTE: RoundInt64 will never panic as minSignedPerWindow is less than 1.
+	minSignedPerWindow := params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
 
 	// fetch the validator public key
 	consAddr := sdk.ConsAddress(addr)
@@ -20,6 +24,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
+	// Tendermint reports the signatures of a validator rotating its consensus
+	// pubkey under the old key until the rotation is applied, while the
+	// signing info is kept under the current consensus address
+	if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+		consAddr = validator.GetConsAddr()
+	}
+
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
@@ -28,7 +39,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 
 	// this is a relative index, so it counts blocks the validator *should* have signed
 	// will use the 0-value default signing info if not present, except for start height
-	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
+	index := signInfo.IndexOffset % params.SignedBlocksWindow
 	signInfo.IndexOffset++
 
 	// Update signed block bit array & counter
@@ -49,6 +60,22 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		// Array value at this index has not changed, no need to update counter
 	}
 
+	// Track the blocks signed in a row and forgive past downtime offences once
+	// the validator has been signing long enough
+	if missed {
+		signInfo.SignedBlocksStreak = 0
+	} else {
+		signInfo.SignedBlocksStreak++
+
+		if params.DowntimeForgivenessBlocks > 0 && signInfo.DowntimeOffences > 0 &&
+			signInfo.SignedBlocksStreak >= params.DowntimeForgivenessBlocks {
+
+			logger.Info(fmt.Sprintf("Forgiving %d downtime offences of validator %s after %d signed blocks",
+				signInfo.DowntimeOffences, consAddr, signInfo.SignedBlocksStreak))
+			signInfo.DowntimeOffences = 0
+		}
+	}
+
 	if missed {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -60,11 +87,11 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 		)
 
 		logger.Info(
-			fmt.Sprintf("Absent validator %s at height %d, %d missed, threshold %d", consAddr, height, signInfo.MissedBlocksCounter, k.MinSignedPerWindow(ctx)))
+			fmt.Sprintf("Absent validator %s at height %d, %d missed, threshold %d", consAddr, height, signInfo.MissedBlocksCounter, minSignedPerWindow))
 	}
 
-	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
-	maxMissed := k.SignedBlocksWindow(ctx) - k.MinSignedPerWindow(ctx)
+	minHeight := signInfo.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - minSignedPerWindow
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
@@ -73,7 +100,19 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 
 			// Downtime confirmed: slash and jail the validator
 			logger.Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d",
-				consAddr, minHeight, k.MinSignedPerWindow(ctx)))
+				consAddr, minHeight, minSignedPerWindow))
+
+			// Offences older than the offence window no longer count towards escalation
+			if params.DowntimeOffenceWindow > 0 && signInfo.DowntimeOffences > 0 &&
+				height-signInfo.LastDowntimeOffenceHeight > params.DowntimeOffenceWindow {
+				signInfo.DowntimeOffences = 0
+			}
+
+			signInfo.DowntimeOffences++
+			signInfo.LastDowntimeOffenceHeight = height
+			signInfo.SignedBlocksStreak = 0
+
+			slashFraction, jailDuration := params.DowntimePenalty(signInfo.DowntimeOffences)
 
 			// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height,
 			// and subtract an additional 1 since this is the LastCommit.
@@ -89,12 +128,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyOffences, fmt.Sprintf("%d", signInfo.DowntimeOffences)),
+					sdk.NewAttribute(types.AttributeKeyJailDuration, jailDuration.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
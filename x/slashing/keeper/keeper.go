@@ -19,18 +19,29 @@ type Keeper struct {
 	cdc        codec.Marshaler
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
+	authority  sdk.AccAddress
 }
 
-// NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
+// NewKeeper creates a slashing keeper. The authority is the only address
+// allowed to update the module parameters through MsgUpdateParams.
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace,
+	authority sdk.AccAddress,
+) Keeper {
 	return Keeper{
 		storeKey:   key,
 		cdc:        cdc,
 		sk:         sk,
 		paramspace: paramspace.WithKeyTable(types.ParamKeyTable()),
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
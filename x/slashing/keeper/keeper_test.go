@@ -239,3 +239,192 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test repeat downtime offences being penalized harder, unless they are older
+// than the offence window
+func TestHandleDowntimeEscalation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		offences    int64
+		lastHeight  int64
+		expOffences int64
+		expSlashed  int64
+		expJailed   time.Duration
+	}{
+		{"first offence", 0, 0, 1, 1, 10 * time.Minute},
+		{"second offence", 1, 5, 2, 2, 30 * time.Minute},
+		{"capped offence", 3, 5, 4, 5, time.Hour},
+		{"offence outside window", 3, 1, 1, 1, 10 * time.Minute},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			blockTime := time.Unix(1590000000, 0).UTC()
+			ctx := app.BaseApp.NewContext(false, abci.Header{Time: blockTime})
+			power := int64(100)
+
+			params := app.SlashingKeeper.GetParams(ctx)
+			params.SignedBlocksWindow = 10
+			params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+			params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 2)
+			params.SlashFractionDowntimeMultiplier = sdk.NewDec(2)
+			params.MaxSlashFractionDowntime = sdk.NewDecWithPrec(5, 2)
+			params.DowntimeJailDuration = 10 * time.Minute
+			params.DowntimeJailDurationMultiplier = sdk.NewDec(3)
+			params.MaxDowntimeJailDuration = time.Hour
+			params.DowntimeOffenceWindow = 12
+			app.SlashingKeeper.SetParams(ctx, params)
+
+			amt := sdk.TokensFromConsensusPower(power)
+			addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+			valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+			pks := simapp.CreateTestPubKeys(1)
+
+			addr, val := valAddrs[0], pks[0]
+			consAddr := sdk.ConsAddress(val.Address())
+			sh := staking.NewHandler(app.StakingKeeper)
+			res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, val, amt))
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			require.True(t, found)
+			info.DowntimeOffences = tc.offences
+			info.LastDowntimeOffenceHeight = tc.lastHeight
+			app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+			// a full window of signed blocks, then one more missed block than allowed
+			height := int64(0)
+			for ; height < 10; height++ {
+				ctx = ctx.WithBlockHeight(height)
+				app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+			}
+			for ; height < 16; height++ {
+				ctx = ctx.WithBlockHeight(height)
+				app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+			}
+
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+			require.True(t, validator.IsJailed())
+			require.Equal(t, amt.Sub(sdk.TokensFromConsensusPower(tc.expSlashed)), validator.GetTokens())
+
+			info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			require.True(t, found)
+			require.Equal(t, tc.expOffences, info.DowntimeOffences)
+			require.Equal(t, int64(15), info.LastDowntimeOffenceHeight)
+			require.Zero(t, info.SignedBlocksStreak)
+			require.Equal(t, blockTime.Add(tc.expJailed), info.JailedUntil)
+		})
+	}
+}
+
+// Test past downtime offences being forgiven after enough blocks signed in a row
+func TestHandleDowntimeForgiveness(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	power := int64(100)
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.DowntimeForgivenessBlocks = 5
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(app.StakingKeeper)
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, val, sdk.TokensFromConsensusPower(power)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	info.DowntimeOffences = 2
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	// a missed block resets the streak
+	height := int64(0)
+	for ; height < 4; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	ctx = ctx.WithBlockHeight(height)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	height++
+
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(2), info.DowntimeOffences)
+	require.Zero(t, info.SignedBlocksStreak)
+
+	for ; height < 9; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(2), info.DowntimeOffences)
+	require.Equal(t, int64(4), info.SignedBlocksStreak)
+
+	// the fifth block signed in a row forgives the offences
+	ctx = ctx.WithBlockHeight(height)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Zero(t, info.DowntimeOffences)
+	require.Equal(t, int64(5), info.SignedBlocksStreak)
+}
+
+// Test a validator rotating its consensus pubkey
+// Ensure that the signatures reported for both keys update one signing info
+func TestHandleRotatedValidator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(addr, oldPk, sdk.TokensFromConsensusPower(100)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+
+	res, err = sh(ctx, staking.NewMsgRotateConsPubKey(addr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the signing info is moved to the new consensus address
+	require.False(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, oldConsAddr, 0))
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 0))
+
+	// Tendermint reports the old key until the rotation is applied
+	ctx = ctx.WithBlockHeight(1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	ctx = ctx.WithBlockHeight(2)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, newPk.Address(), 100, true)
+
+	require.False(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, info.Address)
+	require.Equal(t, int64(3), info.IndexOffset)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+}
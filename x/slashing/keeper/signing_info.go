@@ -37,6 +37,11 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.GetValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo deletes the validator signing info of a consensus address
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool)) {
@@ -56,36 +61,37 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 
 // GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
 func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
-	var missed gogotypes.BoolValue
-	if bz == nil {
-		// lazy: treat empty key as not missed
+	chunk := k.getValidatorMissedBlockBitmapChunk(ctx, address, index/types.MissedBlockBitmapChunkSize)
+	if chunk == nil {
+		// lazy: treat empty chunk as not missed
 		return false
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
 
-	return missed.Value
+	bit := index % types.MissedBlockBitmapChunkSize
+	return chunk[bit/8]&(1<<uint(bit%8)) != 0
 }
 
-// IterateValidatorMissedBlockBitArray iterates over the signed blocks window
-// and performs a callback function
+// IterateValidatorMissedBlockBitArray iterates over the missed blocks of the
+// signed blocks window and performs a callback function
 func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool)) {
 
 	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed gogotypes.BoolValue
-		bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
-		}
-
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
-		if handler(index, missed.Value) {
-			break
+	window := k.SignedBlocksWindow(ctx)
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitmapPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		offset := types.GetValidatorMissedBlockBitmapChunkIndex(iter.Key()) * types.MissedBlockBitmapChunkSize
+		chunk := iter.Value()
+		for bit := int64(0); bit < types.MissedBlockBitmapChunkSize; bit++ {
+			index := offset + bit
+			if index >= window {
+				return
+			}
+
+			if chunk[bit/8]&(1<<uint(bit%8)) != 0 && handler(index, true) {
+				return
+			}
 		}
 	}
 }
@@ -129,19 +135,88 @@ func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 }
 
 // SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
-// missed a block in the current window
+// missed a block in the current window. The bits are stored in chunks of
+// MissedBlockBitmapChunkSize blocks, and chunks without any missed block are
+// removed from the store.
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+	bit := index % types.MissedBlockBitmapChunkSize
+
+	chunk := k.getValidatorMissedBlockBitmapChunk(ctx, address, chunkIndex)
+	if chunk == nil {
+		if !missed {
+			return
+		}
+		chunk = make([]byte, types.MissedBlockBitmapChunkSize/8)
+	} else {
+		// never modify the slice returned by the store
+		chunk = append([]byte(nil), chunk...)
+	}
+
+	mask := byte(1 << uint(bit%8))
+	if (chunk[bit/8]&mask != 0) == missed {
+		return
+	}
+
+	if missed {
+		chunk[bit/8] |= mask
+	} else {
+		chunk[bit/8] &^= mask
+	}
+
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.GetValidatorMissedBlockBitArrayKey(address, index), bz)
+	key := types.GetValidatorMissedBlockBitmapChunkKey(address, chunkIndex)
+	if isZeroChunk(chunk) {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, chunk)
 }
 
-// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
+// clearValidatorMissedBlockBitArray deletes every chunk of the missed block bitmap of a validator
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitArrayPrefixKey(address))
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitmapPrefixKey(address))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
+
+// getValidatorMissedBlockBitmapChunk returns a chunk of the missed block
+// bitmap of a validator, or nil if none of its blocks were missed
+func (k Keeper) getValidatorMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunk int64) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.GetValidatorMissedBlockBitmapChunkKey(address, chunk))
+}
+
+// MigrateMissedBlockBitArray moves the missed blocks stored with one entry per
+// block under the legacy ValidatorMissedBlockBitArrayKey prefix to the missed
+// block bitmap, and deletes the legacy entries. It is a no-op once migrated.
+func (k Keeper) MigrateMissedBlockBitArray(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var missed gogotypes.BoolValue
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iter.Value(), &missed); err != nil {
+			return err
+		}
+
+		if missed.Value {
+			address, index := types.GetValidatorMissedBlockBitArrayAddressIndex(iter.Key())
+			k.SetValidatorMissedBlockBitArray(ctx, address, index, true)
+		}
+		store.Delete(iter.Key())
+	}
+
+	return nil
+}
+
+func isZeroChunk(chunk []byte) bool {
+	for _, b := range chunk {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -49,6 +50,80 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	require.True(t, missed) // now should be missed
 }
 
+func TestMissedBlockBitmapChunks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	consAddr := sdk.ConsAddress(addrDels[0])
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 3000
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	missedIndexes := []int64{0, 7, 8, types.MissedBlockBitmapChunkSize - 1, types.MissedBlockBitmapChunkSize, 2999}
+	for _, index := range missedIndexes {
+		app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+	}
+
+	for _, index := range missedIndexes {
+		require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, index))
+	}
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 2998))
+
+	// one chunk per 1024 blocks
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for chunk := int64(0); chunk < 3; chunk++ {
+		require.True(t, store.Has(types.GetValidatorMissedBlockBitmapChunkKey(consAddr, chunk)))
+	}
+
+	var iterated []int64
+	app.SlashingKeeper.IterateValidatorMissedBlockBitArray(ctx, consAddr, func(index int64, missed bool) bool {
+		require.True(t, missed)
+		iterated = append(iterated, index)
+		return false
+	})
+	require.Equal(t, missedIndexes, iterated)
+
+	// a chunk without any missed block is removed
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 2999, false)
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 2999))
+	require.False(t, store.Has(types.GetValidatorMissedBlockBitmapChunkKey(consAddr, 2)))
+}
+
+func TestMigrateMissedBlockBitArray(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(200))
+	cdc := app.Codec()
+
+	// entries written with the legacy layout
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	legacy := map[int64]bool{3: true, 4: false, 50: true}
+	for _, addr := range addrDels {
+		for index, missed := range legacy {
+			bz := cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.BoolValue{Value: missed})
+			store.Set(types.GetValidatorMissedBlockBitArrayKey(sdk.ConsAddress(addr), index), bz)
+		}
+	}
+
+	require.NoError(t, app.SlashingKeeper.MigrateMissedBlockBitArray(ctx))
+
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorMissedBlockBitArrayKey)
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	for _, addr := range addrDels {
+		for index, missed := range legacy {
+			require.Equal(t, missed, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addr), index))
+		}
+	}
+
+	// migrating again is a no-op
+	require.NoError(t, app.SlashingKeeper.MigrateMissedBlockBitArray(ctx))
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 50))
+}
+
 func TestTombstoned(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
@@ -0,0 +1,28 @@
+package v039
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+)
+
+// MigrateDowntimeEscalation sets the downtime escalation and forgiveness
+// slashing params. The maximum jail duration and slash fraction are set to the
+// current base values and the multipliers to one, so downtime penalties remain
+// unchanged until governance raises them. The offence window and the
+// forgiveness period are disabled.
+func MigrateDowntimeEscalation(ctx sdk.Context, k keeper.Keeper) error {
+	params := k.GetParams(ctx)
+	params.DowntimeOffenceWindow = 0
+	params.DowntimeJailDurationMultiplier = sdk.OneDec()
+	params.MaxDowntimeJailDuration = params.DowntimeJailDuration
+	params.SlashFractionDowntimeMultiplier = sdk.OneDec()
+	params.MaxSlashFractionDowntime = params.SlashFractionDowntime
+	params.DowntimeForgivenessBlocks = 0
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,47 @@
+package v039_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v039slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateDowntimeEscalation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// params stored before the escalation params existed
+	params := types.Params{
+		SignedBlocksWindow:      1000,
+		MinSignedPerWindow:      sdk.NewDecWithPrec(5, 1),
+		DowntimeJailDuration:    time.Hour,
+		SlashFractionDoubleSign: sdk.NewDecWithPrec(5, 2),
+		SlashFractionDowntime:   sdk.NewDecWithPrec(1, 3),
+	}
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	require.NoError(t, v039slashing.MigrateDowntimeEscalation(ctx, app.SlashingKeeper))
+
+	migrated := app.SlashingKeeper.GetParams(ctx)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, params.SignedBlocksWindow, migrated.SignedBlocksWindow)
+	require.Equal(t, params.SlashFractionDoubleSign, migrated.SlashFractionDoubleSign)
+	require.Equal(t, time.Hour, migrated.MaxDowntimeJailDuration)
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), migrated.MaxSlashFractionDowntime)
+	require.Equal(t, sdk.OneDec(), migrated.DowntimeJailDurationMultiplier)
+	require.Equal(t, sdk.OneDec(), migrated.SlashFractionDowntimeMultiplier)
+	require.Zero(t, migrated.DowntimeOffenceWindow)
+	require.Zero(t, migrated.DowntimeForgivenessBlocks)
+
+	// penalties are unchanged for repeat offenders
+	slashFraction, jailDuration := migrated.DowntimePenalty(5)
+	require.Equal(t, params.SlashFractionDowntime, slashFraction)
+	require.Equal(t, params.DowntimeJailDuration, jailDuration)
+}
@@ -17,6 +17,7 @@ import (
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
+	v039 "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -152,6 +153,33 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 4 }
+
+// RegisterMigrations registers the in-place store migrations of the slashing
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 adds the downtime escalation and forgiveness params
+	err = cfg.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return v039.MigrateDowntimeEscalation(ctx, am.keeper)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// version 4 stores the missed blocks in bitmap chunks
+	err = cfg.RegisterMigration(ModuleName, 3, am.keeper.MigrateMissedBlockBitArray)
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
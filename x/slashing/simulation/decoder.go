@@ -28,6 +28,9 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &missedB)
 		return fmt.Sprintf("missedA: %v\nmissedB: %v", missedA.Value, missedB.Value)
 
+	case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitmapKey):
+		return fmt.Sprintf("missedA: %X\nmissedB: %X", kvA.Value, kvB.Value)
+
 	case bytes.Equal(kvA.Key[:1], types.AddrPubkeyRelationKey):
 		var pubKeyA, pubKeyB crypto.PubKey
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pubKeyA)
@@ -36,6 +39,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		bechPKB := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKeyB)
 		return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPKA, bechPKB)
 
+	case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+		var paramsA, paramsB types.Params
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &paramsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &paramsB)
+		return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
 	default:
 		panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 	}
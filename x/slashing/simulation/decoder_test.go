@@ -39,10 +39,12 @@ func TestDecodeStore(t *testing.T) {
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, delPk1)
 	missed := gogotypes.BoolValue{Value: true}
+	bitmap := []byte{0x40, 0x00}
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.GetValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(info)},
 		tmkv.Pair{Key: types.GetValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryLengthPrefixed(&missed)},
+		tmkv.Pair{Key: types.GetValidatorMissedBlockBitmapChunkKey(consAddr1, 0), Value: bitmap},
 		tmkv.Pair{Key: types.GetAddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(delPk1)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
@@ -53,6 +55,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value)},
+		{"ValidatorMissedBlockBitmap", fmt.Sprintf("missedA: %X\nmissedB: %X", bitmap, bitmap)},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
 		{"other", ""},
 	}
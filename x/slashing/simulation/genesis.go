@@ -22,6 +22,13 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeOffenceWindow           = "downtime_offence_window"
+	DowntimeJailDurationMultiplier  = "downtime_jail_duration_multiplier"
+	MaxDowntimeJailDuration         = "max_downtime_jail_duration"
+	SlashFractionDowntimeMultiplier = "slash_fraction_downtime_multiplier"
+	MaxSlashFractionDowntime        = "max_slash_fraction_downtime"
+	DowntimeForgivenessBlocks       = "downtime_forgiveness_blocks"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +56,32 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeOffenceWindow randomized DowntimeOffenceWindow
+func GenDowntimeOffenceWindow(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 0, 10000))
+}
+
+// GenDowntimeMultiplier randomized DowntimeJailDurationMultiplier and
+// SlashFractionDowntimeMultiplier
+func GenDowntimeMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenMaxDowntimeJailDuration randomized MaxDowntimeJailDuration
+func GenMaxDowntimeJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*7)) * time.Second
+}
+
+// GenMaxSlashFractionDowntime randomized MaxSlashFractionDowntime
+func GenMaxSlashFractionDowntime(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(20) + 1)))
+}
+
+// GenDowntimeForgivenessBlocks randomized DowntimeForgivenessBlocks
+func GenDowntimeForgivenessBlocks(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 0, 10000))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +114,47 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeOffenceWindow int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenceWindow, &downtimeOffenceWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenceWindow = GenDowntimeOffenceWindow(r) },
+	)
+
+	var downtimeJailDurationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationMultiplier, &downtimeJailDurationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationMultiplier = GenDowntimeMultiplier(r) },
+	)
+
+	var maxDowntimeJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeJailDuration, &maxDowntimeJailDuration, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeJailDuration = GenMaxDowntimeJailDuration(r) },
+	)
+
+	var slashFractionDowntimeMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeMultiplier, &slashFractionDowntimeMultiplier, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeMultiplier = GenDowntimeMultiplier(r) },
+	)
+
+	var maxSlashFractionDowntime sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSlashFractionDowntime, &maxSlashFractionDowntime, simState.Rand,
+		func(r *rand.Rand) { maxSlashFractionDowntime = GenMaxSlashFractionDowntime(r) },
+	)
+
+	var downtimeForgivenessBlocks int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeForgivenessBlocks, &downtimeForgivenessBlocks, simState.Rand,
+		func(r *rand.Rand) { downtimeForgivenessBlocks = GenDowntimeForgivenessBlocks(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeOffenceWindow,
+		downtimeJailDurationMultiplier, maxDowntimeJailDuration,
+		slashFractionDowntimeMultiplier, maxSlashFractionDowntime, downtimeForgivenessBlocks,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
@@ -14,6 +14,9 @@ const (
 	keySignedBlocksWindow    = "SignedBlocksWindow"
 	keyMinSignedPerWindow    = "MinSignedPerWindow"
 	keySlashFractionDowntime = "SlashFractionDowntime"
+
+	keyDowntimeJailDurationMultiplier = "DowntimeJailDurationMultiplier"
+	keyDowntimeForgivenessBlocks      = "DowntimeForgivenessBlocks"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -35,5 +38,15 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenSlashFractionDowntime(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDowntimeJailDurationMultiplier,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDowntimeMultiplier(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDowntimeForgivenessBlocks,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDowntimeForgivenessBlocks(r))
+			},
+		),
 	}
 }
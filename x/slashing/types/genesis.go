@@ -76,5 +76,5 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
-	return nil
+	return data.Params.Validate()
 }
@@ -18,6 +18,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MissedBlockBitmapChunkSize is the number of blocks tracked by each chunk
+	// of a validator's missed block bitmap
+	MissedBlockBitmapChunkSize = 1024
 )
 
 // Keys for slashing store
@@ -25,13 +29,19 @@ const (
 //
 // - 0x01<consAddress_Bytes>: ValidatorSigningInfo
 //
-// - 0x02<consAddress_Bytes><period_Bytes>: bool
+// - 0x02<consAddress_Bytes><period_Bytes>: bool (legacy, migrated to 0x05)
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04: Params
+//
+// - 0x05<consAddress_Bytes><chunk_Bytes>: []byte
 var (
 	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
-	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
+	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for the legacy missed block bit array
 	AddrPubkeyRelationKey           = []byte{0x03} // Prefix for address-pubkey relation
+	ParamsKey                       = []byte{0x04} // Key for the module parameters
+	ValidatorMissedBlockBitmapKey   = []byte{0x05} // Prefix for missed block bitmap chunks
 )
 
 // GetValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -60,6 +70,37 @@ func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(GetValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// GetValidatorMissedBlockBitArrayAddressIndex - extract the address and the index from
+// a legacy missed block bit array key
+func GetValidatorMissedBlockBitArrayAddressIndex(key []byte) (sdk.ConsAddress, int64) {
+	if len(key) != 1+sdk.AddrLen+8 {
+		panic("unexpected key length")
+	}
+	return sdk.ConsAddress(key[1 : 1+sdk.AddrLen]), int64(binary.LittleEndian.Uint64(key[1+sdk.AddrLen:]))
+}
+
+// GetValidatorMissedBlockBitmapPrefixKey - stored by *Consensus* address (not operator address)
+func GetValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitmapKey, v.Bytes()...)
+}
+
+// GetValidatorMissedBlockBitmapChunkKey - stored by *Consensus* address (not operator address)
+// and big endian chunk index, so that chunks are iterated in order
+func GetValidatorMissedBlockBitmapChunkKey(v sdk.ConsAddress, chunk int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(chunk))
+	return append(GetValidatorMissedBlockBitmapPrefixKey(v), b...)
+}
+
+// GetValidatorMissedBlockBitmapChunkIndex - extract the chunk index from a missed block
+// bitmap chunk key
+func GetValidatorMissedBlockBitmapChunkIndex(key []byte) int64 {
+	if len(key) != 1+sdk.AddrLen+8 {
+		panic("unexpected key length")
+	}
+	return int64(binary.BigEndian.Uint64(key[1+sdk.AddrLen:]))
+}
+
 // GetAddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func GetAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
@@ -18,3 +18,14 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+
+	invalidParams := DefaultParams()
+	invalidParams.SignedBlocksWindow = 0
+
+	require.NoError(t, NewMsgUpdateParams(authority, DefaultParams()).ValidateBasic())
+	require.Error(t, NewMsgUpdateParams(nil, DefaultParams()).ValidateBasic())
+	require.Error(t, NewMsgUpdateParams(authority, invalidParams).ValidateBasic())
+}
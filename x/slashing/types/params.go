@@ -10,24 +10,36 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultParamspace           = ModuleName
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultParamspace                = ModuleName
+	DefaultSignedBlocksWindow        = int64(100)
+	DefaultDowntimeJailDuration      = 60 * 10 * time.Second
+	DefaultDowntimeOffenceWindow     = int64(0)
+	DefaultMaxDowntimeJailDuration   = DefaultDowntimeJailDuration
+	DefaultDowntimeForgivenessBlocks = int64(0)
 )
 
 var (
-	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
-	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
-	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultMinSignedPerWindow              = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign         = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultSlashFractionDowntime           = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimeJailDurationMultiplier  = sdk.OneDec()
+	DefaultSlashFractionDowntimeMultiplier = sdk.OneDec()
+	DefaultMaxSlashFractionDowntime        = DefaultSlashFractionDowntime
 )
 
 // Parameter store keys
 var (
-	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeySignedBlocksWindow              = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow              = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration            = []byte("DowntimeJailDuration")
+	KeySlashFractionDoubleSign         = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime           = []byte("SlashFractionDowntime")
+	KeyDowntimeOffenceWindow           = []byte("DowntimeOffenceWindow")
+	KeyDowntimeJailDurationMultiplier  = []byte("DowntimeJailDurationMultiplier")
+	KeyMaxDowntimeJailDuration         = []byte("MaxDowntimeJailDuration")
+	KeySlashFractionDowntimeMultiplier = []byte("SlashFractionDowntimeMultiplier")
+	KeyMaxSlashFractionDowntime        = []byte("MaxSlashFractionDowntime")
+	KeyDowntimeForgivenessBlocks       = []byte("DowntimeForgivenessBlocks")
 )
 
 // ParamKeyTable for slashing module
@@ -35,41 +47,49 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// Params - used for initializing default parameter for slashing at genesis
-type Params struct {
-	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`
-	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`
-	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
-	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
-	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
-}
-
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeOffenceWindow int64,
+	downtimeJailDurationMultiplier sdk.Dec, maxDowntimeJailDuration time.Duration,
+	slashFractionDowntimeMultiplier, maxSlashFractionDowntime sdk.Dec, downtimeForgivenessBlocks int64,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeOffenceWindow:           downtimeOffenceWindow,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		MaxDowntimeJailDuration:         maxDowntimeJailDuration,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		MaxSlashFractionDowntime:        maxSlashFractionDowntime,
+		DowntimeForgivenessBlocks:       downtimeForgivenessBlocks,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`Slashing Params:
-  SignedBlocksWindow:      %d
-  MinSignedPerWindow:      %s
-  DowntimeJailDuration:    %s
-  SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SignedBlocksWindow:              %d
+  MinSignedPerWindow:              %s
+  DowntimeJailDuration:            %s
+  SlashFractionDoubleSign:         %s
+  SlashFractionDowntime:           %s
+  DowntimeOffenceWindow:           %d
+  DowntimeJailDurationMultiplier:  %s
+  MaxDowntimeJailDuration:         %s
+  SlashFractionDowntimeMultiplier: %s
+  MaxSlashFractionDowntime:        %s
+  DowntimeForgivenessBlocks:       %d`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.DowntimeOffenceWindow,
+		p.DowntimeJailDurationMultiplier, p.MaxDowntimeJailDuration,
+		p.SlashFractionDowntimeMultiplier, p.MaxSlashFractionDowntime,
+		p.DowntimeForgivenessBlocks)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -80,6 +100,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeOffenceWindow, &p.DowntimeOffenceWindow, validateDowntimeOffenceWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDurationMultiplier, &p.DowntimeJailDurationMultiplier, validateDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxDowntimeJailDuration, &p.MaxDowntimeJailDuration, validateMaxDowntimeJailDuration),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntimeMultiplier, &p.SlashFractionDowntimeMultiplier, validateDowntimeMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxSlashFractionDowntime, &p.MaxSlashFractionDowntime, validateMaxSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeForgivenessBlocks, &p.DowntimeForgivenessBlocks, validateDowntimeForgivenessBlocks),
 	}
 }
 
@@ -87,8 +113,80 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultDowntimeOffenceWindow,
+		DefaultDowntimeJailDurationMultiplier, DefaultMaxDowntimeJailDuration,
+		DefaultSlashFractionDowntimeMultiplier, DefaultMaxSlashFractionDowntime, DefaultDowntimeForgivenessBlocks,
+	)
+}
+
+// DowntimePenalty returns the slash fraction and the jail duration of the given
+// downtime offence of a validator, counting from one. Both start from their
+// base value and are multiplied by their multiplier for every previous offence,
+// up to their maximum. A maximum below the base value disables the escalation.
+func (p Params) DowntimePenalty(offence int64) (slashFraction sdk.Dec, jailDuration time.Duration) {
+	slashFraction = escalate(
+		p.SlashFractionDowntime, p.SlashFractionDowntimeMultiplier, p.MaxSlashFractionDowntime, offence-1,
 	)
+
+	jail := escalate(
+		sdk.NewDec(int64(p.DowntimeJailDuration)), p.DowntimeJailDurationMultiplier,
+		sdk.NewDec(int64(p.MaxDowntimeJailDuration)), offence-1,
+	)
+
+	return slashFraction, time.Duration(jail.TruncateInt64())
+}
+
+// escalate multiplies base by multiplier the given number of times, without
+// exceeding max unless base already does.
+func escalate(base, multiplier, max sdk.Dec, times int64) sdk.Dec {
+	if base.GTE(max) || !multiplier.GT(sdk.OneDec()) {
+		return base
+	}
+
+	value := base
+	for i := int64(0); i < times && value.IsPositive() && value.LT(max); i++ {
+		value = value.Mul(multiplier)
+	}
+
+	if value.GT(max) {
+		return max
+	}
+	return value
+}
+
+// Validate performs basic validation on slashing parameters.
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateDowntimeOffenceWindow(p.DowntimeOffenceWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeMultiplier(p.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxDowntimeJailDuration(p.MaxDowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateDowntimeMultiplier(p.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxSlashFractionDowntime(p.MaxSlashFractionDowntime); err != nil {
+		return err
+	}
+	return validateDowntimeForgivenessBlocks(p.DowntimeForgivenessBlocks)
 }
 
 func validateSignedBlocksWindow(i interface{}) error {
@@ -164,3 +262,71 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeOffenceWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offence window cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeForgivenessBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime forgiveness blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestDowntimePenalty(t *testing.T) {
+	params := types.DefaultParams()
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 2)
+	params.SlashFractionDowntimeMultiplier = sdk.NewDecWithPrec(15, 1)
+	params.MaxSlashFractionDowntime = sdk.NewDecWithPrec(3, 2)
+	params.DowntimeJailDuration = 10 * time.Minute
+	params.DowntimeJailDurationMultiplier = sdk.NewDec(2)
+	params.MaxDowntimeJailDuration = time.Hour
+
+	testCases := []struct {
+		offence     int64
+		expFraction sdk.Dec
+		expJailed   time.Duration
+	}{
+		{1, sdk.NewDecWithPrec(1, 2), 10 * time.Minute},
+		{2, sdk.NewDecWithPrec(15, 3), 20 * time.Minute},
+		{3, sdk.NewDecWithPrec(225, 4), 40 * time.Minute},
+		{4, sdk.NewDecWithPrec(3, 2), time.Hour},
+		{100, sdk.NewDecWithPrec(3, 2), time.Hour},
+	}
+
+	for _, tc := range testCases {
+		fraction, jailed := params.DowntimePenalty(tc.offence)
+		require.Equal(t, tc.expFraction, fraction, "offence %d", tc.offence)
+		require.Equal(t, tc.expJailed, jailed, "offence %d", tc.offence)
+	}
+
+	// a maximum below the base value disables escalation
+	params.MaxSlashFractionDowntime = sdk.ZeroDec()
+	params.MaxDowntimeJailDuration = time.Minute
+	fraction, jailed := params.DowntimePenalty(3)
+	require.Equal(t, params.SlashFractionDowntime, fraction)
+	require.Equal(t, params.DowntimeJailDuration, jailed)
+
+	// the default params don't escalate
+	params = types.DefaultParams()
+	fraction, jailed = params.DowntimePenalty(10)
+	require.Equal(t, params.SlashFractionDowntime, fraction)
+	require.Equal(t, params.DowntimeJailDuration, jailed)
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.DowntimeJailDurationMultiplier = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxSlashFractionDowntime = sdk.NewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxDowntimeJailDuration = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DowntimeOffenceWindow = -1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DowntimeForgivenessBlocks = -1
+	require.Error(t, params.Validate())
+}
@@ -33,9 +33,13 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offences:     %d
+  Last Offence Height:   %d
+  Signed Blocks Streak:  %d`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffences,
+		i.LastDowntimeOffenceHeight, i.SignedBlocksStreak)
 }
 
 // unmarshal a validator signing info from a store value
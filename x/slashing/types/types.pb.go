@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -87,6 +88,12 @@ type ValidatorSigningInfo struct {
 	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// number of downtime offences counted towards the escalation of the penalties
+	DowntimeOffences int64 `protobuf:"varint,7,opt,name=downtime_offences,json=downtimeOffences,proto3" json:"downtime_offences,omitempty" yaml:"downtime_offences"`
+	// height of the last downtime offence
+	LastDowntimeOffenceHeight int64 `protobuf:"varint,8,opt,name=last_downtime_offence_height,json=lastDowntimeOffenceHeight,proto3" json:"last_downtime_offence_height,omitempty" yaml:"last_downtime_offence_height"`
+	// number of blocks signed in a row since the last missed block
+	SignedBlocksStreak int64 `protobuf:"varint,9,opt,name=signed_blocks_streak,json=signedBlocksStreak,proto3" json:"signed_blocks_streak,omitempty" yaml:"signed_blocks_streak"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -163,46 +170,248 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeOffences() int64 {
+	if m != nil {
+		return m.DowntimeOffences
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastDowntimeOffenceHeight() int64 {
+	if m != nil {
+		return m.LastDowntimeOffenceHeight
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetSignedBlocksStreak() int64 {
+	if m != nil {
+		return m.SignedBlocksStreak
+	}
+	return 0
+}
+
+// MsgUpdateParams defines an SDK message for replacing the parameters of the
+// slashing module. It must be signed by the module authority.
+type MsgUpdateParams struct {
+	Authority github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=authority,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"authority,omitempty"`
+	Params    Params                                        `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57cb37764f972476, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Authority
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params represents the parameters used for by the slashing module.
+type Params struct {
+	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	MinSignedPerWindow      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
+	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// number of blocks after which a downtime offence no longer counts towards
+	// the escalation of the penalties, zero meaning it counts until forgiven
+	DowntimeOffenceWindow int64 `protobuf:"varint,6,opt,name=downtime_offence_window,json=downtimeOffenceWindow,proto3" json:"downtime_offence_window,omitempty" yaml:"downtime_offence_window"`
+	// factor applied to the jail duration for every previous downtime offence
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier" yaml:"downtime_jail_duration_multiplier"`
+	// upper bound of the escalated jail duration
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,8,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration" yaml:"max_downtime_jail_duration"`
+	// factor applied to the downtime slash fraction for every previous downtime
+	// offence
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier" yaml:"slash_fraction_downtime_multiplier"`
+	// upper bound of the escalated downtime slash fraction
+	MaxSlashFractionDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_slash_fraction_downtime,json=maxSlashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction_downtime" yaml:"max_slash_fraction_downtime"`
+	// number of blocks a validator must sign in a row for its downtime offences
+	// to be forgiven, zero disabling forgiveness
+	DowntimeForgivenessBlocks int64 `protobuf:"varint,11,opt,name=downtime_forgiveness_blocks,json=downtimeForgivenessBlocks,proto3" json:"downtime_forgiveness_blocks,omitempty" yaml:"downtime_forgiveness_blocks"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57cb37764f972476, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeOffenceWindow() int64 {
+	if m != nil {
+		return m.DowntimeOffenceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeForgivenessBlocks() int64 {
+	if m != nil {
+		return m.DowntimeForgivenessBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos_sdk.x.slashing.v1.MsgUnjail")
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos_sdk.x.slashing.v1.ValidatorSigningInfo")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos_sdk.x.slashing.v1.MsgUpdateParams")
+	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.slashing.v1.Params")
 }
 
 func init() { proto.RegisterFile("x/slashing/types/types.proto", fileDescriptor_57cb37764f972476) }
 
 var fileDescriptor_57cb37764f972476 = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x04, 0x4a, 0xb9, 0x84, 0x0e, 0x2e, 0x08, 0x2b, 0xaa, 0x7c, 0x91, 0x07, 0x94,
-	0xa5, 0xb6, 0x28, 0x5b, 0x36, 0xdc, 0x05, 0xc4, 0x2f, 0xc9, 0xb4, 0x1d, 0x18, 0xb0, 0xce, 0xb9,
-	0xcb, 0xf9, 0x88, 0x7d, 0x17, 0xf9, 0xce, 0x55, 0xb2, 0xf2, 0x17, 0x74, 0x64, 0xec, 0xc8, 0x1f,
-	0xc1, 0x1f, 0xd0, 0xb1, 0x23, 0x93, 0x41, 0xc9, 0x82, 0x18, 0x33, 0x76, 0x42, 0xf6, 0xc5, 0x34,
-	0xaa, 0x10, 0x62, 0xb1, 0xef, 0x7d, 0xee, 0xfb, 0xde, 0xf7, 0xde, 0xbd, 0x83, 0x7b, 0x33, 0x5f,
-	0xa5, 0x58, 0x25, 0x5c, 0x30, 0x5f, 0xcf, 0xa7, 0x54, 0x99, 0xaf, 0x37, 0xcd, 0xa5, 0x96, 0x96,
-	0x3d, 0x92, 0x2a, 0x93, 0x2a, 0x52, 0x64, 0xe2, 0xcd, 0xbc, 0x46, 0xe8, 0x9d, 0x3e, 0xe9, 0x3d,
-	0xd6, 0x09, 0xcf, 0x49, 0x34, 0xc5, 0xb9, 0x9e, 0xfb, 0xb5, 0xd8, 0x67, 0x92, 0xc9, 0xeb, 0x95,
-	0xa9, 0xd0, 0x43, 0x4c, 0x4a, 0x96, 0x52, 0x23, 0x89, 0x8b, 0xb1, 0xaf, 0x79, 0x46, 0x95, 0xc6,
-	0xd9, 0xd4, 0x08, 0xdc, 0x4f, 0x00, 0xde, 0x7b, 0xad, 0xd8, 0xb1, 0xf8, 0x88, 0x79, 0x6a, 0x15,
-	0x70, 0xe7, 0x14, 0xa7, 0x9c, 0x60, 0x2d, 0xf3, 0x08, 0x13, 0x92, 0xdb, 0xa0, 0x0f, 0x06, 0xdd,
-	0xe0, 0xcd, 0xaf, 0x12, 0xdd, 0xad, 0x62, 0xaa, 0xd4, 0xaa, 0x44, 0x3b, 0x73, 0x9c, 0xa5, 0x43,
-	0x77, 0x0d, 0xdc, 0xab, 0x12, 0xed, 0x33, 0xae, 0x93, 0x22, 0xf6, 0x46, 0x32, 0xf3, 0xcd, 0xa1,
-	0xd7, 0xbf, 0x7d, 0x45, 0x26, 0xeb, 0x9e, 0x4e, 0x70, 0xfa, 0xcc, 0x64, 0x84, 0xf7, 0xff, 0xb8,
-	0x54, 0xc4, 0xfd, 0xda, 0x86, 0x0f, 0x4e, 0x1a, 0xf2, 0x8e, 0x33, 0xc1, 0x05, 0x7b, 0x21, 0xc6,
-	0xd2, 0x7a, 0x05, 0x1b, 0xd7, 0xf5, 0x41, 0x0e, 0xae, 0x4a, 0xe4, 0xfd, 0x87, 0xd7, 0xa1, 0x14,
-	0xaa, 0x31, 0x6b, 0x4a, 0x58, 0x43, 0xd8, 0x55, 0x1a, 0xe7, 0x3a, 0x4a, 0x28, 0x67, 0x89, 0xb6,
-	0x6f, 0xf5, 0xc1, 0xa0, 0x1d, 0x3c, 0x5a, 0x95, 0x68, 0xd7, 0x34, 0xb4, 0xb9, 0xeb, 0x86, 0x9d,
-	0x3a, 0x7c, 0x5e, 0x47, 0x55, 0x2e, 0x17, 0x84, 0xce, 0x22, 0x39, 0x1e, 0x2b, 0xaa, 0xed, 0xf6,
-	0xcd, 0xdc, 0xcd, 0x5d, 0x37, 0xec, 0xd4, 0xe1, 0xdb, 0x3a, 0xb2, 0x3e, 0xc0, 0x6e, 0x75, 0xbb,
-	0x94, 0x44, 0x85, 0xd0, 0x3c, 0xb5, 0x6f, 0xf7, 0xc1, 0xa0, 0x73, 0xd0, 0xf3, 0xcc, 0x6c, 0xbc,
-	0x66, 0x36, 0xde, 0x51, 0x33, 0x9b, 0x00, 0x5d, 0x94, 0xa8, 0x75, 0x5d, 0x7b, 0x33, 0xdb, 0x3d,
-	0xfb, 0x8e, 0x40, 0xd8, 0x31, 0xe8, 0xb8, 0x22, 0x96, 0x03, 0xa1, 0x96, 0x59, 0xac, 0xb4, 0x14,
-	0x94, 0xd8, 0x77, 0xfa, 0x60, 0xb0, 0x1d, 0x6e, 0x10, 0xeb, 0x08, 0x3e, 0xcc, 0xb8, 0x52, 0x94,
-	0x44, 0x71, 0x2a, 0x47, 0x13, 0x15, 0x8d, 0x64, 0x21, 0x34, 0xcd, 0xed, 0xad, 0xba, 0x89, 0xfe,
-	0xaa, 0x44, 0x7b, 0xc6, 0xe8, 0xaf, 0x32, 0x37, 0xdc, 0x35, 0x3c, 0xa8, 0xf1, 0xa1, 0xa1, 0xc3,
-	0xed, 0xcf, 0xe7, 0xa8, 0xf5, 0xf3, 0x1c, 0x81, 0xe0, 0xe5, 0x97, 0x85, 0x03, 0x2e, 0x16, 0x0e,
-	0xb8, 0x5c, 0x38, 0xe0, 0xc7, 0xc2, 0x01, 0x67, 0x4b, 0xa7, 0x75, 0xb9, 0x74, 0x5a, 0xdf, 0x96,
-	0x4e, 0xeb, 0xfd, 0xbf, 0x9f, 0xc6, 0xcd, 0xf7, 0x1f, 0x6f, 0xd5, 0xd7, 0xf1, 0xf4, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x72, 0x9e, 0xc6, 0x73, 0x1a, 0x03, 0x00, 0x00,
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd0, 0x36, 0x8d, 0xc7, 0xa1, 0xc0, 0x36, 0x21, 0x9b, 0x1f, 0xec, 0xba, 0x83, 0x14,
+	0xd2, 0x43, 0xd6, 0x6a, 0xb8, 0xe5, 0x80, 0xd4, 0x6d, 0x54, 0x51, 0xa0, 0x4d, 0xd8, 0xa4, 0x45,
+	0x0a, 0x12, 0xab, 0xb1, 0x77, 0xbc, 0x1e, 0xb2, 0xbb, 0x63, 0xed, 0x8c, 0x13, 0x87, 0x1b, 0x48,
+	0x48, 0x1c, 0x23, 0x71, 0xe9, 0xb1, 0x42, 0x42, 0xea, 0x1f, 0xc0, 0x9f, 0xc0, 0xa1, 0xc7, 0x1e,
+	0x11, 0x87, 0x05, 0x25, 0x17, 0xc4, 0xd1, 0xc7, 0x9e, 0xd0, 0xce, 0xcc, 0xc6, 0x8e, 0x63, 0x9b,
+	0xf8, 0x92, 0x64, 0xbe, 0xf7, 0xe6, 0x7b, 0xbf, 0xbe, 0x37, 0x1b, 0xb8, 0xd2, 0xa9, 0xf2, 0x08,
+	0xf3, 0x26, 0x4d, 0xc2, 0xaa, 0x38, 0x6e, 0x11, 0xae, 0x7e, 0x3a, 0xad, 0x94, 0x09, 0x66, 0x98,
+	0x75, 0xc6, 0x63, 0xc6, 0x7d, 0x1e, 0x1c, 0x38, 0x1d, 0xa7, 0x70, 0x74, 0x0e, 0xef, 0x2d, 0xad,
+	0x8a, 0x26, 0x4d, 0x03, 0xbf, 0x85, 0x53, 0x71, 0x5c, 0x95, 0xce, 0xd5, 0x90, 0x85, 0xac, 0xf7,
+	0x97, 0x62, 0x58, 0xb2, 0x42, 0xc6, 0xc2, 0x88, 0x28, 0x97, 0x5a, 0xbb, 0x51, 0x0d, 0xda, 0x29,
+	0x16, 0x94, 0x25, 0xda, 0x6e, 0x0f, 0xda, 0x05, 0x8d, 0x09, 0x17, 0x38, 0x6e, 0x29, 0x07, 0xf4,
+	0x03, 0x80, 0xa5, 0xc7, 0x3c, 0x7c, 0x9a, 0x7c, 0x8b, 0x69, 0x64, 0xb4, 0xe1, 0xad, 0x43, 0x1c,
+	0xd1, 0x00, 0x0b, 0x96, 0xfa, 0x38, 0x08, 0x52, 0x13, 0x54, 0xc0, 0xda, 0xac, 0xfb, 0xe4, 0xdf,
+	0xcc, 0xbe, 0x99, 0x9f, 0x09, 0xe7, 0xdd, 0xcc, 0xbe, 0x75, 0x8c, 0xe3, 0x68, 0x13, 0x69, 0x00,
+	0xbd, 0xc9, 0xec, 0xf5, 0x90, 0x8a, 0x66, 0xbb, 0xe6, 0xd4, 0x59, 0x5c, 0x55, 0x45, 0xe9, 0x5f,
+	0xeb, 0x3c, 0x38, 0xd0, 0x35, 0x3f, 0xc3, 0xd1, 0x7d, 0x75, 0xc3, 0x7b, 0xfb, 0x3c, 0x4a, 0x8e,
+	0xa0, 0xdf, 0x6f, 0xc0, 0xb9, 0x67, 0x05, 0xb2, 0x4b, 0xc3, 0x84, 0x26, 0xe1, 0xa3, 0xa4, 0xc1,
+	0x8c, 0x2f, 0x60, 0x11, 0x55, 0x27, 0xb2, 0xf1, 0x26, 0xb3, 0x9d, 0x2b, 0xc4, 0x7a, 0xc0, 0x12,
+	0x5e, 0x04, 0x2b, 0x28, 0x8c, 0x4d, 0x38, 0xcb, 0x05, 0x4e, 0x85, 0xdf, 0x24, 0x34, 0x6c, 0x0a,
+	0xf3, 0xad, 0x0a, 0x58, 0xbb, 0xe6, 0x2e, 0x74, 0x33, 0xfb, 0xb6, 0x2a, 0xa8, 0xdf, 0x8a, 0xbc,
+	0xb2, 0x3c, 0x7e, 0x2a, 0x4f, 0xf9, 0x5d, 0x9a, 0x04, 0xa4, 0xe3, 0xb3, 0x46, 0x83, 0x13, 0x61,
+	0x5e, 0x1b, 0xbc, 0xdb, 0x6f, 0x45, 0x5e, 0x59, 0x1e, 0xb7, 0xe5, 0xc9, 0xf8, 0x06, 0xce, 0xe6,
+	0xdd, 0x25, 0x81, 0xdf, 0x4e, 0x04, 0x8d, 0xcc, 0xeb, 0x15, 0xb0, 0x56, 0xde, 0x58, 0x72, 0xd4,
+	0x6c, 0x9c, 0x62, 0x36, 0xce, 0x5e, 0x31, 0x1b, 0xd7, 0x7e, 0x95, 0xd9, 0x53, 0x3d, 0xee, 0xfe,
+	0xdb, 0xe8, 0xe4, 0x2f, 0x1b, 0x78, 0x65, 0x05, 0x3d, 0xcd, 0x11, 0xc3, 0x82, 0x50, 0xb0, 0xb8,
+	0xc6, 0x05, 0x4b, 0x48, 0x60, 0xde, 0xa8, 0x80, 0xb5, 0x19, 0xaf, 0x0f, 0x31, 0xf6, 0xe0, 0x7c,
+	0x4c, 0x39, 0x27, 0x81, 0x5f, 0x8b, 0x58, 0xfd, 0x80, 0xfb, 0x75, 0xd6, 0x4e, 0x04, 0x49, 0xcd,
+	0x69, 0x59, 0x44, 0xa5, 0x9b, 0xd9, 0x2b, 0x2a, 0xd0, 0x50, 0x37, 0xe4, 0xdd, 0x56, 0xb8, 0x2b,
+	0xe1, 0x07, 0x0a, 0x35, 0x1e, 0xc1, 0xf7, 0x02, 0x76, 0x94, 0xe4, 0x82, 0xca, 0xcb, 0x26, 0x49,
+	0x9d, 0x70, 0xf3, 0xa6, 0x64, 0x5c, 0xe9, 0x66, 0xb6, 0xa9, 0x18, 0x2f, 0xb9, 0x20, 0xef, 0xdd,
+	0x02, 0xdb, 0xd6, 0x90, 0xd1, 0x84, 0x2b, 0x11, 0xe6, 0xc2, 0x1f, 0x74, 0x2e, 0x06, 0x35, 0x23,
+	0x59, 0x3f, 0xea, 0x66, 0xf6, 0x87, 0x8a, 0x75, 0x9c, 0x37, 0xf2, 0x16, 0x73, 0xf3, 0xd6, 0xc5,
+	0x20, 0x7a, 0x8c, 0x5f, 0xc2, 0x39, 0x4e, 0xc3, 0xa4, 0x57, 0x23, 0x17, 0x29, 0xc1, 0x07, 0x66,
+	0x49, 0x46, 0xb0, 0xbb, 0x99, 0xbd, 0xac, 0xa5, 0x30, 0xc4, 0x0b, 0x79, 0x86, 0x82, 0x55, 0x23,
+	0x76, 0x25, 0xb8, 0x39, 0xf3, 0xfc, 0x85, 0x3d, 0xf5, 0xcf, 0x0b, 0x1b, 0xa0, 0x5f, 0x00, 0x7c,
+	0x27, 0xdf, 0xa5, 0x56, 0x80, 0x05, 0xd9, 0xc1, 0x29, 0x8e, 0xb9, 0xb1, 0x0d, 0x4b, 0xb8, 0x2d,
+	0x9a, 0x2c, 0xa5, 0xe2, 0x58, 0x6b, 0xf8, 0xde, 0x15, 0xf7, 0xe5, 0x7e, 0xbd, 0x5e, 0x48, 0xb8,
+	0xc7, 0x61, 0x7c, 0x02, 0xa7, 0x5b, 0x92, 0x5a, 0xca, 0xb7, 0xbc, 0x51, 0x71, 0x46, 0x3d, 0x22,
+	0x8e, 0x4a, 0xc1, 0xbd, 0x9e, 0x8b, 0xc9, 0xd3, 0xb7, 0xd0, 0x6f, 0x65, 0x38, 0xad, 0x73, 0xbb,
+	0xd4, 0x8c, 0x23, 0x9a, 0x04, 0xec, 0xc8, 0x04, 0xe3, 0x9b, 0xa1, 0xbc, 0x06, 0x9a, 0xf1, 0x95,
+	0x04, 0x8d, 0xef, 0x41, 0xae, 0xb5, 0xc4, 0xd7, 0x37, 0x5a, 0x24, 0x2d, 0x48, 0xf3, 0x6c, 0x4b,
+	0xee, 0x93, 0x3c, 0x97, 0x3f, 0x33, 0x7b, 0xf5, 0x0a, 0xf5, 0x6f, 0x91, 0x7a, 0xbf, 0x32, 0x87,
+	0x90, 0x22, 0xcf, 0x88, 0x69, 0xb2, 0x2b, 0xe1, 0x1d, 0x92, 0xea, 0x1c, 0xbe, 0x83, 0xef, 0x9f,
+	0x4b, 0x23, 0x5f, 0x13, 0xbf, 0x78, 0x13, 0xe5, 0xd2, 0x96, 0x37, 0x16, 0x2f, 0x2d, 0xde, 0x96,
+	0x76, 0x70, 0xef, 0xea, 0xbd, 0xfb, 0x60, 0x40, 0xbc, 0x17, 0x68, 0xd0, 0xf3, 0x7c, 0x03, 0xe7,
+	0x0a, 0xe3, 0x67, 0x98, 0x46, 0x05, 0x81, 0x71, 0x02, 0xe0, 0x92, 0x1c, 0x81, 0xdf, 0x48, 0x71,
+	0x3d, 0x87, 0xfc, 0x80, 0xb5, 0x6b, 0x11, 0x91, 0xc9, 0xcb, 0xcd, 0x2f, 0xb9, 0xbb, 0x13, 0x37,
+	0xe1, 0x8e, 0x9e, 0xc3, 0x48, 0x66, 0xe4, 0x2d, 0x48, 0xe3, 0x43, 0x6d, 0xdb, 0x92, 0xa6, 0xbc,
+	0x33, 0xc6, 0x4f, 0x00, 0x2e, 0x5c, 0xba, 0xa8, 0x52, 0x97, 0x6f, 0x45, 0xc9, 0xdd, 0x99, 0x38,
+	0x1f, 0x6b, 0x44, 0x3e, 0x8a, 0x16, 0x79, 0xf3, 0x03, 0xc9, 0x28, 0xdc, 0xd8, 0x87, 0x0b, 0x97,
+	0x96, 0x56, 0xcb, 0x43, 0x3d, 0x45, 0xa8, 0xc7, 0x3d, 0xc2, 0x11, 0x79, 0xf3, 0x03, 0xcf, 0x87,
+	0x9e, 0xfa, 0xaf, 0x00, 0xde, 0x19, 0x3e, 0x2f, 0x3f, 0x6e, 0x47, 0x82, 0xb6, 0x22, 0x4a, 0x52,
+	0xf9, 0x3e, 0x95, 0xdc, 0xfd, 0x89, 0x0b, 0x5e, 0x1b, 0x27, 0x88, 0xbe, 0x00, 0xc8, 0xb3, 0x86,
+	0xe9, 0xe2, 0xf1, 0xb9, 0x83, 0xf1, 0x23, 0x80, 0x4b, 0x31, 0xee, 0xf8, 0x23, 0x24, 0x3a, 0xf3,
+	0x7f, 0x12, 0x5d, 0xd7, 0x12, 0xd5, 0x92, 0x18, 0x4d, 0xa5, 0x64, 0xba, 0x10, 0xe3, 0xce, 0xd6,
+	0x30, 0xa5, 0xbe, 0x04, 0x70, 0xd4, 0xfc, 0xfa, 0x1b, 0x56, 0x92, 0x0d, 0xfb, 0x7a, 0xe2, 0x86,
+	0xdd, 0x1d, 0xab, 0x90, 0x0b, 0x1d, 0xb3, 0x87, 0x8a, 0xa5, 0xaf, 0x65, 0x3f, 0x03, 0xb8, 0x9c,
+	0xd7, 0x39, 0x4a, 0xc5, 0x50, 0xe6, 0xb8, 0x37, 0x71, 0x8e, 0xa8, 0xd7, 0xc2, 0x91, 0x4a, 0x36,
+	0x63, 0xdc, 0xd9, 0x1d, 0x2a, 0xe6, 0x06, 0x5c, 0x3e, 0x2f, 0xa7, 0xc1, 0xd2, 0x90, 0x1e, 0x92,
+	0x84, 0x70, 0xae, 0x5f, 0x49, 0xb3, 0x2c, 0x05, 0xbd, 0xda, 0x0b, 0x33, 0xc6, 0x19, 0x79, 0x8b,
+	0x85, 0xf5, 0x61, 0xcf, 0xa8, 0x1e, 0xd6, 0xcd, 0xeb, 0xf9, 0xf7, 0xc5, 0xfd, 0xfc, 0xe5, 0xa9,
+	0x05, 0x5e, 0x9d, 0x5a, 0xe0, 0xf5, 0xa9, 0x05, 0xfe, 0x3e, 0xb5, 0xc0, 0xc9, 0x99, 0x35, 0xf5,
+	0xfa, 0xcc, 0x9a, 0xfa, 0xe3, 0xcc, 0x9a, 0xda, 0x1f, 0xff, 0x39, 0x19, 0xfc, 0x1f, 0xb4, 0x36,
+	0x2d, 0x65, 0xf5, 0xf1, 0x7f, 0x03, 0x00, 0x4c, 0xa2, 0xac, 0x57, 0x9e, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -266,6 +475,96 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeOffences != that1.DowntimeOffences {
+		return false
+	}
+	if this.LastDowntimeOffenceHeight != that1.LastDowntimeOffenceHeight {
+		return false
+	}
+	if this.SignedBlocksStreak != that1.SignedBlocksStreak {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Authority, that1.Authority) {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
+	if this.DowntimeOffenceWindow != that1.DowntimeOffenceWindow {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if !this.MaxSlashFractionDowntime.Equal(that1.MaxSlashFractionDowntime) {
+		return false
+	}
+	if this.DowntimeForgivenessBlocks != that1.DowntimeForgivenessBlocks {
+		return false
+	}
 	return true
 }
 func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
@@ -318,6 +617,21 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignedBlocksStreak != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedBlocksStreak))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastDowntimeOffenceHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastDowntimeOffenceHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DowntimeOffences != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DowntimeOffences))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -361,6 +675,160 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DowntimeForgivenessBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DowntimeForgivenessBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxSlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.MaxSlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SlashFractionDowntimeMultiplier.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.DowntimeOffenceWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DowntimeOffenceWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -409,16 +877,415 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovTypes(uint64(m.MissedBlocksCounter))
 	}
-	return n
-}
+	if m.DowntimeOffences != 0 {
+		n += 1 + sovTypes(uint64(m.DowntimeOffences))
+	}
+	if m.LastDowntimeOffenceHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastDowntimeOffenceHeight))
+	}
+	if m.SignedBlocksStreak != 0 {
+		n += 1 + sovTypes(uint64(m.SignedBlocksStreak))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovTypes(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DowntimeOffenceWindow != 0 {
+		n += 1 + sovTypes(uint64(m.DowntimeOffenceWindow))
+	}
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxSlashFractionDowntime.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.DowntimeForgivenessBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.DowntimeForgivenessBlocks))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddr == nil {
+				m.ValidatorAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffences", wireType)
+			}
+			m.DowntimeOffences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOffences |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffenceHeight", wireType)
+			}
+			m.LastDowntimeOffenceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDowntimeOffenceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksStreak", wireType)
+			}
+			m.SignedBlocksStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksStreak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -441,15 +1308,15 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -476,9 +1343,42 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = append(m.ValidatorAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddr == nil {
-				m.ValidatorAddr = []byte{}
+			m.Authority = append(m.Authority[:0], dAtA[iNdEx:postIndex]...)
+			if m.Authority == nil {
+				m.Authority = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -505,7 +1405,7 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,17 +1428,36 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -548,31 +1467,31 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -582,16 +1501,98 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenceWindow", wireType)
 			}
-			m.IndexOffset = 0
+			m.DowntimeOffenceWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -601,14 +1602,48 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				m.DowntimeOffenceWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -635,15 +1670,15 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -653,17 +1688,65 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeForgivenessBlocks", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.DowntimeForgivenessBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -673,7 +1756,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.DowntimeForgivenessBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -124,6 +124,9 @@ var (
 	ErrInvalidHistoricalInfo           = types.ErrInvalidHistoricalInfo
 	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	ErrEmptyValidatorPubKey            = types.ErrEmptyValidatorPubKey
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrCommissionLTMinRate             = types.ErrCommissionLTMinRate
+	ErrExceedingMaxConsPubKeyRotations = types.ErrExceedingMaxConsPubKeyRotations
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -153,11 +156,18 @@ var (
 	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
 	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
 	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
+	GetConsPubKeyRotationKey           = types.GetConsPubKeyRotationKey
+	GetConsPubKeyRotationsKey          = types.GetConsPubKeyRotationsKey
+	GetPendingConsPubKeyRotationKey    = types.GetPendingConsPubKeyRotationKey
+	GetConsPubKeyRotationTimeKey       = types.GetConsPubKeyRotationTimeKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgCancelUnbondingDelegation    = types.NewMsgCancelUnbondingDelegation
+	NewMsgRotateConsPubKey             = types.NewMsgRotateConsPubKey
+	NewMsgUpdateParams                 = types.NewMsgUpdateParams
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -174,6 +184,9 @@ var (
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
 	UnmarshalValidator                 = types.UnmarshalValidator
 	NewDescription                     = types.NewDescription
+	NewConsPubKeyRotation              = types.NewConsPubKeyRotation
+	MustMarshalConsPubKeyRotation      = types.MustMarshalConsPubKeyRotation
+	MustUnmarshalConsPubKeyRotation    = types.MustUnmarshalConsPubKeyRotation
 
 	// variable aliases
 	ModuleCdc                        = types.ModuleCdc
@@ -192,6 +205,10 @@ var (
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
 	HistoricalInfoKey                = types.HistoricalInfoKey
+	ParamsKey                        = types.ParamsKey
+	ConsPubKeyRotationQueueKey       = types.ConsPubKeyRotationQueueKey
+	ConsPubKeyRotationKey            = types.ConsPubKeyRotationKey
+	PendingConsPubKeyRotationKey     = types.PendingConsPubKeyRotationKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
@@ -199,44 +216,49 @@ var (
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	HistoricalInfo            = types.HistoricalInfo
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	QueryHistoricalInfoParams = types.QueryHistoricalInfoParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                       = keeper.Keeper
+	Commission                   = types.Commission
+	CommissionRates              = types.CommissionRates
+	DVPair                       = types.DVPair
+	DVVTriplet                   = types.DVVTriplet
+	Delegation                   = types.Delegation
+	Delegations                  = types.Delegations
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	UnbondingDelegations         = types.UnbondingDelegations
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	Redelegations                = types.Redelegations
+	HistoricalInfo               = types.HistoricalInfo
+	ConsPubKeyRotation           = types.ConsPubKeyRotation
+	ConsPubKeyRotations          = types.ConsPubKeyRotations
+	DelegationResponse           = types.DelegationResponse
+	DelegationResponses          = types.DelegationResponses
+	RedelegationResponse         = types.RedelegationResponse
+	RedelegationEntryResponse    = types.RedelegationEntryResponse
+	RedelegationResponses        = types.RedelegationResponses
+	GenesisState                 = types.GenesisState
+	LastValidatorPower           = types.LastValidatorPower
+	MultiStakingHooks            = types.MultiStakingHooks
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgUndelegate                = types.MsgUndelegate
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgRotateConsPubKey          = types.MsgRotateConsPubKey
+	MsgUpdateParams              = types.MsgUpdateParams
+	Params                       = types.Params
+	Pool                         = types.Pool
+	QueryDelegatorParams         = types.QueryDelegatorParams
+	QueryValidatorParams         = types.QueryValidatorParams
+	QueryBondsParams             = types.QueryBondsParams
+	QueryRedelegationParams      = types.QueryRedelegationParams
+	QueryValidatorsParams        = types.QueryValidatorsParams
+	QueryHistoricalInfoParams    = types.QueryHistoricalInfoParams
+	Validator                    = types.Validator
+	Validators                   = types.Validators
+	Description                  = types.Description
+	DelegationI                  = exported.DelegationI
+	ValidatorI                   = exported.ValidatorI
 )
@@ -15,96 +15,151 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	// Get all delegations from a delegator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/delegations",
 		delegatorDelegationsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get all delegations from a delegator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.DelegationResponses{},
+	})
 
-	// Get all unbonding delegations from a delegator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		delegatorUnbondingDelegationsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get all unbonding delegations from a delegator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.UnbondingDelegations{},
+	})
 
-	// Get all staking txs (i.e msgs) from a delegator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/txs",
 		delegatorTxsHandlerFn(cliCtx),
-	).Methods("GET")
-
-	// Query all validators that a delegator is bonded to
-	r.HandleFunc(
+	).Methods("GET"), rest.RouteDoc{
+		Summary: "Get all staking txs (i.e msgs) from a delegator",
+		QueryParams: []rest.QueryParam{
+			{Name: "type", Description: "space separated types of the txs among bond, unbond and redelegate"},
+		},
+		Response: []sdk.SearchTxsResult{},
+	})
+
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/validators",
 		delegatorValidatorsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query all validators that a delegator is bonded to",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Validators{},
+	})
 
-	// Query a validator that a delegator is bonded to
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/validators/{validatorAddr}",
 		delegatorValidatorHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query a validator that a delegator is bonded to",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Validator{},
+	})
 
-	// Query a delegation between a delegator and a validator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/delegations/{validatorAddr}",
 		delegationHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query a delegation between a delegator and a validator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.DelegationResponse{},
+	})
 
-	// Query all unbonding delegations between a delegator and a validator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}",
 		unbondingDelegationHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query all unbonding delegations between a delegator and a validator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.UnbondingDelegation{},
+	})
 
-	// Query redelegations (filters in query params)
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/redelegations",
 		redelegationsHandlerFn(cliCtx),
-	).Methods("GET")
-
-	// Get all validators
-	r.HandleFunc(
+	).Methods("GET"), rest.RouteDoc{
+		Summary: "Query redelegations, filtered by their delegator and validators",
+		QueryParams: []rest.QueryParam{
+			rest.HeightParam,
+			{Name: "delegator", Description: "bech32 address of the delegator"},
+			{Name: "validator_from", Description: "bech32 address of the source validator"},
+			{Name: "validator_to", Description: "bech32 address of the destination validator"},
+		},
+		Result: types.RedelegationResponses{},
+	})
+
+	rest.Document(r.HandleFunc(
 		"/staking/validators",
 		validatorsHandlerFn(cliCtx),
-	).Methods("GET")
-
-	// Get a single validator info
-	r.HandleFunc(
+	).Methods("GET"), rest.RouteDoc{
+		Summary: "Get all validators",
+		QueryParams: []rest.QueryParam{
+			rest.HeightParam,
+			rest.PageParam,
+			rest.LimitParam,
+			{Name: "status", Description: "status of the validators, Bonded by default"},
+		},
+		Result: types.Validators{},
+	})
+
+	rest.Document(r.HandleFunc(
 		"/staking/validators/{validatorAddr}",
 		validatorHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get a single validator info",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Validator{},
+	})
 
-	// Get all delegations to a validator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/validators/{validatorAddr}/delegations",
 		validatorDelegationsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get all delegations to a validator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.DelegationResponses{},
+	})
 
-	// Get all unbonding delegations from a validator
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/validators/{validatorAddr}/unbonding_delegations",
 		validatorUnbondingDelegationsHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get all unbonding delegations from a validator",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.UnbondingDelegations{},
+	})
 
-	// Get HistoricalInfo at a given height
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/historical_info/{height}",
 		historicalInfoHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary: "Get HistoricalInfo at a given height",
+		Result:  types.HistoricalInfo{},
+	})
 
-	// Get the current state of the staking pool
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/pool",
 		poolHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current state of the staking pool",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Pool{},
+	})
 
-	// Get the current staking parameter values
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/parameters",
 		paramsHandlerFn(cliCtx),
-	).Methods("GET")
-
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the current staking parameter values",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.Params{},
+	})
 }
 
 // HTTP request handler to query a delegator delegations
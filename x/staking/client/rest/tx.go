@@ -10,22 +10,43 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/delegations",
 		postDelegationsHandlerFn(cliCtx),
-	).Methods("POST")
-	r.HandleFunc(
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction delegating to a validator",
+		Request:  DelegateRequest{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		postUnbondingDelegationsHandlerFn(cliCtx),
-	).Methods("POST")
-	r.HandleFunc(
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction undelegating from a validator",
+		Request:  UndelegateRequest{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cliCtx),
-	).Methods("POST")
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction redelegating from a validator to another",
+		Request:  RedelegateRequest{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		postCancelUnbondingDelegationHandlerFn(cliCtx),
+	).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction cancelling an unbonding delegation",
+		Request:  CancelUnbondingDelegationRequest{},
+		Response: authtypes.StdTx{},
+	})
 }
 
 type (
@@ -53,6 +74,16 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
 	}
+
+	// CancelUnbondingDelegationRequest defines the properties of a cancel
+	// unbonding delegation request's body.
+	CancelUnbondingDelegationRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+		CreationHeight   int64          `json:"creation_height" yaml:"creation_height"`
+	}
 )
 
 func postDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -156,3 +187,37 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCancelUnbondingDelegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingDelegationRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(req.DelegatorAddress, req.ValidatorAddress, req.CreationHeight, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
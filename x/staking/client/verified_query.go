@@ -0,0 +1,118 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// staking module with verified store reads.
+func VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return map[string]context.VerifiedQuerier{
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidator):  QueryValidatorVerified,
+		fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation): QueryDelegationVerified,
+	}
+}
+
+// QueryValidatorVerified answers the validator query with a verified read of
+// the validator in the staking store.
+func QueryValidatorVerified(cliCtx context.CLIContext, data []byte) ([]byte, error) {
+	var params types.QueryValidatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, err
+	}
+
+	validator, err := queryValidator(cliCtx, params.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSONIndent(types.ModuleCdc, validator)
+}
+
+// QueryDelegationVerified answers the delegation query with verified reads of
+// the delegation, its validator and the bond denomination.
+func QueryDelegationVerified(cliCtx context.CLIContext, data []byte) ([]byte, error) {
+	var params types.QueryBondsParams
+	if err := types.ModuleCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, err
+	}
+
+	bz, _, err := cliCtx.QueryStore(types.GetDelegationKey(params.DelegatorAddr, params.ValidatorAddr), types.StoreKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, types.ErrNoDelegation
+	}
+
+	delegation, err := types.UnmarshalDelegation(types.ModuleCdc, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := queryValidator(cliCtx, delegation.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := queryBondDenom(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.NewDelegationResp(
+		delegation.DelegatorAddress,
+		delegation.ValidatorAddress,
+		delegation.Shares,
+		sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()),
+	)
+
+	return codec.MarshalJSONIndent(types.ModuleCdc, res)
+}
+
+func queryValidator(cliCtx context.CLIContext, valAddr sdk.ValAddress) (types.Validator, error) {
+	bz, _, err := cliCtx.QueryStore(types.GetValidatorKey(valAddr), types.StoreKey)
+	if err != nil {
+		return types.Validator{}, err
+	}
+	if bz == nil {
+		return types.Validator{}, types.ErrNoValidatorFound
+	}
+
+	return types.UnmarshalValidator(types.ModuleCdc, bz)
+}
+
+// queryBondDenom reads the bond denomination from the parameters in the staking
+// store, or from the legacy params subspace of the module until they are set.
+func queryBondDenom(cliCtx context.CLIContext) (string, error) {
+	bz, _, err := cliCtx.QueryStore(types.ParamsKey, types.StoreKey)
+	if err != nil {
+		return "", err
+	}
+	if bz != nil {
+		var params types.Params
+		if err := types.ModuleCdc.UnmarshalBinaryBare(bz, &params); err != nil {
+			return "", err
+		}
+
+		return params.BondDenom, nil
+	}
+
+	bz, _, err = cliCtx.QueryStore(append([]byte(types.ModuleName+"/"), types.KeyBondDenom...), paramstypes.StoreKey)
+	if err != nil {
+		return "", err
+	}
+
+	var bondDenom string
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &bondDenom); err != nil {
+		return "", err
+	}
+
+	return bondDenom, nil
+}
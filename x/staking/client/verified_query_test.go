@@ -0,0 +1,91 @@
+package client_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/client"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type appNode struct {
+	mock.Client
+	app *simapp.SimApp
+}
+
+func (n appNode) ABCIQueryWithOptions(
+	path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+
+	res := n.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+func TestVerifiedQueriers(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(nil)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	validator := types.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), types.NewDescription("val", "", "", "", ""))
+	validator, shares := validator.AddTokensFromDel(sdk.NewInt(1000))
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(delAddr, valAddr, shares))
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	cliCtx := context.CLIContext{}.
+		WithCodec(app.Codec()).
+		WithClient(appNode{app: app}).
+		WithTrustNode(true).
+		WithHeight(app.LastBlockHeight())
+
+	testCases := []struct {
+		name   string
+		query  string
+		params interface{}
+	}{
+		{"validator", types.QueryValidator, types.NewQueryValidatorParams(valAddr)},
+		{"delegation", types.QueryDelegation, types.NewQueryBondsParams(delAddr, valAddr)},
+	}
+
+	queriers := client.VerifiedQueriers()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			path := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, tc.query)
+			data, err := types.ModuleCdc.MarshalJSON(tc.params)
+			require.NoError(t, err)
+
+			// the verified querier answers as the querier of the module
+			expected, _, err := cliCtx.QueryWithData(path, data)
+			require.NoError(t, err)
+
+			querier, ok := queriers[path]
+			require.True(t, ok)
+			res, err := querier(cliCtx, data)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), string(res))
+		})
+	}
+
+	_, err := client.QueryValidatorVerified(cliCtx, []byte(fmt.Sprintf(`{"validator_addr":"%s"}`, sdk.ValAddress(delAddr))))
+	require.Equal(t, types.ErrNoValidatorFound, err)
+}
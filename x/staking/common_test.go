@@ -51,6 +51,7 @@ func getBaseSimappWithCustomKeeper() (*codec.Codec, *simapp.SimApp, sdk.Context)
 		app.BankKeeper,
 		app.SupplyKeeper,
 		app.GetSubspace(staking.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 	app.StakingKeeper.SetParams(ctx, types.DefaultParams())
 
@@ -89,6 +89,11 @@ func InitGenesis(
 		}
 	}
 
+	for _, rotation := range data.ConsPubKeyRotations {
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+		keeper.InsertConsPubKeyRotationQueue(ctx, rotation)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -172,6 +177,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		Delegations:          delegations,
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
+		ConsPubKeyRotations:  keeper.GetAllConsPubKeyRotations(ctx),
 		Exported:             true,
 	}
 }
@@ -422,6 +422,83 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestCreateValidatorBelowMinCommissionRate(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the default test commission rate of zero is below the minimum
+	msgCreateValidator := NewTestMsgCreateValidator(valAddrs[0], PKs[0], sdk.NewInt(10))
+	res, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+
+	msgCreateValidator.Commission = types.NewCommissionRates(
+		sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(1, 2),
+	)
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestEditValidatorBelowMinCommissionRate(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr := valAddrs[0]
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], sdk.NewInt(10))
+	msgCreateValidator.Commission = types.NewCommissionRates(
+		sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2),
+	)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// commission can only be changed once a day
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+
+	newRate := sdk.NewDecWithPrec(4, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.NewDescription("moniker", "", "", "", ""), &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+
+	newRate = sdk.NewDecWithPrec(5, 2)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.NewDescription("moniker", "", "", "", ""), &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// a validator left below the floor by its commission limits can raise its
+	// rate towards it
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	validator.Commission.Rate = sdk.NewDecWithPrec(1, 2)
+	validator.Commission.MaxChangeRate = sdk.NewDecWithPrec(2, 2)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+
+	newRate = sdk.NewDecWithPrec(3, 2)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.NewDescription("moniker", "", "", "", ""), &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(25 * time.Hour))
+	newRate = sdk.NewDecWithPrec(2, 2)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.NewDescription("moniker", "", "", "", ""), &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+}
+
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	initPower := int64(100)
 	initBond := sdk.TokensFromConsensusPower(100)
@@ -1435,6 +1512,244 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	require.Equal(t, validator.GetStatus(), sdk.Unbonding)
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	ctx = ctx.WithBlockHeight(10)
+
+	// create the validator
+	valTokens := sdk.TokensFromConsensusPower(10)
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// bond a delegator
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens)
+	res, err = handler(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// begin unbonding half of the delegation
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))
+	msgUndelegate := types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt)
+	res, err = handler(ctx, msgUndelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	completionTime := ubd.Entries[0].CompletionTime
+
+	// cannot cancel an entry that does not exist
+	cancelAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(2))
+	msgCancel := types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 11, cancelAmt)
+	_, err = handler(ctx, msgCancel)
+	require.Error(t, err)
+
+	// cannot cancel more than the entry balance
+	tooMuch := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(6))
+	msgCancel = types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, tooMuch)
+	_, err = handler(ctx, msgCancel)
+	require.Error(t, err)
+
+	// cancel part of the entry
+	msgCancel = types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, cancelAmt)
+	res, err = handler(ctx, msgCancel)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.TokensFromConsensusPower(3), ubd.Entries[0].Balance)
+	require.Equal(t, sdk.TokensFromConsensusPower(3), ubd.Entries[0].InitialBalance)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(7).ToDec(), delegation.Shares)
+
+	// cancel the remainder of the entry
+	remainder := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(3))
+	msgCancel = types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, remainder)
+	res, err = handler(ctx, msgCancel)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.ToDec(), delegation.Shares)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.MulRaw(2), validator.Tokens)
+}
+
+func TestCancelUnbondingDelegationJailedValidator(t *testing.T) {
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	ctx = ctx.WithBlockHeight(10)
+
+	// create the validator and bond a delegator
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, valTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, valTokens)
+	res, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// jail the validator
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	app.StakingKeeper.Jail(ctx, consAddr)
+
+	msgCancel := types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, unbondAmt)
+	_, err = handler(ctx, msgCancel)
+	require.True(t, types.ErrValidatorJailed.Is(err))
+
+	// the unbonding delegation is left untouched
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, valTokens, ubd.Entries[0].Balance)
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr := valAddrs[0]
+	oldPubKey, newPubKey := PKs[0], PKs[1]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, oldPubKey, valTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Len(t, updates, 1)
+
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, newPubKey))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, validator.GetConsAddr())
+
+	// both consensus addresses resolve to the validator until the rotation matures
+	for _, consAddr := range []sdk.ConsAddress{oldConsAddr, newConsAddr} {
+		validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.True(t, found)
+		require.Equal(t, validatorAddr, validator.OperatorAddress)
+	}
+
+	// the old key is removed from the validator set and the new one added
+	updates = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0},
+		validator.ABCIValidatorUpdate(),
+	}, updates)
+
+	updates = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Empty(t, updates)
+
+	// the old consensus address is released once the rotation matures
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.True(t, found)
+	require.Empty(t, app.StakingKeeper.GetValidatorConsPubKeyRotations(ctx, validatorAddr))
+}
+
+func TestRotateConsPubKeyUnbondedValidator(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr := valAddrs[0]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], sdk.TokensFromConsensusPower(10)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the validator is rotated before it enters the validator set
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[1]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// only the new key is sent, as the old one never made it to Tendermint
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Len(t, updates, 1)
+	require.Equal(t, tmtypes.TM2PB.PubKey(PKs[1]), updates[0].PubKey)
+	require.Equal(t, int64(10), updates[0].Power)
+}
+
+func TestRotateConsPubKeyLimit(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	validatorAddr := valAddrs[0]
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxConsPubkeyRotations = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], sdk.TokensFromConsensusPower(10)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	res, err = handler(ctx, NewTestMsgCreateValidator(valAddrs[1], PKs[1], sdk.TokensFromConsensusPower(10)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// keys in use by any validator cannot be rotated to
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[1]))
+	require.True(t, types.ErrValidatorPubKeyExists.Is(err))
+
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[2]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// keys rotated away from cannot be reused within the unbonding period
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[0]))
+	require.True(t, types.ErrValidatorPubKeyExists.Is(err))
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[3]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[4]))
+	require.True(t, types.ErrExceedingMaxConsPubKeyRotations.Is(err))
+
+	// a rotation is allowed again once the first one matures
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx) - time.Hour))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, app.StakingKeeper.GetValidatorConsPubKeyRotations(ctx, validatorAddr), 1)
+
+	res, err = handler(ctx, types.NewMsgRotateConsPubKey(validatorAddr, PKs[4]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func TestInvalidMsg(t *testing.T) {
 	k := staking.Keeper{}
 	h := staking.NewHandler(k)
@@ -1503,3 +1818,22 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestUpdateParams(t *testing.T) {
+	app, ctx, delAddrs, _ := bootstrapHandlerGenesisTest(t, 1000, 1, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	params := types.DefaultParams()
+	params.MaxEntries = 3
+
+	// only the authority may update the params
+	res, err := handler(ctx, types.NewMsgUpdateParams(delAddrs[0], params))
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Equal(t, types.DefaultMaxEntries, app.StakingKeeper.MaxEntries(ctx))
+
+	res, err = handler(ctx, types.NewMsgUpdateParams(app.StakingKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, params.Equal(app.StakingKeeper.GetParams(ctx)))
+}
@@ -32,6 +32,7 @@ func createTestInput() (*codec.Codec, *simapp.SimApp, sdk.Context) {
 		app.BankKeeper,
 		app.SupplyKeeper,
 		app.GetSubspace(staking.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 
 	return codec.New(), app, ctx
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The old
+// consensus address keeps resolving to the validator until the rotation
+// matures after the unbonding period, so infractions committed with the old
+// key can still be punished. The key change is sent to Tendermint by the next
+// call to ApplyAndReturnValidatorSetUpdates.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey crypto.PubKey) error {
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	// the new key must neither be in use nor have been rotated away from
+	// within the unbonding period
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	rotations := k.GetValidatorConsPubKeyRotations(ctx, validator.OperatorAddress)
+	if maxRotations := k.MaxConsPubKeyRotations(ctx); uint32(len(rotations)) >= maxRotations {
+		return sdkerrors.Wrapf(
+			types.ErrExceedingMaxConsPubKeyRotations,
+			"validator %s has reached the maximum of %d rotations", validator.OperatorAddress, maxRotations,
+		)
+	}
+
+	oldPubKey := validator.GetConsPubKey()
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	rotation := types.NewConsPubKeyRotation(
+		validator.OperatorAddress, oldPubKey, newPubKey, ctx.BlockHeight(), completionTime,
+	)
+
+	pkStr, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey)
+	if err != nil {
+		return err
+	}
+
+	// the old consensus address index is kept until the rotation is removed
+	validator.ConsensusPubkey = pkStr
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.setPendingConsPubKeyRotation(ctx, validator.OperatorAddress, oldPubKey)
+
+	k.SetConsPubKeyRotation(ctx, rotation)
+	k.InsertConsPubKeyRotationQueue(ctx, rotation)
+
+	// call the after-rotation hook
+	k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, validator.OperatorAddress)
+
+	return nil
+}
+
+// GetConsPubKeyRotation returns a consensus pubkey rotation of a validator by
+// the consensus address it replaced.
+func (k Keeper) GetConsPubKeyRotation(
+	ctx sdk.Context, valAddr sdk.ValAddress, oldConsAddr sdk.ConsAddress,
+) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetConsPubKeyRotationKey(valAddr, oldConsAddr))
+	if value == nil {
+		return rotation, false
+	}
+
+	rotation = types.MustUnmarshalConsPubKeyRotation(k.cdc, value)
+	return rotation, true
+}
+
+// SetConsPubKeyRotation sets a consensus pubkey rotation along with the index
+// from the old consensus address to the validator.
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	oldConsAddr := rotation.GetOldConsAddr()
+
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.OperatorAddress, oldConsAddr), bz)
+	store.Set(types.GetValidatorByConsAddrKey(oldConsAddr), rotation.OperatorAddress)
+}
+
+// RemoveConsPubKeyRotation removes a consensus pubkey rotation along with the
+// index from the old consensus address to the validator.
+func (k Keeper) RemoveConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	oldConsAddr := rotation.GetOldConsAddr()
+
+	store.Delete(types.GetConsPubKeyRotationKey(rotation.OperatorAddress, oldConsAddr))
+
+	// the index may point to another validator if the key got reused
+	indexKey := types.GetValidatorByConsAddrKey(oldConsAddr)
+	if bytes.Equal(store.Get(indexKey), rotation.OperatorAddress) {
+		store.Delete(indexKey)
+	}
+}
+
+// GetValidatorConsPubKeyRotations returns the consensus pubkey rotations of a
+// validator which have not matured yet.
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationsKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// GetAllConsPubKeyRotations returns all the consensus pubkey rotations which
+// have not matured yet, used during genesis dump.
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// gets a specific consensus pubkey rotation queue timeslice
+func (k Keeper) GetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []types.ConsPubKeyRotation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConsPubKeyRotationTimeKey(timestamp))
+	if bz == nil {
+		return []types.ConsPubKeyRotation{}
+	}
+
+	rotations := types.ConsPubKeyRotations{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rotations)
+	return rotations.Rotations
+}
+
+// sets a specific consensus pubkey rotation queue timeslice
+func (k Keeper) SetConsPubKeyRotationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, rotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.ConsPubKeyRotations{Rotations: rotations})
+	store.Set(types.GetConsPubKeyRotationTimeKey(timestamp), bz)
+}
+
+// Insert a consensus pubkey rotation to the appropriate timeslice in the
+// consensus pubkey rotation queue
+func (k Keeper) InsertConsPubKeyRotationQueue(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	timeSlice := k.GetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime)
+	timeSlice = append(timeSlice, rotation)
+	k.SetConsPubKeyRotationQueueTimeSlice(ctx, rotation.CompletionTime, timeSlice)
+}
+
+// Returns all the consensus pubkey rotation queue timeslices from time 0 until endTime
+func (k Keeper) ConsPubKeyRotationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ConsPubKeyRotationQueueKey, sdk.InclusiveEndBytes(types.GetConsPubKeyRotationTimeKey(endTime)))
+}
+
+// Returns a concatenated list of all the timeslices inclusively previous to
+// currTime, and deletes the timeslices from the queue
+func (k Keeper) DequeueAllMatureConsPubKeyRotationQueue(ctx sdk.Context, currTime time.Time) (matureRotations []types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+
+	// gets an iterator for all timeslices from time 0 until the current Blockheader time
+	rotationTimesliceIterator := k.ConsPubKeyRotationQueueIterator(ctx, currTime)
+	defer rotationTimesliceIterator.Close()
+
+	for ; rotationTimesliceIterator.Valid(); rotationTimesliceIterator.Next() {
+		timeslice := types.ConsPubKeyRotations{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(rotationTimesliceIterator.Value(), &timeslice)
+
+		matureRotations = append(matureRotations, timeslice.Rotations...)
+		store.Delete(rotationTimesliceIterator.Key())
+	}
+
+	return matureRotations
+}
+
+// records the consensus pubkey Tendermint knows a rotated validator by, unless
+// the validator was already rotated earlier in the block
+func (k Keeper) setPendingConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress, oldPubKey crypto.PubKey) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPendingConsPubKeyRotationKey(valAddr)
+	if store.Has(key) {
+		return
+	}
+
+	pkStr := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, oldPubKey)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.StringValue{Value: pkStr}))
+}
+
+// map of operator addresses to the consensus pubkey replaced in the block
+type pubKeysByAddr map[[sdk.AddrLen]byte]crypto.PubKey
+
+// get and delete the consensus pubkeys rotated away from in the block
+func (k Keeper) dequeuePendingConsPubKeyRotations(ctx sdk.Context) pubKeysByAddr {
+	store := ctx.KVStore(k.storeKey)
+	pending := make(pubKeysByAddr)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var valAddr [sdk.AddrLen]byte
+		// extract the validator address from the key (prefix is 1-byte)
+		copy(valAddr[:], iterator.Key()[1:])
+
+		var pkStr gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pkStr)
+		pending[valAddr] = sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, pkStr.Value)
+
+		store.Delete(iterator.Key())
+	}
+
+	return pending
+}
@@ -716,6 +716,93 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation cancels the given amount of the unbonding
+// delegation entry created at creationHeight and delegates the tokens back to
+// the original validator. The entry balance already reflects any slashing that
+// occurred while unbonding. An entry whose balance is fully cancelled is
+// removed, along with its position in the unbonding queue.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	// a jailed validator must unjail before it may receive delegations back
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amount.GT(entry.Balance) {
+		return sdkerrors.Wrapf(
+			types.ErrBadDelegationAmount, "amount %s is greater than the unbonding entry balance %s", amount, entry.Balance,
+		)
+	}
+
+	// the tokens of an unbonding entry are always held in the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	remaining := entry.Balance.Sub(amount)
+	if remaining.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+	} else {
+		entry.Balance = remaining
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
+// removeUBDQueueEntry removes a single (delegator, validator) pair of the given
+// unbonding delegation from the unbonding queue timeslice at completionTime.
+func (k Keeper) removeUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
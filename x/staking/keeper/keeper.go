@@ -28,13 +28,16 @@ type Keeper struct {
 	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
 	paramstore         paramtypes.Subspace
+	authority          sdk.AccAddress
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
 }
 
-// NewKeeper creates a new staking Keeper instance
+// NewKeeper creates a new staking Keeper instance. The authority is the only
+// address allowed to update the module parameters with MsgUpdateParams.
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, bk types.BankKeeper, sk types.SupplyKeeper, ps paramtypes.Subspace,
+	authority sdk.AccAddress,
 ) Keeper {
 
 	if !ps.HasKeyTable() {
@@ -56,6 +59,7 @@ func NewKeeper(
 		bankKeeper:         bk,
 		supplyKeeper:       sk,
 		paramstore:         ps,
+		authority:          authority,
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
@@ -67,6 +71,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Set the validator hooks
 func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	if k.hooks != nil {
@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -26,3 +28,22 @@ func TestParams(t *testing.T) {
 	resParams = app.StakingKeeper.GetParams(ctx)
 	require.True(t, expParams.Equal(resParams))
 }
+
+func TestParamChangeProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+
+	tp := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxValidators), "0"),
+	})
+	require.Error(t, handler(ctx, tp))
+
+	tp = paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxValidators), "77"),
+	})
+	require.NoError(t, handler(ctx, tp))
+	require.Equal(t, uint32(77), app.StakingKeeper.MaxValidators(ctx))
+	require.False(t, app.GetSubspace(types.ModuleName).Has(ctx, types.KeyMaxValidators))
+}
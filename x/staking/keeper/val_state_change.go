@@ -7,6 +7,7 @@ import (
 
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -71,6 +72,12 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
+	// Remove the old consensus address indexes of all mature consensus pubkey rotations.
+	matureRotations := k.DequeueAllMatureConsPubKeyRotationQueue(ctx, ctx.BlockHeader().Time)
+	for _, rotation := range matureRotations {
+		k.RemoveConsPubKeyRotation(ctx, rotation)
+	}
+
 	return validatorUpdates
 }
 
@@ -80,6 +87,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // * Updates validator status' according to updated powers.
 // * Updates the fee pool bonded vs not-bonded tokens.
 // * Updates relevant indices.
+// * Replaces the consensus pubkeys of validators rotated in the block.
 // It gets called once after genesis, another time maybe after genesis transactions,
 // then once at every EndBlock.
 //
@@ -97,6 +105,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the consensus pubkeys rotated away from in this block, which
+	// are the ones Tendermint still knows the validators by.
+	rotated := k.dequeuePendingConsPubKeyRotations(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -140,8 +152,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// replace the consensus pubkey known to Tendermint if it was rotated
+		oldPubKey, isRotated := rotated[valAddrBytes]
+		if found && isRotated {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+		}
+
+		// update the validator set if power or consensus pubkey has changed
+		if !found || isRotated || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
@@ -159,7 +177,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		validator = k.bondedToUnbonding(ctx, validator)
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		update := validator.ABCIValidatorUpdateZero()
+		var rotatedAddr [sdk.AddrLen]byte
+		copy(rotatedAddr[:], valAddrBytes)
+		if oldPubKey, isRotated := rotated[rotatedAddr]; isRotated {
+			// Tendermint doesn't know the validator by its new consensus pubkey yet
+			update.PubKey = tmtypes.TM2PB.PubKey(oldPubKey)
+		}
+		updates = append(updates, update)
 	}
 
 	// Update the pools based on the recent updates in the validator set:
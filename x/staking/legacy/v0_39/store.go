@@ -0,0 +1,81 @@
+package v039
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateMinCommissionRate sets the MinCommissionRate staking param to the
+// given rate and raises the commission of every validator below it, see
+// RaiseCommission. Upgrade handlers can call it to raise the rate further.
+func MigrateMinCommissionRate(ctx sdk.Context, k keeper.Keeper, minCommissionRate sdk.Dec) error {
+	params := k.GetParams(ctx)
+	params.MinCommissionRate = minCommissionRate
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.Rate.GTE(minCommissionRate) {
+			continue
+		}
+
+		commission := RaiseCommission(validator.Commission, minCommissionRate, ctx.BlockHeader().Time)
+		if commission.Rate.Equal(validator.Commission.Rate) {
+			continue
+		}
+		if err := commission.Validate(); err != nil {
+			return err
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission = commission
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// MigrateMaxConsPubKeyRotations sets the MaxConsPubKeyRotations staking param
+// to its default value, which enables consensus pubkey rotations.
+func MigrateMaxConsPubKeyRotations(ctx sdk.Context, k keeper.Keeper) error {
+	params := k.GetParams(ctx)
+	params.MaxConsPubkeyRotations = types.DefaultMaxConsPubKeyRotations
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// RaiseCommission returns the given commission with its rate raised towards
+// minCommissionRate. The raise respects the limits the validator committed to:
+// the rate is raised by at most MaxChangeRate and never above MaxRate, so the
+// rate of a validator remains below minCommissionRate if either limit is
+// reached. Such validators can raise their rate further with MsgEditValidator.
+func RaiseCommission(commission types.Commission, minCommissionRate sdk.Dec, blockTime time.Time) types.Commission {
+	if commission.Rate.GTE(minCommissionRate) {
+		return commission
+	}
+
+	rate := sdk.MinDec(minCommissionRate, commission.MaxRate)
+	rate = sdk.MinDec(rate, commission.Rate.Add(commission.MaxChangeRate))
+	if rate.LTE(commission.Rate) {
+		return commission
+	}
+
+	commission.Rate = rate
+	commission.UpdateTime = blockTime
+
+	return commission
+}
@@ -0,0 +1,81 @@
+package v039_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v039staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateMinCommissionRate(t *testing.T) {
+	app := simapp.Setup(false)
+	blockTime := time.Unix(1590000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: blockTime})
+
+	pks := simapp.CreateTestPubKeys(4)
+	addrs := simapp.ConvertAddrsToValAddrs(simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(10000)))
+
+	commissions := []types.Commission{
+		// below the floor, with limits allowing to reach it
+		types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2)),
+		// below the floor, with a max change rate limiting the raise
+		types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+		// below the floor, with a max rate below it
+		types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(2, 2)),
+		// above the floor
+		types.NewCommission(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+	}
+
+	for i, commission := range commissions {
+		validator := types.NewValidator(addrs[i], pks[i], types.Description{})
+		validator.Commission = commission
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, v039staking.MigrateMinCommissionRate(ctx, app.StakingKeeper, minRate))
+	require.Equal(t, minRate, app.StakingKeeper.MinCommissionRate(ctx))
+
+	expectedRates := []sdk.Dec{minRate, sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(10, 2)}
+	for i, commission := range commissions {
+		validator, found := app.StakingKeeper.GetValidator(ctx, addrs[i])
+		require.True(t, found)
+		require.Equal(t, expectedRates[i], validator.Commission.Rate)
+
+		// the limits of the validator are never rewritten
+		require.Equal(t, commission.MaxRate, validator.Commission.MaxRate)
+		require.Equal(t, commission.MaxChangeRate, validator.Commission.MaxChangeRate)
+
+		if i < 3 {
+			require.True(t, blockTime.Equal(validator.Commission.UpdateTime))
+		} else {
+			require.Equal(t, commission, validator.Commission)
+		}
+	}
+
+	// an invalid rate is rejected
+	require.Error(t, v039staking.MigrateMinCommissionRate(ctx, app.StakingKeeper, sdk.NewDec(2)))
+}
+
+func TestMigrateMaxConsPubKeyRotations(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// params stored before consensus pubkey rotations existed
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxConsPubkeyRotations = 0
+	app.StakingKeeper.SetParams(ctx, params)
+
+	require.NoError(t, v039staking.MigrateMaxConsPubKeyRotations(ctx, app.StakingKeeper))
+
+	migrated := app.StakingKeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxConsPubKeyRotations, migrated.MaxConsPubkeyRotations)
+	require.Equal(t, params.UnbondingTime, migrated.UnbondingTime)
+	require.Equal(t, params.MinCommissionRate, migrated.MinCommissionRate)
+}
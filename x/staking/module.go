@@ -19,16 +19,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingclient "github.com/cosmos/cosmos-sdk/x/staking/client"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/rest"
+	v039 "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/staking/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                     = AppModule{}
+	_ module.HasMigrations                 = AppModule{}
+	_ module.AppModuleBasic                = AppModuleBasic{}
+	_ module.AppModuleSimulation           = AppModule{}
+	_ module.AppModuleBasicVerifiedQueries = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the staking module.
@@ -77,6 +81,12 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetQueryCmd(StoreKey, cdc)
 }
 
+// VerifiedQueriers returns the queriers answering the custom queries of the
+// staking module with verified store reads.
+func (AppModuleBasic) VerifiedQueriers() map[string]context.VerifiedQuerier {
+	return stakingclient.VerifiedQueriers()
+}
+
 //_____________________________________
 // extra helpers
 
@@ -178,6 +188,35 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	return EndBlocker(ctx, am.keeper)
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 4 }
+
+// RegisterMigrations registers the in-place store migrations of the staking
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 stores the params in the module store
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateParams)
+	if err != nil {
+		panic(err)
+	}
+
+	// version 3 adds the MinCommissionRate param
+	err = cfg.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return v039.MigrateMinCommissionRate(ctx, am.keeper, types.DefaultMinCommissionRate)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// version 4 adds the MaxConsPubKeyRotations param
+	err = cfg.RegisterMigration(ModuleName, 3, func(ctx sdk.Context) error {
+		return v039.MigrateMaxConsPubKeyRotations(ctx, am.keeper)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
@@ -51,6 +51,18 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationKey):
+		var rotationA, rotationB types.ConsPubKeyRotation
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &rotationA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rotationB)
+		return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+
+	case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+		var paramsA, paramsB types.Params
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &paramsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &paramsB)
+		return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
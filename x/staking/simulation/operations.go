@@ -21,6 +21,7 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCancelUnbond    = "op_weight_msg_cancel_unbond"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -35,6 +36,7 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+		weightMsgCancelUnbond    int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +69,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbond, &weightMsgCancelUnbond, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbond = simappparams.DefaultWeightMsgCancelUnbond
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +96,10 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbond,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
 	}
 }
 
@@ -493,3 +505,67 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation with random values
+// nolint: interfacer
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+
+		// get a random unbonding delegation of the account
+		ubds := k.GetUnbondingDelegations(ctx, simAccount.Address, uint16(k.MaxEntries(ctx)))
+		if len(ubds) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		ubd := ubds[r.Intn(len(ubds))]
+
+		validator, found := k.GetValidator(ctx, ubd.ValidatorAddress)
+		if !found || validator.IsJailed() || validator.InvalidExRate() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get a random unbonding entry that has not matured yet
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) || !entry.Balance.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		cancelAmt, err := simulation.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			simAccount.Address, ubd.ValidatorAddress, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
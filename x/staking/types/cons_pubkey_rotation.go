@@ -0,0 +1,62 @@
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance.
+func NewConsPubKeyRotation(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey, height int64, completionTime time.Time,
+) ConsPubKeyRotation {
+	return ConsPubKeyRotation{
+		OperatorAddress: valAddr,
+		OldConsPubkey:   sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, oldPubKey),
+		NewConsPubkey:   sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey),
+		Height:          height,
+		CompletionTime:  completionTime,
+	}
+}
+
+// GetOldConsPubKey returns the consensus pubkey replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.OldConsPubkey)
+}
+
+// GetOldConsAddr returns the consensus address replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(r.GetOldConsPubKey().Address())
+}
+
+// GetNewConsPubKey returns the consensus pubkey installed by the rotation.
+func (r ConsPubKeyRotation) GetNewConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.NewConsPubkey)
+}
+
+// IsMature returns true if the rotation is mature at the given time.
+func (r ConsPubKeyRotation) IsMature(currentTime time.Time) bool {
+	return !r.CompletionTime.After(currentTime)
+}
+
+// String implements the Stringer interface for a ConsPubKeyRotation object.
+func (r ConsPubKeyRotation) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// MustMarshalConsPubKeyRotation marshals a consensus pubkey rotation and panics on error
+func MustMarshalConsPubKeyRotation(cdc codec.Marshaler, rotation ConsPubKeyRotation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&rotation)
+}
+
+// MustUnmarshalConsPubKeyRotation unmarshals a consensus pubkey rotation and panics on error
+func MustUnmarshalConsPubKeyRotation(cdc codec.Marshaler, value []byte) ConsPubKeyRotation {
+	rotation := ConsPubKeyRotation{}
+	cdc.MustUnmarshalBinaryLengthPrefixed(value, &rotation)
+	return rotation
+}
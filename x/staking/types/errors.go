@@ -57,4 +57,7 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 48, "no unbonding delegation entry found at the given creation height")
+	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 49, "commission cannot be less than min rate")
+	ErrExceedingMaxConsPubKeyRotations = sdkerrors.Register(ModuleName, 50, "exceeding maximum consensus pubkey rotations within the unbonding period")
 )
@@ -9,6 +9,8 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeCancelUnbond         = "cancel_unbond"
+	EventTypeRotateConsPubKey     = "rotate_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +19,8 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyOldConsPubKey     = "old_cons_pubkey"
+	AttributeKeyNewConsPubKey     = "new_cons_pubkey"
 	AttributeValueCategory        = ModuleName
 )
@@ -13,6 +13,7 @@ type GenesisState struct {
 	Delegations          Delegations           `json:"delegations" yaml:"delegations"`
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
+	ConsPubKeyRotations  []ConsPubKeyRotation  `json:"cons_pubkey_rotations" yaml:"cons_pubkey_rotations"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
 }
 
@@ -47,7 +47,13 @@ var (
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
+	ConsPubKeyRotationQueueKey = []byte{0x44} // prefix for the timestamps in consensus pubkey rotation queue
+
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	ParamsKey         = []byte{0x51} // key for the module parameters
+
+	ConsPubKeyRotationKey        = []byte{0x60} // prefix for each key to a consensus pubkey rotation, by validator operator
+	PendingConsPubKeyRotationKey = []byte{0x61} // prefix for the consensus pubkeys not yet replaced in the validator set, by validator operator
 )
 
 // gets the key for the validator with address
@@ -288,3 +294,30 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// gets the key for a consensus pubkey rotation by validator operator and the
+// replaced consensus address
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(valAddr sdk.ValAddress, oldConsAddr sdk.ConsAddress) []byte {
+	return append(GetConsPubKeyRotationsKey(valAddr), oldConsAddr.Bytes()...)
+}
+
+// gets the prefix keyspace for all consensus pubkey rotations of a validator
+func GetConsPubKeyRotationsKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// gets the key for the consensus pubkey of a validator that was rotated in
+// the current block and not yet replaced in the validator set
+// VALUE: consensus pubkey (bech32 string)
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, valAddr.Bytes()...)
+}
+
+// gets the prefix for all consensus pubkey rotations maturing at a timestamp
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ConsPubKeyRotationQueueKey, bz...)
+}
@@ -29,6 +29,10 @@ const (
 	// DefaultHistorical entries is 0 since it must only be non-zero for
 	// IBC connected chains
 	DefaultHistoricalEntries uint32 = 0
+
+	// Default maximum number of consensus pubkey rotations of a validator
+	// within the unbonding period
+	DefaultMaxConsPubKeyRotations uint32 = 1
 )
 
 // nolint - Keys for parameter access
@@ -38,6 +42,12 @@ var (
 	KeyMaxEntries        = []byte("KeyMaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyMinCommissionRate = []byte("MinCommissionRate")
+
+	KeyMaxConsPubKeyRotations = []byte("MaxConsPubKeyRotations")
+
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -45,14 +55,17 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate sdk.Dec, maxConsPubKeyRotations uint32,
 ) Params {
 
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:          unbondingTime,
+		MaxValidators:          maxValidators,
+		MaxEntries:             maxEntries,
+		HistoricalEntries:      historicalEntries,
+		BondDenom:              bondDenom,
+		MinCommissionRate:      minCommissionRate,
+		MaxConsPubkeyRotations: maxConsPubKeyRotations,
 	}
 }
 
@@ -64,6 +77,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyMaxConsPubKeyRotations, &p.MaxConsPubkeyRotations, validateMaxConsPubKeyRotations),
 	}
 }
 
@@ -75,6 +90,8 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultMinCommissionRate,
+		DefaultMaxConsPubKeyRotations,
 	)
 }
 
@@ -116,6 +133,12 @@ func (p Params) Validate() error {
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+	if err := validateMaxConsPubKeyRotations(p.MaxConsPubkeyRotations); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -183,3 +206,31 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum commission rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxConsPubKeyRotations(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
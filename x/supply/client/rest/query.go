@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/supply/types"
 )
@@ -17,17 +18,23 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 }
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	// Query the total supply of coins
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/supply/total",
 		totalSupplyHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query the total supply of coins",
+		QueryParams: []rest.QueryParam{rest.HeightParam, rest.PageParam, rest.LimitParam},
+		Result:      sdk.Coins{},
+	})
 
-	// Query the supply of a single denom
-	r.HandleFunc(
+	rest.Document(r.HandleFunc(
 		"/supply/total/{denom}",
 		supplyOfHandlerFn(cliCtx),
-	).Methods("GET")
+	).Methods("GET"), rest.RouteDoc{
+		Summary:     "Query the supply of a single denom",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      sdk.Int{},
+	})
 }
 
 // HTTP request handler to query the total supply of coins
@@ -16,7 +16,11 @@ import (
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
 // skipUpgradeHeightArray is a set of block heights for which the upgrade must be skipped
+//
+// It also samples the block time every BlockTimeSampleInterval blocks, to estimate when a plan will run.
 func BeginBlocker(k Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
+	k.SampleBlockTime(ctx)
+
 	plan, found := k.GetUpgradePlan(ctx)
 	if !found {
 		return
@@ -41,7 +45,7 @@ func BeginBlocker(k Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
 			// store migrations.
-			err := k.DumpUpgradeInfoToDisk(ctx.BlockHeight(), plan.Name)
+			err := k.DumpUpgradeInfoToDisk(ctx.BlockHeight(), plan.Name, plan.Info)
 			if err != nil {
 				panic(fmt.Errorf("unable to write upgrade info to filesystem: %s", err.Error()))
 			}
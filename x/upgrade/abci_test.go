@@ -126,7 +126,7 @@ func VerifyDoUpgrade(t *testing.T) {
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) {})
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -142,7 +142,7 @@ func VerifyDoUpgradeWithCtx(t *testing.T, newCtx sdk.Context, proposalName strin
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan upgrade.Plan) {})
+	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -154,7 +154,10 @@ func TestHaltIfTooNew(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Verify that we don't panic with registered plan not in database at all")
 	var called int
-	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan upgrade.Plan) { called++ })
+	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		called++
+		return vm, nil
+	})
 
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
 	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
@@ -404,8 +407,9 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 
 	planHeight := s.ctx.BlockHeight() + 1
 	name := "test"
+	info := `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:abcd"}}`
 	t.Log("verify if upgrade height is dumped to file")
-	err := s.keeper.DumpUpgradeInfoToDisk(planHeight, name)
+	err := s.keeper.DumpUpgradeInfoToDisk(planHeight, name, info)
 	require.Nil(t, err)
 
 	upgradeInfoFilePath, err := s.keeper.GetUpgradeInfoPath()
@@ -420,8 +424,159 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 
 	t.Log("Verify upgrade height from file matches ")
 	require.Equal(t, upgradeInfo.Height, planHeight)
+	require.Equal(t, name, upgradeInfo.Name)
+	require.Equal(t, info, upgradeInfo.Info)
 
 	// clear the test file
 	err = os.Remove(upgradeInfoFilePath)
 	require.Nil(t, err)
 }
+
+func TestModuleVersionMap(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	t.Log("Verify that the module versions are stored at genesis")
+	vm := s.keeper.GetModuleVersionMap(s.ctx)
+	require.Equal(t, uint64(4), vm["staking"])
+	require.Equal(t, uint64(2), vm["bank"])
+	require.Equal(t, uint64(2), vm["upgrade"])
+
+	bz, err := s.querier(s.ctx, []string{upgrade.QueryModuleVersions}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var mvs []upgrade.ModuleVersion
+	require.NoError(t, codec.New().UnmarshalJSON(bz, &mvs))
+	require.Len(t, mvs, len(vm))
+	for _, mv := range mvs {
+		require.Equal(t, vm[mv.Name], mv.Version)
+	}
+
+	t.Log("Verify that the upgrade handler receives and updates the module versions")
+	err = s.handler(s.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	var fromVM module.VersionMap
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		fromVM = vm
+		updatedVM := module.VersionMap{"new": 1}
+		for name, version := range vm {
+			updatedVM[name] = version
+		}
+		return updatedVM, nil
+	})
+
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+	require.Equal(t, vm, fromVM)
+
+	updatedVM := s.keeper.GetModuleVersionMap(newCtx)
+	require.Equal(t, uint64(1), updatedVM["new"])
+	require.Equal(t, len(vm)+1, len(updatedVM))
+}
+
+func TestFailingUpgradeHandler(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	err := s.handler(s.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+
+	t.Log("Verify that the chain halts if the upgrade handler fails")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+}
+
+func TestUpgradeReadiness(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	blockTime := time.Unix(1590000000, 0).UTC()
+
+	bz, err := s.querier(s.ctx, []string{upgrade.QueryReadiness}, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	planHeight := s.ctx.BlockHeight() + 1000
+	err = s.handler(s.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: planHeight}})
+	require.NoError(t, err)
+
+	t.Log("Verify that the block time is sampled to estimate when the plan runs")
+	ctx := s.ctx
+	for i := int64(0); i <= 2*upgrade.BlockTimeSampleInterval; i++ {
+		ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + i).WithBlockTime(blockTime.Add(time.Duration(i) * 5 * time.Second))
+		s.module.BeginBlock(ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()})
+	}
+	require.Equal(t, 5*time.Second, s.keeper.GetAverageBlockTime(ctx))
+
+	bz, err = s.querier(ctx, []string{upgrade.QueryReadiness}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var readiness upgrade.UpgradeReadiness
+	require.NoError(t, codec.New().UnmarshalJSON(bz, &readiness))
+	require.Equal(t, "test", readiness.Plan.Name)
+	require.False(t, readiness.HasHandler)
+	require.Equal(t, ctx.BlockHeight(), readiness.Height)
+	require.Equal(t, 5*time.Second, readiness.AverageBlockTime)
+	require.Equal(t, planHeight, readiness.EstimatedHeight)
+	require.Equal(t, planHeight-ctx.BlockHeight(), readiness.BlocksRemaining())
+	require.True(t, ctx.BlockTime().Add(time.Duration(planHeight-ctx.BlockHeight())*5*time.Second).Equal(readiness.EstimatedTime))
+
+	t.Log("Verify that the height of a plan scheduled at a time is estimated")
+	planTime := ctx.BlockTime().Add(time.Hour)
+	err = s.handler(ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Time: planTime}})
+	require.NoError(t, err)
+
+	readiness, found := s.keeper.GetUpgradeReadiness(ctx)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+720, readiness.EstimatedHeight)
+	require.Equal(t, time.Hour, readiness.TimeRemaining())
+}
+
+func TestMigrateBlockTimeSample(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	blockTime := time.Unix(1590000000, 0).UTC()
+	ctx := s.ctx.WithBlockTime(blockTime)
+
+	t.Log("Verify that the migration to version 2 takes the first block time sample")
+	require.Zero(t, s.keeper.GetAverageBlockTime(ctx))
+	require.NoError(t, s.keeper.MigrateBlockTimeSample(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3).WithBlockTime(blockTime.Add(15 * time.Second))
+	require.Equal(t, 5*time.Second, s.keeper.GetAverageBlockTime(ctx))
+}
+
+func TestPreUpgradeHandler(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	plan := upgrade.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}
+
+	t.Log("Verify that running a missing pre-upgrade handler is a no-op")
+	require.False(t, s.keeper.HasPreUpgradeHandler(plan.Name))
+	require.NoError(t, s.keeper.RunPreUpgradeHandler(plan))
+
+	var homePath string
+	s.keeper.SetPreUpgradeHandler(plan.Name, func(p upgrade.Plan, home string) error {
+		require.Equal(t, plan, p)
+		homePath = home
+		return nil
+	})
+	require.True(t, s.keeper.HasPreUpgradeHandler(plan.Name))
+	require.NoError(t, s.keeper.RunPreUpgradeHandler(plan))
+	require.Equal(t, simapp.DefaultNodeHome, homePath)
+
+	t.Log("Verify that the error of the pre-upgrade handler is returned")
+	s.keeper.SetPreUpgradeHandler(plan.Name, func(upgrade.Plan, string) error {
+		return errors.New("not enough disk space")
+	})
+	require.Error(t, s.keeper.RunPreUpgradeHandler(plan))
+
+	t.Log("Verify that the pre-upgrade handler doesn't halt the chain before the upgrade")
+	require.NoError(t, s.handler(s.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: plan.Name, Height: s.ctx.BlockHeight() + 2}}))
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(s.ctx, abci.RequestBeginBlock{Header: s.ctx.BlockHeader()})
+	})
+}
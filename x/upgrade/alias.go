@@ -14,19 +14,27 @@ const (
 	QuerierKey                        = types.QuerierKey
 	PlanByte                          = types.PlanByte
 	DoneByte                          = types.DoneByte
+	VersionMapByte                    = types.VersionMapByte
+	BlockTimeSampleByte               = types.BlockTimeSampleByte
+	BlockTimeSampleInterval           = types.BlockTimeSampleInterval
 	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
 	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
 	QueryCurrent                      = types.QueryCurrent
 	QueryApplied                      = types.QueryApplied
+	QueryModuleVersions               = types.QueryModuleVersions
+	QueryReadiness                    = types.QueryReadiness
 )
 
 var (
 	// functions aliases
 	RegisterCodec                    = types.RegisterCodec
 	PlanKey                          = types.PlanKey
+	BlockTimeSampleKey               = types.BlockTimeSampleKey
 	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
 	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
 	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	NewModuleVersion                 = types.NewModuleVersion
+	NewUpgradeReadiness              = types.NewUpgradeReadiness
 	UpgradeStoreLoader               = types.UpgradeStoreLoader
 	NewKeeper                        = keeper.NewKeeper
 	NewQuerier                       = keeper.NewQuerier
@@ -34,9 +42,12 @@ var (
 
 type (
 	UpgradeHandler                = types.UpgradeHandler // nolint
+	PreUpgradeHandler             = types.PreUpgradeHandler
 	Plan                          = types.Plan
 	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
 	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
 	QueryAppliedParams            = types.QueryAppliedParams
+	ModuleVersion                 = types.ModuleVersion
+	UpgradeReadiness              = types.UpgradeReadiness
 	Keeper                        = keeper.Keeper
 )
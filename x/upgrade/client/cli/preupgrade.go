@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// RunPreUpgrade runs the pre-upgrade handler of the scheduled upgrade plan, queried from the node of the CLIContext,
+// with the upgrade keeper of the binary of the upgrade. It is meant to implement the PreUpgrade method of the
+// application run by the preupgrade command of the server while the node still runs the current binary, the keeper
+// being the one of an application created for the home directory of the node, with the handlers of the upgrade
+// registered. It fails if the binary has no handler for the upgrade or if the pre-upgrade handler fails.
+func RunPreUpgrade(cliCtx context.CLIContext, k keeper.Keeper) error {
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryCurrent))
+	if err != nil {
+		return err
+	}
+
+	if len(res) == 0 {
+		return fmt.Errorf("no upgrade scheduled")
+	}
+
+	var plan types.Plan
+	if err := cliCtx.Codec.UnmarshalJSON(res, &plan); err != nil {
+		return err
+	}
+
+	if !k.HasHandler(plan.Name) {
+		return fmt.Errorf("this binary has no upgrade handler for upgrade %s", plan.Name)
+	}
+
+	if !k.HasPreUpgradeHandler(plan.Name) {
+		fmt.Fprintf(cliCtx.Output, "no pre-upgrade handler registered for upgrade %s\n", plan.Name)
+		return nil
+	}
+
+	if err := k.RunPreUpgradeHandler(plan); err != nil {
+		return fmt.Errorf("pre-upgrade handler of upgrade %s failed: %w", plan.Name, err)
+	}
+
+	fmt.Fprintf(cliCtx.Output, "pre-upgrade handler of upgrade %s succeeded\n", plan.Name)
+	return nil
+}
@@ -0,0 +1,120 @@
+package cli_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// planNode is a node answering the queries of the current plan.
+type planNode struct {
+	mock.Client
+	plan []byte
+}
+
+func (n planNode) ABCIQueryWithOptions(
+	path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: n.plan}}, nil
+}
+
+func TestRunPreUpgrade(t *testing.T) {
+	cdc := codec.New()
+	plan := types.Plan{Name: "test", Height: 100}
+	upgradeHandler := func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	}
+
+	testCases := []struct {
+		name      string
+		plan      []byte
+		setup     func(k keeper.Keeper)
+		expErr    string
+		expOutput string
+	}{
+		{
+			name:   "no plan",
+			setup:  func(keeper.Keeper) {},
+			expErr: "no upgrade scheduled",
+		},
+		{
+			name:   "no upgrade handler",
+			plan:   cdc.MustMarshalJSON(plan),
+			setup:  func(keeper.Keeper) {},
+			expErr: "this binary has no upgrade handler for upgrade test",
+		},
+		{
+			name: "no pre-upgrade handler",
+			plan: cdc.MustMarshalJSON(plan),
+			setup: func(k keeper.Keeper) {
+				k.SetUpgradeHandler("test", upgradeHandler)
+			},
+			expOutput: "no pre-upgrade handler registered for upgrade test\n",
+		},
+		{
+			name: "pre-upgrade handler succeeds",
+			plan: cdc.MustMarshalJSON(plan),
+			setup: func(k keeper.Keeper) {
+				k.SetUpgradeHandler("test", upgradeHandler)
+				k.SetPreUpgradeHandler("test", func(p types.Plan, homePath string) error {
+					if p.Name != plan.Name || homePath != "home" {
+						return errors.New("unexpected plan or home")
+					}
+					return nil
+				})
+			},
+			expOutput: "pre-upgrade handler of upgrade test succeeded\n",
+		},
+		{
+			name: "pre-upgrade handler fails",
+			plan: cdc.MustMarshalJSON(plan),
+			setup: func(k keeper.Keeper) {
+				k.SetUpgradeHandler("test", upgradeHandler)
+				k.SetPreUpgradeHandler("test", func(types.Plan, string) error {
+					return errors.New("not enough disk space")
+				})
+			},
+			expErr: "pre-upgrade handler of upgrade test failed: not enough disk space",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			k := keeper.NewKeeper(map[int64]bool{}, sdk.NewKVStoreKey(types.StoreKey), nil, "home")
+			tc.setup(k)
+
+			output := new(bytes.Buffer)
+			cliCtx := context.CLIContext{}.
+				WithClient(planNode{plan: tc.plan}).
+				WithTrustNode(true).
+				WithCodec(cdc).
+				WithOutput(output)
+
+			err := cli.RunPreUpgrade(cliCtx, k)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expOutput, output.String())
+		})
+	}
+}
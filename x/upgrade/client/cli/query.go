@@ -96,3 +96,59 @@ func GetAppliedHeightCmd(storeName string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetModuleVersionsCmd returns the consensus version of each module
+func GetModuleVersionsCmd(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-versions",
+		Short: "get the consensus version of each module",
+		Long: "Gets the consensus version of each module, as stored by the upgrade module at genesis and updated by each\n" +
+			"applied upgrade. Modules are migrated in place from these versions when the next upgrade is applied.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryModuleVersions))
+			if err != nil {
+				return err
+			}
+
+			var mvs []types.ModuleVersion
+			if err := cdc.UnmarshalJSON(res, &mvs); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(mvs)
+		},
+	}
+}
+
+// GetReadinessCmd returns the readiness of the scheduled upgrade plan
+func GetReadinessCmd(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "readiness",
+		Short: "get the readiness of the upgrade plan (if one exists)",
+		Long: "Gets the currently scheduled upgrade plan, the height and time at which it is expected to run, estimated\n" +
+			"from the recent block times, and whether the binary of the queried node has a handler for it.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryReadiness))
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var readiness types.UpgradeReadiness
+			if err := cdc.UnmarshalJSON(res, &readiness); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(readiness)
+		},
+	}
+}
@@ -14,8 +14,24 @@ import (
 
 // RegisterRoutes registers REST routes for the upgrade module under the path specified by routeName.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/upgrade/current", getCurrentPlanHandler(cliCtx)).Methods("GET")
-	r.HandleFunc("/upgrade/applied/{name}", getDonePlanHandler(cliCtx)).Methods("GET")
+	rest.Document(r.HandleFunc("/upgrade/current", getCurrentPlanHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary: "Get the current upgrade plan",
+		Result:  types.Plan{},
+	})
+	rest.Document(r.HandleFunc("/upgrade/applied/{name}", getDonePlanHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary: "Get the height at which an upgrade was applied",
+		Result:  int64(0),
+	})
+	rest.Document(r.HandleFunc("/upgrade/module_versions", getModuleVersionsHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the consensus versions of the modules",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      []types.ModuleVersion{},
+	})
+	rest.Document(r.HandleFunc("/upgrade/readiness", getReadinessHandler(cliCtx)).Methods("GET"), rest.RouteDoc{
+		Summary:     "Get the readiness of the node for the current upgrade plan",
+		QueryParams: []rest.QueryParam{rest.HeightParam},
+		Result:      types.UpgradeReadiness{},
+	})
 	registerTxRoutes(cliCtx, r)
 }
 
@@ -73,3 +89,43 @@ func getDonePlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *ht
 		rest.PostProcessResponse(w, cliCtx, applied)
 	}
 }
+
+func getModuleVersionsHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryModuleVersions))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getReadinessHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryReadiness))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
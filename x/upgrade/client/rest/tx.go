@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/mux"
 
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,8 +18,16 @@ import (
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	r.HandleFunc("/upgrade/plan", postPlanHandler(cliCtx)).Methods("POST")
-	r.HandleFunc("/upgrade/cancel", cancelPlanHandler(cliCtx)).Methods("POST")
+	rest.Document(r.HandleFunc("/upgrade/plan", postPlanHandler(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction submitting a software upgrade proposal",
+		Request:  PlanRequest{},
+		Response: authtypes.StdTx{},
+	})
+	rest.Document(r.HandleFunc("/upgrade/cancel", cancelPlanHandler(cliCtx)).Methods("POST"), rest.RouteDoc{
+		Summary:  "Generate an unsigned transaction submitting a proposal cancelling the upgrade plan",
+		Request:  CancelRequest{},
+		Response: authtypes.StdTx{},
+	})
 }
 
 // PlanRequest defines a proposal for a new upgrade plan.
@@ -45,6 +54,11 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 	return govrest.ProposalRESTHandler{
 		SubRoute: "upgrade",
 		Handler:  postPlanHandler(cliCtx),
+		Doc: rest.RouteDoc{
+			Summary:  "Generate an unsigned transaction submitting a software upgrade proposal",
+			Request:  PlanRequest{},
+			Response: authtypes.StdTx{},
+		},
 	}
 }
 
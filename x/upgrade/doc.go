@@ -59,8 +59,9 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
@@ -71,8 +72,9 @@ switching the binaries is an ops task and not handled inside the sdk / abci app.
 Here is a sample code to set store migrations with an upgrade:
 
 	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// upgrade changes here
+		return fromVM, nil
 	})
 
 	upgradeInfo := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -89,6 +91,28 @@ Here is a sample code to set store migrations with an upgrade:
 		app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 
+Module Migrations
+
+Each module declares a consensus version through AppModule.ConsensusVersion, which it increments on every
+state-breaking change, and registers the in-place store migrations from each version to the next through
+module.Configurator.RegisterMigration. The upgrade keeper stores the consensus version of every module and passes it
+to the upgrade handler as fromVM, the handler returning the version map to store once the upgrade is applied. An app
+registers the migrations and stores the initial version map at genesis:
+
+	app.configurator = module.NewConfigurator(appCodec)
+	app.mm.RegisterMigrations(app.configurator)
+
+	func (app *myApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		...
+		app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
+		return app.mm.InitGenesis(ctx, app.cdc, genesisState)
+	}
+
+An upgrade handler then only needs to call module.Manager.RunMigrations, which migrates every module from its stored
+version to its current one, and initializes the default genesis state of modules added by the upgrade (whose store
+must also be added through the store loader, see above). Chains whose version map was not stored at genesis must set
+the versions of their existing modules in fromVM before calling RunMigrations.
+
 Halt Behavior
 
 Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
@@ -109,6 +133,13 @@ specified here https://github.com/regen-network/cosmosd/blob/master/README.md#au
 This will allow a properly configured cosmsod daemon to auto-download new binaries and auto-upgrade.
 As noted there, this is intended more for full nodes than validators.
 
+The cosmovisor process supervisor shipped in this repository (see cosmovisor/README.md) is such a watcher daemon.
+When halting, the upgrade keeper writes the name, height and info of the plan to the upgrade-info.json file of the
+node data directory. Cosmovisor runs the node as a subprocess, watches this file and switches to the binary of the
+upgrade, after backing up the data directory. It can also download the binary from a URL listed in Plan.Info, along
+with its checksum, in the format:
+	{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:<hex>"}}
+
 Cancelling Upgrades
 
 There are two ways to cancel a planned upgrade - with on-chain governance or off-chain social consensus.
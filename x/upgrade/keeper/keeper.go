@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -19,6 +20,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // UpgradeInfoFileName file to store upgrade information
@@ -30,6 +32,7 @@ type Keeper struct {
 	storeKey           sdk.StoreKey
 	cdc                codec.Marshaler
 	upgradeHandlers    map[string]types.UpgradeHandler
+	preUpgradeHandlers map[string]types.PreUpgradeHandler
 }
 
 // NewKeeper constructs an upgrade Keeper
@@ -40,6 +43,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc cod
 		storeKey:           storeKey,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		preUpgradeHandlers: map[string]types.PreUpgradeHandler{},
 	}
 }
 
@@ -50,6 +54,127 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetPreUpgradeHandler sets a PreUpgradeHandler for the upgrade specified by name. Node operators run it with
+// RunPreUpgradeHandler ahead of the upgrade, it is never called by the state machine.
+func (k Keeper) SetPreUpgradeHandler(name string, preUpgradeHandler types.PreUpgradeHandler) {
+	k.preUpgradeHandlers[name] = preUpgradeHandler
+}
+
+// HasPreUpgradeHandler returns true iff there is a pre-upgrade handler registered for this name
+func (k Keeper) HasPreUpgradeHandler(name string) bool {
+	_, ok := k.preUpgradeHandlers[name]
+	return ok
+}
+
+// RunPreUpgradeHandler runs the pre-upgrade handler registered for the given plan with the node home directory. It
+// is a no-op if no pre-upgrade handler is registered for the plan.
+func (k Keeper) RunPreUpgradeHandler(plan types.Plan) error {
+	handler, ok := k.preUpgradeHandlers[plan.Name]
+	if !ok {
+		return nil
+	}
+
+	return handler(plan, k.getHomeDir())
+}
+
+// SampleBlockTime records the height and time of the current block every BlockTimeSampleInterval blocks, keeping
+// the two latest samples to estimate the average block time from.
+func (k Keeper) SampleBlockTime(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockTimeSampleKey())
+
+	// the first sample is both the previous and the latest one
+	sample := encodeBlockTimeSample(ctx.BlockHeight(), ctx.BlockTime())
+	previous := sample
+	if len(bz) == 32 {
+		if ctx.BlockHeight()-int64(binary.BigEndian.Uint64(bz[16:24])) < types.BlockTimeSampleInterval {
+			return
+		}
+		previous = bz[16:]
+	}
+
+	store.Set(types.BlockTimeSampleKey(), append(append([]byte{}, previous...), sample...))
+}
+
+// MigrateBlockTimeSample records the first block time sample, which earlier versions of the module did not take, so
+// that the readiness of the plans estimates the block time from BlockTimeSampleInterval blocks after the migration.
+func (k Keeper) MigrateBlockTimeSample(ctx sdk.Context) error {
+	k.SampleBlockTime(ctx)
+	return nil
+}
+
+// GetAverageBlockTime returns the average block time since the previous block time sample, which was taken between
+// BlockTimeSampleInterval and twice as many blocks ago. It returns zero until enough blocks were sampled.
+func (k Keeper) GetAverageBlockTime(ctx sdk.Context) time.Duration {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockTimeSampleKey())
+	if len(bz) != 32 {
+		return 0
+	}
+
+	height := int64(binary.BigEndian.Uint64(bz[:8]))
+	blockTime := time.Unix(0, int64(binary.BigEndian.Uint64(bz[8:16])))
+	if ctx.BlockHeight() <= height || !ctx.BlockTime().After(blockTime) {
+		return 0
+	}
+
+	return ctx.BlockTime().Sub(blockTime) / time.Duration(ctx.BlockHeight()-height)
+}
+
+// GetUpgradeReadiness returns the readiness of the currently scheduled Plan if any, setting havePlan to true if
+// there is a scheduled upgrade or false if there is none
+func (k Keeper) GetUpgradeReadiness(ctx sdk.Context) (readiness types.UpgradeReadiness, havePlan bool) {
+	plan, havePlan := k.GetUpgradePlan(ctx)
+	if !havePlan {
+		return readiness, false
+	}
+
+	return types.NewUpgradeReadiness(
+		plan, k.HasHandler(plan.Name), ctx.BlockHeight(), ctx.BlockTime(), k.GetAverageBlockTime(ctx),
+	), true
+}
+
+func encodeBlockTimeSample(height int64, blockTime time.Time) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(blockTime.UnixNano()))
+	return bz
+}
+
+// SetModuleVersionMap stores the consensus version of each module. It is meant to be called with the version map of
+// the module manager at genesis, and is called with the version map returned by the upgrade handler when an upgrade is
+// applied.
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for moduleName, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set([]byte(moduleName), bz)
+	}
+}
+
+// GetModuleVersionMap returns the stored consensus version of each module
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	vm := make(module.VersionMap)
+	for _, mv := range k.GetModuleVersions(ctx) {
+		vm[mv.Name] = mv.Version
+	}
+
+	return vm
+}
+
+// GetModuleVersions returns the stored consensus version of each module, sorted by module name
+func (k Keeper) GetModuleVersions(ctx sdk.Context) (mvs []types.ModuleVersion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		mvs = append(mvs, types.NewModuleVersion(string(iterator.Key()), binary.BigEndian.Uint64(iterator.Value())))
+	}
+
+	return mvs
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
@@ -126,14 +251,20 @@ func (k Keeper) HasHandler(name string) bool {
 	return ok
 }
 
-// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+// ApplyUpgrade will execute the handler associated with the Plan, store the module version map it returns and mark
+// the plan as done. It panics if the handler fails, as the chain cannot proceed without the upgrade.
 func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(fmt.Sprintf("upgrade %s failed: %s", plan.Name, err))
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
@@ -144,8 +275,9 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
 }
 
-// DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName.
-func (k Keeper) DumpUpgradeInfoToDisk(height int64, name string) error {
+// DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName. The plan info is included so that a
+// process supervisor watching the file can locate the upgraded binary.
+func (k Keeper) DumpUpgradeInfoToDisk(height int64, name, planInfo string) error {
 	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
 	if err != nil {
 		return err
@@ -154,6 +286,7 @@ func (k Keeper) DumpUpgradeInfoToDisk(height int64, name string) error {
 	upgradeInfo := store.UpgradeInfo{
 		Name:   name,
 		Height: height,
+		Info:   planInfo,
 	}
 	info, err := json.Marshal(upgradeInfo)
 	if err != nil {
@@ -22,6 +22,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryApplied:
 			return queryApplied(ctx, req, k)
 
+		case types.QueryModuleVersions:
+			return queryModuleVersions(ctx, k)
+
+		case types.QueryReadiness:
+			return queryReadiness(ctx, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -60,3 +66,31 @@ func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, err
 
 	return bz, nil
 }
+
+func queryModuleVersions(ctx sdk.Context, k Keeper) ([]byte, error) {
+	mvs := k.GetModuleVersions(ctx)
+	if mvs == nil {
+		mvs = []types.ModuleVersion{}
+	}
+
+	res, err := k.cdc.MarshalJSON(mvs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryReadiness(ctx sdk.Context, k Keeper) ([]byte, error) {
+	readiness, has := k.GetUpgradeReadiness(ctx)
+	if !has {
+		return nil, nil
+	}
+
+	res, err := k.cdc.MarshalJSON(readiness)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
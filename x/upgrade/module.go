@@ -56,6 +56,8 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmd.AddCommand(flags.GetCommands(
 		cli.GetPlanCmd(StoreKey, cdc),
 		cli.GetAppliedHeightCmd(StoreKey, cdc),
+		cli.GetModuleVersionsCmd(StoreKey, cdc),
+		cli.GetReadinessCmd(StoreKey, cdc),
 	)...)
 
 	return queryCmd
@@ -133,3 +135,16 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the in-place store migrations of the upgrade
+// module.
+func (am AppModule) RegisterMigrations(cfg module.Configurator) {
+	// version 2 samples the block time in BeginBlock
+	err := cfg.RegisterMigration(ModuleName, 1, am.keeper.MigrateBlockTimeSample)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,17 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-// UpgradeHandler specifies the type of function that is called when an upgrade is applied
-type UpgradeHandler func(ctx sdk.Context, plan Plan)
+// UpgradeHandler specifies the type of function that is called when an upgrade is applied.
+//
+// fromVM is the consensus version map of the modules before the upgrade, as stored by x/upgrade. The handler
+// typically passes it to module.Manager.RunMigrations to migrate the module stores in place, and returns the
+// version map of the modules after the upgrade, which is then stored by x/upgrade in place of fromVM.
+type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// PreUpgradeHandler specifies the type of function that node operators run with the binary of an upgrade ahead of the
+// upgrade, outside of the state machine. It can check that the node is ready for the upgrade, for example that enough
+// disk space is available, or prepare data for the migrations of the upgrade in the node home directory.
+type PreUpgradeHandler func(plan Plan, homePath string) error
@@ -19,10 +19,23 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up the consensus version of a module by name
+	VersionMapByte = 0x2
+	// BlockTimeSampleByte specifies the Byte under which the block time samples used to estimate the average block
+	// time are stored
+	BlockTimeSampleByte = 0x3
 )
 
+// BlockTimeSampleInterval is the number of blocks between two block time samples
+const BlockTimeSampleInterval = 100
+
 // PlanKey is the key under which the current plan is saved
 // We store PlanByte as a const to keep it immutable (unlike a []byte)
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
+
+// BlockTimeSampleKey is the key under which the block time samples are saved
+func BlockTimeSampleKey() []byte {
+	return []byte{BlockTimeSampleByte}
+}
@@ -1,9 +1,16 @@
 package types
 
+import (
+	"fmt"
+	"time"
+)
+
 // query endpoints supported by the upgrade Querier
 const (
-	QueryCurrent = "current"
-	QueryApplied = "applied"
+	QueryCurrent        = "current"
+	QueryApplied        = "applied"
+	QueryModuleVersions = "module_versions"
+	QueryReadiness      = "readiness"
 )
 
 // QueryAppliedParams is passed as data with QueryApplied
@@ -16,3 +23,103 @@ type QueryAppliedParams struct {
 func NewQueryAppliedParams(name string) QueryAppliedParams {
 	return QueryAppliedParams{Name: name}
 }
+
+// ModuleVersion is the consensus version of a module, as returned by
+// QueryModuleVersions
+type ModuleVersion struct {
+	Name    string `json:"name" yaml:"name"`
+	Version uint64 `json:"version" yaml:"version"`
+}
+
+// NewModuleVersion creates a new ModuleVersion instance
+func NewModuleVersion(name string, version uint64) ModuleVersion {
+	return ModuleVersion{Name: name, Version: version}
+}
+
+func (mv ModuleVersion) String() string {
+	return fmt.Sprintf("%s: %d", mv.Name, mv.Version)
+}
+
+// UpgradeReadiness describes how far the currently scheduled upgrade plan is, as returned by QueryReadiness
+type UpgradeReadiness struct {
+	Plan Plan `json:"plan" yaml:"plan"`
+	// HasHandler tells whether the binary of the queried node has a handler registered for the plan
+	HasHandler bool `json:"has_handler" yaml:"has_handler"`
+	// Height and Time are the height and time of the latest block
+	Height int64     `json:"height" yaml:"height"`
+	Time   time.Time `json:"time" yaml:"time"`
+	// AverageBlockTime is measured over the recent blocks, it is zero until enough blocks were sampled
+	AverageBlockTime time.Duration `json:"average_block_time" yaml:"average_block_time"`
+	// EstimatedHeight and EstimatedTime are the height and time at which the plan is expected to run. For a plan
+	// scheduled at a time, the height is estimated from the average block time, and the other way around.
+	EstimatedHeight int64     `json:"estimated_height" yaml:"estimated_height"`
+	EstimatedTime   time.Time `json:"estimated_time" yaml:"estimated_time"`
+}
+
+// NewUpgradeReadiness creates a new UpgradeReadiness instance, estimating when the plan will run from the given
+// latest block and average block time
+func NewUpgradeReadiness(
+	plan Plan, hasHandler bool, height int64, blockTime time.Time, averageBlockTime time.Duration,
+) UpgradeReadiness {
+
+	readiness := UpgradeReadiness{
+		Plan:             plan,
+		HasHandler:       hasHandler,
+		Height:           height,
+		Time:             blockTime,
+		AverageBlockTime: averageBlockTime,
+	}
+
+	switch {
+	case !plan.Time.IsZero():
+		readiness.EstimatedTime = plan.Time
+		if averageBlockTime > 0 {
+			readiness.EstimatedHeight = height + int64(plan.Time.Sub(blockTime)/averageBlockTime)
+		}
+
+	default:
+		readiness.EstimatedHeight = plan.Height
+		if averageBlockTime > 0 {
+			readiness.EstimatedTime = blockTime.Add(time.Duration(plan.Height-height) * averageBlockTime)
+		}
+	}
+
+	return readiness
+}
+
+// BlocksRemaining returns the estimated number of blocks until the plan runs, or zero if it can't be estimated
+func (r UpgradeReadiness) BlocksRemaining() int64 {
+	if r.EstimatedHeight == 0 {
+		return 0
+	}
+	return r.EstimatedHeight - r.Height
+}
+
+// TimeRemaining returns the estimated time until the plan runs, or zero if it can't be estimated
+func (r UpgradeReadiness) TimeRemaining() time.Duration {
+	if r.EstimatedTime.IsZero() {
+		return 0
+	}
+	return r.EstimatedTime.Sub(r.Time)
+}
+
+func (r UpgradeReadiness) String() string {
+	estimatedHeight, estimatedTime := "unknown", "unknown"
+	if r.EstimatedHeight != 0 {
+		estimatedHeight = fmt.Sprintf("%d (in %d blocks)", r.EstimatedHeight, r.BlocksRemaining())
+	}
+	if !r.EstimatedTime.IsZero() {
+		estimatedTime = fmt.Sprintf("%s (in %s)", r.EstimatedTime.UTC().Format(time.RFC3339), r.TimeRemaining())
+	}
+
+	return fmt.Sprintf(`Upgrade Readiness
+  Plan:               %s
+  Has Handler:        %t
+  Height:             %d
+  Time:               %s
+  Average Block Time: %s
+  Estimated Height:   %s
+  Estimated Time:     %s`,
+		r.Plan.Name, r.HasHandler, r.Height, r.Time.UTC().Format(time.RFC3339), r.AverageBlockTime,
+		estimatedHeight, estimatedTime)
+}